@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.15.8
 // source: dsts.proto
 
@@ -29,34 +29,103 @@ var file_dsts_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
 	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0e, 0x61, 0x70, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x32, 0x93, 0x0a, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x54, 0x53, 0x12,
-	0x57, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
-	0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
-	0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x6f, 0x1a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0c, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xb5, 0x1e,
+	0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x54, 0x53, 0x12, 0x57, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1e, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6b, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64,
 	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
 	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76,
+	0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x76,
 	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
 	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21,
 	0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
 	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e,
+	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e,
+	0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x69, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a,
+	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74,
+	0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x71, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x2e, 0x6b,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e,
+	0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x22, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23,
+	0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x25, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e,
+	0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
 	0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65,
 	0x79, 0x12, 0x22, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
 	0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65,
@@ -91,88 +160,280 @@ var file_dsts_proto_rawDesc = []byte{
 	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74,
 	0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e,
-	0x67, 0x12, 0x19, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x6b,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26,
+	0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e,
+	0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x23, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x66, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26, 0x2e,
+	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x66, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e,
+	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x23, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x15,
+	0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e,
+	0x64, 0x73, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64,
+	0x73, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x2d, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e,
 	0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x12, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64,
-	0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x61, 0x7a, 0x63, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x68, 0x70, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x4b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2f, 0x64, 0x73, 0x74, 0x73, 0x2f, 0x64, 0x73,
-	0x74, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x20, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73,
+	0x74, 0x73, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12,
+	0x19, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6b, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x12, 0x26, 0x2e, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74,
+	0x73, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x6e, 0x2e, 0x64, 0x73, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x48, 0x50, 0x49, 0x6e, 0x63, 0x2f, 0x6b, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x6e, 0x2d, 0x64, 0x73, 0x74, 0x73, 0x2f, 0x64, 0x73, 0x74, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_dsts_proto_goTypes = []interface{}{
 	(*CreateDeviceRequest)(nil),                // 0: krypton.dsts.CreateDeviceRequest
 	(*GetDeviceRequest)(nil),                   // 1: krypton.dsts.GetDeviceRequest
 	(*ListDevicesRequest)(nil),                 // 2: krypton.dsts.ListDevicesRequest
-	(*UpdateDeviceRequest)(nil),                // 3: krypton.dsts.UpdateDeviceRequest
-	(*DeleteDeviceRequest)(nil),                // 4: krypton.dsts.DeleteDeviceRequest
-	(*GetSigningKeyRequest)(nil),               // 5: krypton.dsts.GetSigningKeyRequest
-	(*CreateEnrollmentTokenRequest)(nil),       // 6: krypton.dsts.CreateEnrollmentTokenRequest
-	(*GetEnrollmentTokenRequest)(nil),          // 7: krypton.dsts.GetEnrollmentTokenRequest
-	(*DeleteEnrollmentTokenRequest)(nil),       // 8: krypton.dsts.DeleteEnrollmentTokenRequest
-	(*ValidateEnrollmentTokenRequest)(nil),     // 9: krypton.dsts.ValidateEnrollmentTokenRequest
-	(*PingRequest)(nil),                        // 10: krypton.dsts.PingRequest
-	(*AppAuthenticationChallengeRequest)(nil),  // 11: krypton.dsts.AppAuthenticationChallengeRequest
-	(*AppAuthenticationRequest)(nil),           // 12: krypton.dsts.AppAuthenticationRequest
-	(*CreateDeviceResponse)(nil),               // 13: krypton.dsts.CreateDeviceResponse
-	(*GetDeviceResponse)(nil),                  // 14: krypton.dsts.GetDeviceResponse
-	(*ListDevicesResponse)(nil),                // 15: krypton.dsts.ListDevicesResponse
-	(*UpdateDeviceResponse)(nil),               // 16: krypton.dsts.UpdateDeviceResponse
-	(*DeleteDeviceResponse)(nil),               // 17: krypton.dsts.DeleteDeviceResponse
-	(*GetSigningKeyResponse)(nil),              // 18: krypton.dsts.GetSigningKeyResponse
-	(*CreateEnrollmentTokenResponse)(nil),      // 19: krypton.dsts.CreateEnrollmentTokenResponse
-	(*GetEnrollmentTokenResponse)(nil),         // 20: krypton.dsts.GetEnrollmentTokenResponse
-	(*DeleteEnrollmentTokenResponse)(nil),      // 21: krypton.dsts.DeleteEnrollmentTokenResponse
-	(*ValidateEnrollmentTokenResponse)(nil),    // 22: krypton.dsts.ValidateEnrollmentTokenResponse
-	(*PingResponse)(nil),                       // 23: krypton.dsts.PingResponse
-	(*AppAuthenticationChallengeResponse)(nil), // 24: krypton.dsts.AppAuthenticationChallengeResponse
-	(*AppAuthenticationResponse)(nil),          // 25: krypton.dsts.AppAuthenticationResponse
+	(*StreamDevicesRequest)(nil),               // 3: krypton.dsts.StreamDevicesRequest
+	(*FindDevicesRequest)(nil),                 // 4: krypton.dsts.FindDevicesRequest
+	(*UpdateDeviceRequest)(nil),                // 5: krypton.dsts.UpdateDeviceRequest
+	(*BatchCreateDevicesRequest)(nil),          // 6: krypton.dsts.BatchCreateDevicesRequest
+	(*BatchUpdateDevicesRequest)(nil),          // 7: krypton.dsts.BatchUpdateDevicesRequest
+	(*DeleteDeviceRequest)(nil),                // 8: krypton.dsts.DeleteDeviceRequest
+	(*ListTombstonedDevicesRequest)(nil),       // 9: krypton.dsts.ListTombstonedDevicesRequest
+	(*RestoreDeviceRequest)(nil),               // 10: krypton.dsts.RestoreDeviceRequest
+	(*TransferDeviceRequest)(nil),              // 11: krypton.dsts.TransferDeviceRequest
+	(*GetDevicePostureRequest)(nil),            // 12: krypton.dsts.GetDevicePostureRequest
+	(*GetSigningKeyRequest)(nil),               // 13: krypton.dsts.GetSigningKeyRequest
+	(*CreateEnrollmentTokenRequest)(nil),       // 14: krypton.dsts.CreateEnrollmentTokenRequest
+	(*GetEnrollmentTokenRequest)(nil),          // 15: krypton.dsts.GetEnrollmentTokenRequest
+	(*DeleteEnrollmentTokenRequest)(nil),       // 16: krypton.dsts.DeleteEnrollmentTokenRequest
+	(*ValidateEnrollmentTokenRequest)(nil),     // 17: krypton.dsts.ValidateEnrollmentTokenRequest
+	(*CreateTokenPolicyRequest)(nil),           // 18: krypton.dsts.CreateTokenPolicyRequest
+	(*GetTokenPolicyRequest)(nil),              // 19: krypton.dsts.GetTokenPolicyRequest
+	(*ListTokenPoliciesRequest)(nil),           // 20: krypton.dsts.ListTokenPoliciesRequest
+	(*UpdateTokenPolicyRequest)(nil),           // 21: krypton.dsts.UpdateTokenPolicyRequest
+	(*DeleteTokenPolicyRequest)(nil),           // 22: krypton.dsts.DeleteTokenPolicyRequest
+	(*CreateDeviceGroupRequest)(nil),           // 23: krypton.dsts.CreateDeviceGroupRequest
+	(*GetDeviceGroupRequest)(nil),              // 24: krypton.dsts.GetDeviceGroupRequest
+	(*ListDeviceGroupsRequest)(nil),            // 25: krypton.dsts.ListDeviceGroupsRequest
+	(*DeleteDeviceGroupRequest)(nil),           // 26: krypton.dsts.DeleteDeviceGroupRequest
+	(*UpdateDeviceGroupMembersRequest)(nil),    // 27: krypton.dsts.UpdateDeviceGroupMembersRequest
+	(*ApplyDeviceGroupActionRequest)(nil),      // 28: krypton.dsts.ApplyDeviceGroupActionRequest
+	(*UpdateTenantStateRequest)(nil),           // 29: krypton.dsts.UpdateTenantStateRequest
+	(*PurgeTenantRequest)(nil),                 // 30: krypton.dsts.PurgeTenantRequest
+	(*GetTenantStatsRequest)(nil),              // 31: krypton.dsts.GetTenantStatsRequest
+	(*PingRequest)(nil),                        // 32: krypton.dsts.PingRequest
+	(*AppAuthenticationChallengeRequest)(nil),  // 33: krypton.dsts.AppAuthenticationChallengeRequest
+	(*AppAuthenticationRequest)(nil),           // 34: krypton.dsts.AppAuthenticationRequest
+	(*CreateDeviceResponse)(nil),               // 35: krypton.dsts.CreateDeviceResponse
+	(*GetDeviceResponse)(nil),                  // 36: krypton.dsts.GetDeviceResponse
+	(*ListDevicesResponse)(nil),                // 37: krypton.dsts.ListDevicesResponse
+	(*StreamDevicesResponse)(nil),              // 38: krypton.dsts.StreamDevicesResponse
+	(*FindDevicesResponse)(nil),                // 39: krypton.dsts.FindDevicesResponse
+	(*UpdateDeviceResponse)(nil),               // 40: krypton.dsts.UpdateDeviceResponse
+	(*BatchCreateDevicesResponse)(nil),         // 41: krypton.dsts.BatchCreateDevicesResponse
+	(*BatchUpdateDevicesResponse)(nil),         // 42: krypton.dsts.BatchUpdateDevicesResponse
+	(*DeleteDeviceResponse)(nil),               // 43: krypton.dsts.DeleteDeviceResponse
+	(*ListTombstonedDevicesResponse)(nil),      // 44: krypton.dsts.ListTombstonedDevicesResponse
+	(*RestoreDeviceResponse)(nil),              // 45: krypton.dsts.RestoreDeviceResponse
+	(*TransferDeviceResponse)(nil),             // 46: krypton.dsts.TransferDeviceResponse
+	(*GetDevicePostureResponse)(nil),           // 47: krypton.dsts.GetDevicePostureResponse
+	(*GetSigningKeyResponse)(nil),              // 48: krypton.dsts.GetSigningKeyResponse
+	(*CreateEnrollmentTokenResponse)(nil),      // 49: krypton.dsts.CreateEnrollmentTokenResponse
+	(*GetEnrollmentTokenResponse)(nil),         // 50: krypton.dsts.GetEnrollmentTokenResponse
+	(*DeleteEnrollmentTokenResponse)(nil),      // 51: krypton.dsts.DeleteEnrollmentTokenResponse
+	(*ValidateEnrollmentTokenResponse)(nil),    // 52: krypton.dsts.ValidateEnrollmentTokenResponse
+	(*CreateTokenPolicyResponse)(nil),          // 53: krypton.dsts.CreateTokenPolicyResponse
+	(*GetTokenPolicyResponse)(nil),             // 54: krypton.dsts.GetTokenPolicyResponse
+	(*ListTokenPoliciesResponse)(nil),          // 55: krypton.dsts.ListTokenPoliciesResponse
+	(*UpdateTokenPolicyResponse)(nil),          // 56: krypton.dsts.UpdateTokenPolicyResponse
+	(*DeleteTokenPolicyResponse)(nil),          // 57: krypton.dsts.DeleteTokenPolicyResponse
+	(*CreateDeviceGroupResponse)(nil),          // 58: krypton.dsts.CreateDeviceGroupResponse
+	(*GetDeviceGroupResponse)(nil),             // 59: krypton.dsts.GetDeviceGroupResponse
+	(*ListDeviceGroupsResponse)(nil),           // 60: krypton.dsts.ListDeviceGroupsResponse
+	(*DeleteDeviceGroupResponse)(nil),          // 61: krypton.dsts.DeleteDeviceGroupResponse
+	(*UpdateDeviceGroupMembersResponse)(nil),   // 62: krypton.dsts.UpdateDeviceGroupMembersResponse
+	(*ApplyDeviceGroupActionResponse)(nil),     // 63: krypton.dsts.ApplyDeviceGroupActionResponse
+	(*UpdateTenantStateResponse)(nil),          // 64: krypton.dsts.UpdateTenantStateResponse
+	(*PurgeTenantResponse)(nil),                // 65: krypton.dsts.PurgeTenantResponse
+	(*GetTenantStatsResponse)(nil),             // 66: krypton.dsts.GetTenantStatsResponse
+	(*PingResponse)(nil),                       // 67: krypton.dsts.PingResponse
+	(*AppAuthenticationChallengeResponse)(nil), // 68: krypton.dsts.AppAuthenticationChallengeResponse
+	(*AppAuthenticationResponse)(nil),          // 69: krypton.dsts.AppAuthenticationResponse
 }
 var file_dsts_proto_depIdxs = []int32{
 	0,  // 0: krypton.dsts.DeviceSTS.CreateDevice:input_type -> krypton.dsts.CreateDeviceRequest
 	1,  // 1: krypton.dsts.DeviceSTS.GetDevice:input_type -> krypton.dsts.GetDeviceRequest
 	2,  // 2: krypton.dsts.DeviceSTS.ListDevices:input_type -> krypton.dsts.ListDevicesRequest
-	3,  // 3: krypton.dsts.DeviceSTS.UpdateDevice:input_type -> krypton.dsts.UpdateDeviceRequest
-	4,  // 4: krypton.dsts.DeviceSTS.DeleteDevice:input_type -> krypton.dsts.DeleteDeviceRequest
-	5,  // 5: krypton.dsts.DeviceSTS.GetSigningKey:input_type -> krypton.dsts.GetSigningKeyRequest
-	6,  // 6: krypton.dsts.DeviceSTS.CreateEnrollmentToken:input_type -> krypton.dsts.CreateEnrollmentTokenRequest
-	7,  // 7: krypton.dsts.DeviceSTS.GetEnrollmentToken:input_type -> krypton.dsts.GetEnrollmentTokenRequest
-	8,  // 8: krypton.dsts.DeviceSTS.DeleteEnrollmentToken:input_type -> krypton.dsts.DeleteEnrollmentTokenRequest
-	9,  // 9: krypton.dsts.DeviceSTS.ValidateEnrollmentToken:input_type -> krypton.dsts.ValidateEnrollmentTokenRequest
-	10, // 10: krypton.dsts.DeviceSTS.Ping:input_type -> krypton.dsts.PingRequest
-	11, // 11: krypton.dsts.DeviceSTS.GetAppAuthenticationChallenge:input_type -> krypton.dsts.AppAuthenticationChallengeRequest
-	12, // 12: krypton.dsts.DeviceSTS.AuthenticateApp:input_type -> krypton.dsts.AppAuthenticationRequest
-	13, // 13: krypton.dsts.DeviceSTS.CreateDevice:output_type -> krypton.dsts.CreateDeviceResponse
-	14, // 14: krypton.dsts.DeviceSTS.GetDevice:output_type -> krypton.dsts.GetDeviceResponse
-	15, // 15: krypton.dsts.DeviceSTS.ListDevices:output_type -> krypton.dsts.ListDevicesResponse
-	16, // 16: krypton.dsts.DeviceSTS.UpdateDevice:output_type -> krypton.dsts.UpdateDeviceResponse
-	17, // 17: krypton.dsts.DeviceSTS.DeleteDevice:output_type -> krypton.dsts.DeleteDeviceResponse
-	18, // 18: krypton.dsts.DeviceSTS.GetSigningKey:output_type -> krypton.dsts.GetSigningKeyResponse
-	19, // 19: krypton.dsts.DeviceSTS.CreateEnrollmentToken:output_type -> krypton.dsts.CreateEnrollmentTokenResponse
-	20, // 20: krypton.dsts.DeviceSTS.GetEnrollmentToken:output_type -> krypton.dsts.GetEnrollmentTokenResponse
-	21, // 21: krypton.dsts.DeviceSTS.DeleteEnrollmentToken:output_type -> krypton.dsts.DeleteEnrollmentTokenResponse
-	22, // 22: krypton.dsts.DeviceSTS.ValidateEnrollmentToken:output_type -> krypton.dsts.ValidateEnrollmentTokenResponse
-	23, // 23: krypton.dsts.DeviceSTS.Ping:output_type -> krypton.dsts.PingResponse
-	24, // 24: krypton.dsts.DeviceSTS.GetAppAuthenticationChallenge:output_type -> krypton.dsts.AppAuthenticationChallengeResponse
-	25, // 25: krypton.dsts.DeviceSTS.AuthenticateApp:output_type -> krypton.dsts.AppAuthenticationResponse
-	13, // [13:26] is the sub-list for method output_type
-	0,  // [0:13] is the sub-list for method input_type
+	3,  // 3: krypton.dsts.DeviceSTS.StreamDevices:input_type -> krypton.dsts.StreamDevicesRequest
+	4,  // 4: krypton.dsts.DeviceSTS.FindDevices:input_type -> krypton.dsts.FindDevicesRequest
+	5,  // 5: krypton.dsts.DeviceSTS.UpdateDevice:input_type -> krypton.dsts.UpdateDeviceRequest
+	6,  // 6: krypton.dsts.DeviceSTS.BatchCreateDevices:input_type -> krypton.dsts.BatchCreateDevicesRequest
+	7,  // 7: krypton.dsts.DeviceSTS.BatchUpdateDevices:input_type -> krypton.dsts.BatchUpdateDevicesRequest
+	6,  // 8: krypton.dsts.DeviceSTS.BatchCreateDevicesStream:input_type -> krypton.dsts.BatchCreateDevicesRequest
+	7,  // 9: krypton.dsts.DeviceSTS.BatchUpdateDevicesStream:input_type -> krypton.dsts.BatchUpdateDevicesRequest
+	8,  // 10: krypton.dsts.DeviceSTS.DeleteDevice:input_type -> krypton.dsts.DeleteDeviceRequest
+	9,  // 11: krypton.dsts.DeviceSTS.ListTombstonedDevices:input_type -> krypton.dsts.ListTombstonedDevicesRequest
+	10, // 12: krypton.dsts.DeviceSTS.RestoreDevice:input_type -> krypton.dsts.RestoreDeviceRequest
+	11, // 13: krypton.dsts.DeviceSTS.TransferDevice:input_type -> krypton.dsts.TransferDeviceRequest
+	12, // 14: krypton.dsts.DeviceSTS.GetDevicePosture:input_type -> krypton.dsts.GetDevicePostureRequest
+	13, // 15: krypton.dsts.DeviceSTS.GetSigningKey:input_type -> krypton.dsts.GetSigningKeyRequest
+	14, // 16: krypton.dsts.DeviceSTS.CreateEnrollmentToken:input_type -> krypton.dsts.CreateEnrollmentTokenRequest
+	15, // 17: krypton.dsts.DeviceSTS.GetEnrollmentToken:input_type -> krypton.dsts.GetEnrollmentTokenRequest
+	16, // 18: krypton.dsts.DeviceSTS.DeleteEnrollmentToken:input_type -> krypton.dsts.DeleteEnrollmentTokenRequest
+	17, // 19: krypton.dsts.DeviceSTS.ValidateEnrollmentToken:input_type -> krypton.dsts.ValidateEnrollmentTokenRequest
+	18, // 20: krypton.dsts.DeviceSTS.CreateTokenPolicy:input_type -> krypton.dsts.CreateTokenPolicyRequest
+	19, // 21: krypton.dsts.DeviceSTS.GetTokenPolicy:input_type -> krypton.dsts.GetTokenPolicyRequest
+	20, // 22: krypton.dsts.DeviceSTS.ListTokenPolicies:input_type -> krypton.dsts.ListTokenPoliciesRequest
+	21, // 23: krypton.dsts.DeviceSTS.UpdateTokenPolicy:input_type -> krypton.dsts.UpdateTokenPolicyRequest
+	22, // 24: krypton.dsts.DeviceSTS.DeleteTokenPolicy:input_type -> krypton.dsts.DeleteTokenPolicyRequest
+	23, // 25: krypton.dsts.DeviceSTS.CreateDeviceGroup:input_type -> krypton.dsts.CreateDeviceGroupRequest
+	24, // 26: krypton.dsts.DeviceSTS.GetDeviceGroup:input_type -> krypton.dsts.GetDeviceGroupRequest
+	25, // 27: krypton.dsts.DeviceSTS.ListDeviceGroups:input_type -> krypton.dsts.ListDeviceGroupsRequest
+	26, // 28: krypton.dsts.DeviceSTS.DeleteDeviceGroup:input_type -> krypton.dsts.DeleteDeviceGroupRequest
+	27, // 29: krypton.dsts.DeviceSTS.AddDeviceGroupMembers:input_type -> krypton.dsts.UpdateDeviceGroupMembersRequest
+	27, // 30: krypton.dsts.DeviceSTS.RemoveDeviceGroupMembers:input_type -> krypton.dsts.UpdateDeviceGroupMembersRequest
+	28, // 31: krypton.dsts.DeviceSTS.ApplyDeviceGroupAction:input_type -> krypton.dsts.ApplyDeviceGroupActionRequest
+	29, // 32: krypton.dsts.DeviceSTS.UpdateTenantState:input_type -> krypton.dsts.UpdateTenantStateRequest
+	30, // 33: krypton.dsts.DeviceSTS.PurgeTenant:input_type -> krypton.dsts.PurgeTenantRequest
+	31, // 34: krypton.dsts.DeviceSTS.GetTenantStats:input_type -> krypton.dsts.GetTenantStatsRequest
+	32, // 35: krypton.dsts.DeviceSTS.Ping:input_type -> krypton.dsts.PingRequest
+	33, // 36: krypton.dsts.DeviceSTS.GetAppAuthenticationChallenge:input_type -> krypton.dsts.AppAuthenticationChallengeRequest
+	34, // 37: krypton.dsts.DeviceSTS.AuthenticateApp:input_type -> krypton.dsts.AppAuthenticationRequest
+	35, // 38: krypton.dsts.DeviceSTS.CreateDevice:output_type -> krypton.dsts.CreateDeviceResponse
+	36, // 39: krypton.dsts.DeviceSTS.GetDevice:output_type -> krypton.dsts.GetDeviceResponse
+	37, // 40: krypton.dsts.DeviceSTS.ListDevices:output_type -> krypton.dsts.ListDevicesResponse
+	38, // 41: krypton.dsts.DeviceSTS.StreamDevices:output_type -> krypton.dsts.StreamDevicesResponse
+	39, // 42: krypton.dsts.DeviceSTS.FindDevices:output_type -> krypton.dsts.FindDevicesResponse
+	40, // 43: krypton.dsts.DeviceSTS.UpdateDevice:output_type -> krypton.dsts.UpdateDeviceResponse
+	41, // 44: krypton.dsts.DeviceSTS.BatchCreateDevices:output_type -> krypton.dsts.BatchCreateDevicesResponse
+	42, // 45: krypton.dsts.DeviceSTS.BatchUpdateDevices:output_type -> krypton.dsts.BatchUpdateDevicesResponse
+	41, // 46: krypton.dsts.DeviceSTS.BatchCreateDevicesStream:output_type -> krypton.dsts.BatchCreateDevicesResponse
+	42, // 47: krypton.dsts.DeviceSTS.BatchUpdateDevicesStream:output_type -> krypton.dsts.BatchUpdateDevicesResponse
+	43, // 48: krypton.dsts.DeviceSTS.DeleteDevice:output_type -> krypton.dsts.DeleteDeviceResponse
+	44, // 49: krypton.dsts.DeviceSTS.ListTombstonedDevices:output_type -> krypton.dsts.ListTombstonedDevicesResponse
+	45, // 50: krypton.dsts.DeviceSTS.RestoreDevice:output_type -> krypton.dsts.RestoreDeviceResponse
+	46, // 51: krypton.dsts.DeviceSTS.TransferDevice:output_type -> krypton.dsts.TransferDeviceResponse
+	47, // 52: krypton.dsts.DeviceSTS.GetDevicePosture:output_type -> krypton.dsts.GetDevicePostureResponse
+	48, // 53: krypton.dsts.DeviceSTS.GetSigningKey:output_type -> krypton.dsts.GetSigningKeyResponse
+	49, // 54: krypton.dsts.DeviceSTS.CreateEnrollmentToken:output_type -> krypton.dsts.CreateEnrollmentTokenResponse
+	50, // 55: krypton.dsts.DeviceSTS.GetEnrollmentToken:output_type -> krypton.dsts.GetEnrollmentTokenResponse
+	51, // 56: krypton.dsts.DeviceSTS.DeleteEnrollmentToken:output_type -> krypton.dsts.DeleteEnrollmentTokenResponse
+	52, // 57: krypton.dsts.DeviceSTS.ValidateEnrollmentToken:output_type -> krypton.dsts.ValidateEnrollmentTokenResponse
+	53, // 58: krypton.dsts.DeviceSTS.CreateTokenPolicy:output_type -> krypton.dsts.CreateTokenPolicyResponse
+	54, // 59: krypton.dsts.DeviceSTS.GetTokenPolicy:output_type -> krypton.dsts.GetTokenPolicyResponse
+	55, // 60: krypton.dsts.DeviceSTS.ListTokenPolicies:output_type -> krypton.dsts.ListTokenPoliciesResponse
+	56, // 61: krypton.dsts.DeviceSTS.UpdateTokenPolicy:output_type -> krypton.dsts.UpdateTokenPolicyResponse
+	57, // 62: krypton.dsts.DeviceSTS.DeleteTokenPolicy:output_type -> krypton.dsts.DeleteTokenPolicyResponse
+	58, // 63: krypton.dsts.DeviceSTS.CreateDeviceGroup:output_type -> krypton.dsts.CreateDeviceGroupResponse
+	59, // 64: krypton.dsts.DeviceSTS.GetDeviceGroup:output_type -> krypton.dsts.GetDeviceGroupResponse
+	60, // 65: krypton.dsts.DeviceSTS.ListDeviceGroups:output_type -> krypton.dsts.ListDeviceGroupsResponse
+	61, // 66: krypton.dsts.DeviceSTS.DeleteDeviceGroup:output_type -> krypton.dsts.DeleteDeviceGroupResponse
+	62, // 67: krypton.dsts.DeviceSTS.AddDeviceGroupMembers:output_type -> krypton.dsts.UpdateDeviceGroupMembersResponse
+	62, // 68: krypton.dsts.DeviceSTS.RemoveDeviceGroupMembers:output_type -> krypton.dsts.UpdateDeviceGroupMembersResponse
+	63, // 69: krypton.dsts.DeviceSTS.ApplyDeviceGroupAction:output_type -> krypton.dsts.ApplyDeviceGroupActionResponse
+	64, // 70: krypton.dsts.DeviceSTS.UpdateTenantState:output_type -> krypton.dsts.UpdateTenantStateResponse
+	65, // 71: krypton.dsts.DeviceSTS.PurgeTenant:output_type -> krypton.dsts.PurgeTenantResponse
+	66, // 72: krypton.dsts.DeviceSTS.GetTenantStats:output_type -> krypton.dsts.GetTenantStatsResponse
+	67, // 73: krypton.dsts.DeviceSTS.Ping:output_type -> krypton.dsts.PingResponse
+	68, // 74: krypton.dsts.DeviceSTS.GetAppAuthenticationChallenge:output_type -> krypton.dsts.AppAuthenticationChallengeResponse
+	69, // 75: krypton.dsts.DeviceSTS.AuthenticateApp:output_type -> krypton.dsts.AppAuthenticationResponse
+	38, // [38:76] is the sub-list for method output_type
+	0,  // [0:38] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -188,6 +449,10 @@ func file_dsts_proto_init() {
 	file_signing_key_proto_init()
 	file_enrollment_token_proto_init()
 	file_app_auth_proto_init()
+	file_device_posture_proto_init()
+	file_token_policy_proto_init()
+	file_device_group_proto_init()
+	file_tenant_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
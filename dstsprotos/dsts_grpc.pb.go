@@ -26,14 +26,42 @@ type DeviceSTSClient interface {
 	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CreateDeviceResponse, error)
 	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error)
 	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	StreamDevices(ctx context.Context, in *StreamDevicesRequest, opts ...grpc.CallOption) (DeviceSTS_StreamDevicesClient, error)
+	FindDevices(ctx context.Context, in *FindDevicesRequest, opts ...grpc.CallOption) (*FindDevicesResponse, error)
 	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*UpdateDeviceResponse, error)
+	BatchCreateDevices(ctx context.Context, in *BatchCreateDevicesRequest, opts ...grpc.CallOption) (*BatchCreateDevicesResponse, error)
+	BatchUpdateDevices(ctx context.Context, in *BatchUpdateDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateDevicesResponse, error)
+	BatchCreateDevicesStream(ctx context.Context, opts ...grpc.CallOption) (DeviceSTS_BatchCreateDevicesStreamClient, error)
+	BatchUpdateDevicesStream(ctx context.Context, opts ...grpc.CallOption) (DeviceSTS_BatchUpdateDevicesStreamClient, error)
 	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error)
+	ListTombstonedDevices(ctx context.Context, in *ListTombstonedDevicesRequest, opts ...grpc.CallOption) (*ListTombstonedDevicesResponse, error)
+	RestoreDevice(ctx context.Context, in *RestoreDeviceRequest, opts ...grpc.CallOption) (*RestoreDeviceResponse, error)
+	TransferDevice(ctx context.Context, in *TransferDeviceRequest, opts ...grpc.CallOption) (*TransferDeviceResponse, error)
+	GetDevicePosture(ctx context.Context, in *GetDevicePostureRequest, opts ...grpc.CallOption) (*GetDevicePostureResponse, error)
 	// Device STS - token service RPCs.
 	GetSigningKey(ctx context.Context, in *GetSigningKeyRequest, opts ...grpc.CallOption) (*GetSigningKeyResponse, error)
 	CreateEnrollmentToken(ctx context.Context, in *CreateEnrollmentTokenRequest, opts ...grpc.CallOption) (*CreateEnrollmentTokenResponse, error)
 	GetEnrollmentToken(ctx context.Context, in *GetEnrollmentTokenRequest, opts ...grpc.CallOption) (*GetEnrollmentTokenResponse, error)
 	DeleteEnrollmentToken(ctx context.Context, in *DeleteEnrollmentTokenRequest, opts ...grpc.CallOption) (*DeleteEnrollmentTokenResponse, error)
 	ValidateEnrollmentToken(ctx context.Context, in *ValidateEnrollmentTokenRequest, opts ...grpc.CallOption) (*ValidateEnrollmentTokenResponse, error)
+	// Token policy management RPCs.
+	CreateTokenPolicy(ctx context.Context, in *CreateTokenPolicyRequest, opts ...grpc.CallOption) (*CreateTokenPolicyResponse, error)
+	GetTokenPolicy(ctx context.Context, in *GetTokenPolicyRequest, opts ...grpc.CallOption) (*GetTokenPolicyResponse, error)
+	ListTokenPolicies(ctx context.Context, in *ListTokenPoliciesRequest, opts ...grpc.CallOption) (*ListTokenPoliciesResponse, error)
+	UpdateTokenPolicy(ctx context.Context, in *UpdateTokenPolicyRequest, opts ...grpc.CallOption) (*UpdateTokenPolicyResponse, error)
+	DeleteTokenPolicy(ctx context.Context, in *DeleteTokenPolicyRequest, opts ...grpc.CallOption) (*DeleteTokenPolicyResponse, error)
+	// Device group management RPCs.
+	CreateDeviceGroup(ctx context.Context, in *CreateDeviceGroupRequest, opts ...grpc.CallOption) (*CreateDeviceGroupResponse, error)
+	GetDeviceGroup(ctx context.Context, in *GetDeviceGroupRequest, opts ...grpc.CallOption) (*GetDeviceGroupResponse, error)
+	ListDeviceGroups(ctx context.Context, in *ListDeviceGroupsRequest, opts ...grpc.CallOption) (*ListDeviceGroupsResponse, error)
+	DeleteDeviceGroup(ctx context.Context, in *DeleteDeviceGroupRequest, opts ...grpc.CallOption) (*DeleteDeviceGroupResponse, error)
+	AddDeviceGroupMembers(ctx context.Context, in *UpdateDeviceGroupMembersRequest, opts ...grpc.CallOption) (*UpdateDeviceGroupMembersResponse, error)
+	RemoveDeviceGroupMembers(ctx context.Context, in *UpdateDeviceGroupMembersRequest, opts ...grpc.CallOption) (*UpdateDeviceGroupMembersResponse, error)
+	ApplyDeviceGroupAction(ctx context.Context, in *ApplyDeviceGroupActionRequest, opts ...grpc.CallOption) (*ApplyDeviceGroupActionResponse, error)
+	// Tenant lifecycle management RPCs.
+	UpdateTenantState(ctx context.Context, in *UpdateTenantStateRequest, opts ...grpc.CallOption) (*UpdateTenantStateResponse, error)
+	PurgeTenant(ctx context.Context, in *PurgeTenantRequest, opts ...grpc.CallOption) (*PurgeTenantResponse, error)
+	GetTenantStats(ctx context.Context, in *GetTenantStatsRequest, opts ...grpc.CallOption) (*GetTenantStatsResponse, error)
 	// Health check/uptime check RPC.
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// App authentication RPCs.
@@ -76,6 +104,47 @@ func (c *deviceSTSClient) ListDevices(ctx context.Context, in *ListDevicesReques
 	return out, nil
 }
 
+func (c *deviceSTSClient) StreamDevices(ctx context.Context, in *StreamDevicesRequest, opts ...grpc.CallOption) (DeviceSTS_StreamDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceSTS_ServiceDesc.Streams[0], "/krypton.dsts.DeviceSTS/StreamDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceSTSStreamDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceSTS_StreamDevicesClient interface {
+	Recv() (*StreamDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type deviceSTSStreamDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceSTSStreamDevicesClient) Recv() (*StreamDevicesResponse, error) {
+	m := new(StreamDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceSTSClient) FindDevices(ctx context.Context, in *FindDevicesRequest, opts ...grpc.CallOption) (*FindDevicesResponse, error) {
+	out := new(FindDevicesResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/FindDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceSTSClient) UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*UpdateDeviceResponse, error) {
 	out := new(UpdateDeviceResponse)
 	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/UpdateDevice", in, out, opts...)
@@ -85,6 +154,92 @@ func (c *deviceSTSClient) UpdateDevice(ctx context.Context, in *UpdateDeviceRequ
 	return out, nil
 }
 
+func (c *deviceSTSClient) BatchCreateDevices(ctx context.Context, in *BatchCreateDevicesRequest, opts ...grpc.CallOption) (*BatchCreateDevicesResponse, error) {
+	out := new(BatchCreateDevicesResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/BatchCreateDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) BatchUpdateDevices(ctx context.Context, in *BatchUpdateDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateDevicesResponse, error) {
+	out := new(BatchUpdateDevicesResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/BatchUpdateDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) BatchCreateDevicesStream(ctx context.Context, opts ...grpc.CallOption) (DeviceSTS_BatchCreateDevicesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceSTS_ServiceDesc.Streams[1], "/krypton.dsts.DeviceSTS/BatchCreateDevicesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceSTSBatchCreateDevicesStreamClient{stream}
+	return x, nil
+}
+
+type DeviceSTS_BatchCreateDevicesStreamClient interface {
+	Send(*BatchCreateDevicesRequest) error
+	CloseAndRecv() (*BatchCreateDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type deviceSTSBatchCreateDevicesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceSTSBatchCreateDevicesStreamClient) Send(m *BatchCreateDevicesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deviceSTSBatchCreateDevicesStreamClient) CloseAndRecv() (*BatchCreateDevicesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BatchCreateDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceSTSClient) BatchUpdateDevicesStream(ctx context.Context, opts ...grpc.CallOption) (DeviceSTS_BatchUpdateDevicesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceSTS_ServiceDesc.Streams[2], "/krypton.dsts.DeviceSTS/BatchUpdateDevicesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceSTSBatchUpdateDevicesStreamClient{stream}
+	return x, nil
+}
+
+type DeviceSTS_BatchUpdateDevicesStreamClient interface {
+	Send(*BatchUpdateDevicesRequest) error
+	CloseAndRecv() (*BatchUpdateDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type deviceSTSBatchUpdateDevicesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceSTSBatchUpdateDevicesStreamClient) Send(m *BatchUpdateDevicesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deviceSTSBatchUpdateDevicesStreamClient) CloseAndRecv() (*BatchUpdateDevicesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BatchUpdateDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *deviceSTSClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error) {
 	out := new(DeleteDeviceResponse)
 	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/DeleteDevice", in, out, opts...)
@@ -94,6 +249,42 @@ func (c *deviceSTSClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequ
 	return out, nil
 }
 
+func (c *deviceSTSClient) ListTombstonedDevices(ctx context.Context, in *ListTombstonedDevicesRequest, opts ...grpc.CallOption) (*ListTombstonedDevicesResponse, error) {
+	out := new(ListTombstonedDevicesResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/ListTombstonedDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) RestoreDevice(ctx context.Context, in *RestoreDeviceRequest, opts ...grpc.CallOption) (*RestoreDeviceResponse, error) {
+	out := new(RestoreDeviceResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/RestoreDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) TransferDevice(ctx context.Context, in *TransferDeviceRequest, opts ...grpc.CallOption) (*TransferDeviceResponse, error) {
+	out := new(TransferDeviceResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/TransferDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) GetDevicePosture(ctx context.Context, in *GetDevicePostureRequest, opts ...grpc.CallOption) (*GetDevicePostureResponse, error) {
+	out := new(GetDevicePostureResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/GetDevicePosture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceSTSClient) GetSigningKey(ctx context.Context, in *GetSigningKeyRequest, opts ...grpc.CallOption) (*GetSigningKeyResponse, error) {
 	out := new(GetSigningKeyResponse)
 	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/GetSigningKey", in, out, opts...)
@@ -139,6 +330,141 @@ func (c *deviceSTSClient) ValidateEnrollmentToken(ctx context.Context, in *Valid
 	return out, nil
 }
 
+func (c *deviceSTSClient) CreateTokenPolicy(ctx context.Context, in *CreateTokenPolicyRequest, opts ...grpc.CallOption) (*CreateTokenPolicyResponse, error) {
+	out := new(CreateTokenPolicyResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/CreateTokenPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) GetTokenPolicy(ctx context.Context, in *GetTokenPolicyRequest, opts ...grpc.CallOption) (*GetTokenPolicyResponse, error) {
+	out := new(GetTokenPolicyResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/GetTokenPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) ListTokenPolicies(ctx context.Context, in *ListTokenPoliciesRequest, opts ...grpc.CallOption) (*ListTokenPoliciesResponse, error) {
+	out := new(ListTokenPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/ListTokenPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) UpdateTokenPolicy(ctx context.Context, in *UpdateTokenPolicyRequest, opts ...grpc.CallOption) (*UpdateTokenPolicyResponse, error) {
+	out := new(UpdateTokenPolicyResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/UpdateTokenPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) DeleteTokenPolicy(ctx context.Context, in *DeleteTokenPolicyRequest, opts ...grpc.CallOption) (*DeleteTokenPolicyResponse, error) {
+	out := new(DeleteTokenPolicyResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/DeleteTokenPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) CreateDeviceGroup(ctx context.Context, in *CreateDeviceGroupRequest, opts ...grpc.CallOption) (*CreateDeviceGroupResponse, error) {
+	out := new(CreateDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/CreateDeviceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) GetDeviceGroup(ctx context.Context, in *GetDeviceGroupRequest, opts ...grpc.CallOption) (*GetDeviceGroupResponse, error) {
+	out := new(GetDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/GetDeviceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) ListDeviceGroups(ctx context.Context, in *ListDeviceGroupsRequest, opts ...grpc.CallOption) (*ListDeviceGroupsResponse, error) {
+	out := new(ListDeviceGroupsResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/ListDeviceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) DeleteDeviceGroup(ctx context.Context, in *DeleteDeviceGroupRequest, opts ...grpc.CallOption) (*DeleteDeviceGroupResponse, error) {
+	out := new(DeleteDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/DeleteDeviceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) AddDeviceGroupMembers(ctx context.Context, in *UpdateDeviceGroupMembersRequest, opts ...grpc.CallOption) (*UpdateDeviceGroupMembersResponse, error) {
+	out := new(UpdateDeviceGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/AddDeviceGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) RemoveDeviceGroupMembers(ctx context.Context, in *UpdateDeviceGroupMembersRequest, opts ...grpc.CallOption) (*UpdateDeviceGroupMembersResponse, error) {
+	out := new(UpdateDeviceGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/RemoveDeviceGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) ApplyDeviceGroupAction(ctx context.Context, in *ApplyDeviceGroupActionRequest, opts ...grpc.CallOption) (*ApplyDeviceGroupActionResponse, error) {
+	out := new(ApplyDeviceGroupActionResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/ApplyDeviceGroupAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) UpdateTenantState(ctx context.Context, in *UpdateTenantStateRequest, opts ...grpc.CallOption) (*UpdateTenantStateResponse, error) {
+	out := new(UpdateTenantStateResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/UpdateTenantState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) PurgeTenant(ctx context.Context, in *PurgeTenantRequest, opts ...grpc.CallOption) (*PurgeTenantResponse, error) {
+	out := new(PurgeTenantResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/PurgeTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSTSClient) GetTenantStats(ctx context.Context, in *GetTenantStatsRequest, opts ...grpc.CallOption) (*GetTenantStatsResponse, error) {
+	out := new(GetTenantStatsResponse)
+	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/GetTenantStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceSTSClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
 	out := new(PingResponse)
 	err := c.cc.Invoke(ctx, "/krypton.dsts.DeviceSTS/Ping", in, out, opts...)
@@ -174,14 +500,42 @@ type DeviceSTSServer interface {
 	CreateDevice(context.Context, *CreateDeviceRequest) (*CreateDeviceResponse, error)
 	GetDevice(context.Context, *GetDeviceRequest) (*GetDeviceResponse, error)
 	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	StreamDevices(*StreamDevicesRequest, DeviceSTS_StreamDevicesServer) error
+	FindDevices(context.Context, *FindDevicesRequest) (*FindDevicesResponse, error)
 	UpdateDevice(context.Context, *UpdateDeviceRequest) (*UpdateDeviceResponse, error)
+	BatchCreateDevices(context.Context, *BatchCreateDevicesRequest) (*BatchCreateDevicesResponse, error)
+	BatchUpdateDevices(context.Context, *BatchUpdateDevicesRequest) (*BatchUpdateDevicesResponse, error)
+	BatchCreateDevicesStream(DeviceSTS_BatchCreateDevicesStreamServer) error
+	BatchUpdateDevicesStream(DeviceSTS_BatchUpdateDevicesStreamServer) error
 	DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error)
+	ListTombstonedDevices(context.Context, *ListTombstonedDevicesRequest) (*ListTombstonedDevicesResponse, error)
+	RestoreDevice(context.Context, *RestoreDeviceRequest) (*RestoreDeviceResponse, error)
+	TransferDevice(context.Context, *TransferDeviceRequest) (*TransferDeviceResponse, error)
+	GetDevicePosture(context.Context, *GetDevicePostureRequest) (*GetDevicePostureResponse, error)
 	// Device STS - token service RPCs.
 	GetSigningKey(context.Context, *GetSigningKeyRequest) (*GetSigningKeyResponse, error)
 	CreateEnrollmentToken(context.Context, *CreateEnrollmentTokenRequest) (*CreateEnrollmentTokenResponse, error)
 	GetEnrollmentToken(context.Context, *GetEnrollmentTokenRequest) (*GetEnrollmentTokenResponse, error)
 	DeleteEnrollmentToken(context.Context, *DeleteEnrollmentTokenRequest) (*DeleteEnrollmentTokenResponse, error)
 	ValidateEnrollmentToken(context.Context, *ValidateEnrollmentTokenRequest) (*ValidateEnrollmentTokenResponse, error)
+	// Token policy management RPCs.
+	CreateTokenPolicy(context.Context, *CreateTokenPolicyRequest) (*CreateTokenPolicyResponse, error)
+	GetTokenPolicy(context.Context, *GetTokenPolicyRequest) (*GetTokenPolicyResponse, error)
+	ListTokenPolicies(context.Context, *ListTokenPoliciesRequest) (*ListTokenPoliciesResponse, error)
+	UpdateTokenPolicy(context.Context, *UpdateTokenPolicyRequest) (*UpdateTokenPolicyResponse, error)
+	DeleteTokenPolicy(context.Context, *DeleteTokenPolicyRequest) (*DeleteTokenPolicyResponse, error)
+	// Device group management RPCs.
+	CreateDeviceGroup(context.Context, *CreateDeviceGroupRequest) (*CreateDeviceGroupResponse, error)
+	GetDeviceGroup(context.Context, *GetDeviceGroupRequest) (*GetDeviceGroupResponse, error)
+	ListDeviceGroups(context.Context, *ListDeviceGroupsRequest) (*ListDeviceGroupsResponse, error)
+	DeleteDeviceGroup(context.Context, *DeleteDeviceGroupRequest) (*DeleteDeviceGroupResponse, error)
+	AddDeviceGroupMembers(context.Context, *UpdateDeviceGroupMembersRequest) (*UpdateDeviceGroupMembersResponse, error)
+	RemoveDeviceGroupMembers(context.Context, *UpdateDeviceGroupMembersRequest) (*UpdateDeviceGroupMembersResponse, error)
+	ApplyDeviceGroupAction(context.Context, *ApplyDeviceGroupActionRequest) (*ApplyDeviceGroupActionResponse, error)
+	// Tenant lifecycle management RPCs.
+	UpdateTenantState(context.Context, *UpdateTenantStateRequest) (*UpdateTenantStateResponse, error)
+	PurgeTenant(context.Context, *PurgeTenantRequest) (*PurgeTenantResponse, error)
+	GetTenantStats(context.Context, *GetTenantStatsRequest) (*GetTenantStatsResponse, error)
 	// Health check/uptime check RPC.
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// App authentication RPCs.
@@ -203,12 +557,42 @@ func (UnimplementedDeviceSTSServer) GetDevice(context.Context, *GetDeviceRequest
 func (UnimplementedDeviceSTSServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
 }
+func (UnimplementedDeviceSTSServer) StreamDevices(*StreamDevicesRequest, DeviceSTS_StreamDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDevices not implemented")
+}
+func (UnimplementedDeviceSTSServer) FindDevices(context.Context, *FindDevicesRequest) (*FindDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDevices not implemented")
+}
 func (UnimplementedDeviceSTSServer) UpdateDevice(context.Context, *UpdateDeviceRequest) (*UpdateDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
 }
+func (UnimplementedDeviceSTSServer) BatchCreateDevices(context.Context, *BatchCreateDevicesRequest) (*BatchCreateDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDevices not implemented")
+}
+func (UnimplementedDeviceSTSServer) BatchUpdateDevices(context.Context, *BatchUpdateDevicesRequest) (*BatchUpdateDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateDevices not implemented")
+}
+func (UnimplementedDeviceSTSServer) BatchCreateDevicesStream(DeviceSTS_BatchCreateDevicesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchCreateDevicesStream not implemented")
+}
+func (UnimplementedDeviceSTSServer) BatchUpdateDevicesStream(DeviceSTS_BatchUpdateDevicesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchUpdateDevicesStream not implemented")
+}
 func (UnimplementedDeviceSTSServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
 }
+func (UnimplementedDeviceSTSServer) ListTombstonedDevices(context.Context, *ListTombstonedDevicesRequest) (*ListTombstonedDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTombstonedDevices not implemented")
+}
+func (UnimplementedDeviceSTSServer) RestoreDevice(context.Context, *RestoreDeviceRequest) (*RestoreDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDevice not implemented")
+}
+func (UnimplementedDeviceSTSServer) TransferDevice(context.Context, *TransferDeviceRequest) (*TransferDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDevice not implemented")
+}
+func (UnimplementedDeviceSTSServer) GetDevicePosture(context.Context, *GetDevicePostureRequest) (*GetDevicePostureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevicePosture not implemented")
+}
 func (UnimplementedDeviceSTSServer) GetSigningKey(context.Context, *GetSigningKeyRequest) (*GetSigningKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKey not implemented")
 }
@@ -224,6 +608,51 @@ func (UnimplementedDeviceSTSServer) DeleteEnrollmentToken(context.Context, *Dele
 func (UnimplementedDeviceSTSServer) ValidateEnrollmentToken(context.Context, *ValidateEnrollmentTokenRequest) (*ValidateEnrollmentTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateEnrollmentToken not implemented")
 }
+func (UnimplementedDeviceSTSServer) CreateTokenPolicy(context.Context, *CreateTokenPolicyRequest) (*CreateTokenPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTokenPolicy not implemented")
+}
+func (UnimplementedDeviceSTSServer) GetTokenPolicy(context.Context, *GetTokenPolicyRequest) (*GetTokenPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenPolicy not implemented")
+}
+func (UnimplementedDeviceSTSServer) ListTokenPolicies(context.Context, *ListTokenPoliciesRequest) (*ListTokenPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenPolicies not implemented")
+}
+func (UnimplementedDeviceSTSServer) UpdateTokenPolicy(context.Context, *UpdateTokenPolicyRequest) (*UpdateTokenPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPolicy not implemented")
+}
+func (UnimplementedDeviceSTSServer) DeleteTokenPolicy(context.Context, *DeleteTokenPolicyRequest) (*DeleteTokenPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTokenPolicy not implemented")
+}
+func (UnimplementedDeviceSTSServer) CreateDeviceGroup(context.Context, *CreateDeviceGroupRequest) (*CreateDeviceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeviceGroup not implemented")
+}
+func (UnimplementedDeviceSTSServer) GetDeviceGroup(context.Context, *GetDeviceGroupRequest) (*GetDeviceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceGroup not implemented")
+}
+func (UnimplementedDeviceSTSServer) ListDeviceGroups(context.Context, *ListDeviceGroupsRequest) (*ListDeviceGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceGroups not implemented")
+}
+func (UnimplementedDeviceSTSServer) DeleteDeviceGroup(context.Context, *DeleteDeviceGroupRequest) (*DeleteDeviceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeviceGroup not implemented")
+}
+func (UnimplementedDeviceSTSServer) AddDeviceGroupMembers(context.Context, *UpdateDeviceGroupMembersRequest) (*UpdateDeviceGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeviceGroupMembers not implemented")
+}
+func (UnimplementedDeviceSTSServer) RemoveDeviceGroupMembers(context.Context, *UpdateDeviceGroupMembersRequest) (*UpdateDeviceGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeviceGroupMembers not implemented")
+}
+func (UnimplementedDeviceSTSServer) ApplyDeviceGroupAction(context.Context, *ApplyDeviceGroupActionRequest) (*ApplyDeviceGroupActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDeviceGroupAction not implemented")
+}
+func (UnimplementedDeviceSTSServer) UpdateTenantState(context.Context, *UpdateTenantStateRequest) (*UpdateTenantStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantState not implemented")
+}
+func (UnimplementedDeviceSTSServer) PurgeTenant(context.Context, *PurgeTenantRequest) (*PurgeTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTenant not implemented")
+}
+func (UnimplementedDeviceSTSServer) GetTenantStats(context.Context, *GetTenantStatsRequest) (*GetTenantStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantStats not implemented")
+}
 func (UnimplementedDeviceSTSServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
@@ -300,98 +729,297 @@ func _DeviceSTS_ListDevices_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DeviceSTS_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DeviceSTSServer).UpdateDevice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/krypton.dsts.DeviceSTS/UpdateDevice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceSTSServer).UpdateDevice(ctx, req.(*UpdateDeviceRequest))
+func _DeviceSTS_StreamDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(DeviceSTSServer).StreamDevices(m, &deviceSTSStreamDevicesServer{stream})
 }
 
-func _DeviceSTS_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDeviceRequest)
+type DeviceSTS_StreamDevicesServer interface {
+	Send(*StreamDevicesResponse) error
+	grpc.ServerStream
+}
+
+type deviceSTSStreamDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceSTSStreamDevicesServer) Send(m *StreamDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceSTS_FindDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DeviceSTSServer).DeleteDevice(ctx, in)
+		return srv.(DeviceSTSServer).FindDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/krypton.dsts.DeviceSTS/DeleteDevice",
+		FullMethod: "/krypton.dsts.DeviceSTS/FindDevices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceSTSServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+		return srv.(DeviceSTSServer).FindDevices(ctx, req.(*FindDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DeviceSTS_GetSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSigningKeyRequest)
+func _DeviceSTS_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DeviceSTSServer).GetSigningKey(ctx, in)
+		return srv.(DeviceSTSServer).UpdateDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/krypton.dsts.DeviceSTS/GetSigningKey",
+		FullMethod: "/krypton.dsts.DeviceSTS/UpdateDevice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceSTSServer).GetSigningKey(ctx, req.(*GetSigningKeyRequest))
+		return srv.(DeviceSTSServer).UpdateDevice(ctx, req.(*UpdateDeviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DeviceSTS_CreateEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEnrollmentTokenRequest)
+func _DeviceSTS_BatchCreateDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DeviceSTSServer).CreateEnrollmentToken(ctx, in)
+		return srv.(DeviceSTSServer).BatchCreateDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/krypton.dsts.DeviceSTS/CreateEnrollmentToken",
+		FullMethod: "/krypton.dsts.DeviceSTS/BatchCreateDevices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceSTSServer).CreateEnrollmentToken(ctx, req.(*CreateEnrollmentTokenRequest))
+		return srv.(DeviceSTSServer).BatchCreateDevices(ctx, req.(*BatchCreateDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DeviceSTS_GetEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnrollmentTokenRequest)
+func _DeviceSTS_BatchUpdateDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DeviceSTSServer).GetEnrollmentToken(ctx, in)
+		return srv.(DeviceSTSServer).BatchUpdateDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/krypton.dsts.DeviceSTS/GetEnrollmentToken",
+		FullMethod: "/krypton.dsts.DeviceSTS/BatchUpdateDevices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceSTSServer).GetEnrollmentToken(ctx, req.(*GetEnrollmentTokenRequest))
+		return srv.(DeviceSTSServer).BatchUpdateDevices(ctx, req.(*BatchUpdateDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DeviceSTS_DeleteEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEnrollmentTokenRequest)
+func _DeviceSTS_BatchCreateDevicesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceSTSServer).BatchCreateDevicesStream(&deviceSTSBatchCreateDevicesStreamServer{stream})
+}
+
+type DeviceSTS_BatchCreateDevicesStreamServer interface {
+	SendAndClose(*BatchCreateDevicesResponse) error
+	Recv() (*BatchCreateDevicesRequest, error)
+	grpc.ServerStream
+}
+
+type deviceSTSBatchCreateDevicesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceSTSBatchCreateDevicesStreamServer) SendAndClose(m *BatchCreateDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deviceSTSBatchCreateDevicesStreamServer) Recv() (*BatchCreateDevicesRequest, error) {
+	m := new(BatchCreateDevicesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DeviceSTS_BatchUpdateDevicesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceSTSServer).BatchUpdateDevicesStream(&deviceSTSBatchUpdateDevicesStreamServer{stream})
+}
+
+type DeviceSTS_BatchUpdateDevicesStreamServer interface {
+	SendAndClose(*BatchUpdateDevicesResponse) error
+	Recv() (*BatchUpdateDevicesRequest, error)
+	grpc.ServerStream
+}
+
+type deviceSTSBatchUpdateDevicesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceSTSBatchUpdateDevicesStreamServer) SendAndClose(m *BatchUpdateDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deviceSTSBatchUpdateDevicesStreamServer) Recv() (*BatchUpdateDevicesRequest, error) {
+	m := new(BatchUpdateDevicesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DeviceSTS_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/DeleteDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_ListTombstonedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTombstonedDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).ListTombstonedDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/ListTombstonedDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).ListTombstonedDevices(ctx, req.(*ListTombstonedDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_RestoreDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).RestoreDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/RestoreDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).RestoreDevice(ctx, req.(*RestoreDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_TransferDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).TransferDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/TransferDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).TransferDevice(ctx, req.(*TransferDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetDevicePosture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDevicePostureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetDevicePosture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetDevicePosture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetDevicePosture(ctx, req.(*GetDevicePostureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetSigningKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetSigningKey(ctx, req.(*GetSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_CreateEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnrollmentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).CreateEnrollmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/CreateEnrollmentToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).CreateEnrollmentToken(ctx, req.(*CreateEnrollmentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetEnrollmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetEnrollmentToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetEnrollmentToken(ctx, req.(*GetEnrollmentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_DeleteEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnrollmentTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -426,6 +1054,276 @@ func _DeviceSTS_ValidateEnrollmentToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceSTS_CreateTokenPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).CreateTokenPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/CreateTokenPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).CreateTokenPolicy(ctx, req.(*CreateTokenPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetTokenPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetTokenPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetTokenPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetTokenPolicy(ctx, req.(*GetTokenPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_ListTokenPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokenPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).ListTokenPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/ListTokenPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).ListTokenPolicies(ctx, req.(*ListTokenPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_UpdateTokenPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTokenPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).UpdateTokenPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/UpdateTokenPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).UpdateTokenPolicy(ctx, req.(*UpdateTokenPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_DeleteTokenPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTokenPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).DeleteTokenPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/DeleteTokenPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).DeleteTokenPolicy(ctx, req.(*DeleteTokenPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_CreateDeviceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).CreateDeviceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/CreateDeviceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).CreateDeviceGroup(ctx, req.(*CreateDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetDeviceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetDeviceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetDeviceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetDeviceGroup(ctx, req.(*GetDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_ListDeviceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).ListDeviceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/ListDeviceGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).ListDeviceGroups(ctx, req.(*ListDeviceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_DeleteDeviceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).DeleteDeviceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/DeleteDeviceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).DeleteDeviceGroup(ctx, req.(*DeleteDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_AddDeviceGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).AddDeviceGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/AddDeviceGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).AddDeviceGroupMembers(ctx, req.(*UpdateDeviceGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_RemoveDeviceGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).RemoveDeviceGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/RemoveDeviceGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).RemoveDeviceGroupMembers(ctx, req.(*UpdateDeviceGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_ApplyDeviceGroupAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDeviceGroupActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).ApplyDeviceGroupAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/ApplyDeviceGroupAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).ApplyDeviceGroupAction(ctx, req.(*ApplyDeviceGroupActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_UpdateTenantState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).UpdateTenantState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/UpdateTenantState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).UpdateTenantState(ctx, req.(*UpdateTenantStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_PurgeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).PurgeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/PurgeTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).PurgeTenant(ctx, req.(*PurgeTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSTS_GetTenantStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSTSServer).GetTenantStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/krypton.dsts.DeviceSTS/GetTenantStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSTSServer).GetTenantStats(ctx, req.(*GetTenantStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceSTS_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PingRequest)
 	if err := dec(in); err != nil {
@@ -499,14 +1397,42 @@ var DeviceSTS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDevices",
 			Handler:    _DeviceSTS_ListDevices_Handler,
 		},
+		{
+			MethodName: "FindDevices",
+			Handler:    _DeviceSTS_FindDevices_Handler,
+		},
 		{
 			MethodName: "UpdateDevice",
 			Handler:    _DeviceSTS_UpdateDevice_Handler,
 		},
+		{
+			MethodName: "BatchCreateDevices",
+			Handler:    _DeviceSTS_BatchCreateDevices_Handler,
+		},
+		{
+			MethodName: "BatchUpdateDevices",
+			Handler:    _DeviceSTS_BatchUpdateDevices_Handler,
+		},
 		{
 			MethodName: "DeleteDevice",
 			Handler:    _DeviceSTS_DeleteDevice_Handler,
 		},
+		{
+			MethodName: "ListTombstonedDevices",
+			Handler:    _DeviceSTS_ListTombstonedDevices_Handler,
+		},
+		{
+			MethodName: "RestoreDevice",
+			Handler:    _DeviceSTS_RestoreDevice_Handler,
+		},
+		{
+			MethodName: "TransferDevice",
+			Handler:    _DeviceSTS_TransferDevice_Handler,
+		},
+		{
+			MethodName: "GetDevicePosture",
+			Handler:    _DeviceSTS_GetDevicePosture_Handler,
+		},
 		{
 			MethodName: "GetSigningKey",
 			Handler:    _DeviceSTS_GetSigningKey_Handler,
@@ -527,6 +1453,66 @@ var DeviceSTS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateEnrollmentToken",
 			Handler:    _DeviceSTS_ValidateEnrollmentToken_Handler,
 		},
+		{
+			MethodName: "CreateTokenPolicy",
+			Handler:    _DeviceSTS_CreateTokenPolicy_Handler,
+		},
+		{
+			MethodName: "GetTokenPolicy",
+			Handler:    _DeviceSTS_GetTokenPolicy_Handler,
+		},
+		{
+			MethodName: "ListTokenPolicies",
+			Handler:    _DeviceSTS_ListTokenPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateTokenPolicy",
+			Handler:    _DeviceSTS_UpdateTokenPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteTokenPolicy",
+			Handler:    _DeviceSTS_DeleteTokenPolicy_Handler,
+		},
+		{
+			MethodName: "CreateDeviceGroup",
+			Handler:    _DeviceSTS_CreateDeviceGroup_Handler,
+		},
+		{
+			MethodName: "GetDeviceGroup",
+			Handler:    _DeviceSTS_GetDeviceGroup_Handler,
+		},
+		{
+			MethodName: "ListDeviceGroups",
+			Handler:    _DeviceSTS_ListDeviceGroups_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceGroup",
+			Handler:    _DeviceSTS_DeleteDeviceGroup_Handler,
+		},
+		{
+			MethodName: "AddDeviceGroupMembers",
+			Handler:    _DeviceSTS_AddDeviceGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveDeviceGroupMembers",
+			Handler:    _DeviceSTS_RemoveDeviceGroupMembers_Handler,
+		},
+		{
+			MethodName: "ApplyDeviceGroupAction",
+			Handler:    _DeviceSTS_ApplyDeviceGroupAction_Handler,
+		},
+		{
+			MethodName: "UpdateTenantState",
+			Handler:    _DeviceSTS_UpdateTenantState_Handler,
+		},
+		{
+			MethodName: "PurgeTenant",
+			Handler:    _DeviceSTS_PurgeTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantStats",
+			Handler:    _DeviceSTS_GetTenantStats_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _DeviceSTS_Ping_Handler,
@@ -540,6 +1526,22 @@ var DeviceSTS_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DeviceSTS_AuthenticateApp_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDevices",
+			Handler:       _DeviceSTS_StreamDevices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchCreateDevicesStream",
+			Handler:       _DeviceSTS_BatchCreateDevicesStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchUpdateDevicesStream",
+			Handler:       _DeviceSTS_BatchUpdateDevicesStream_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "dsts.proto",
 }
@@ -0,0 +1,47 @@
+// package github.com/HPInc/krypton-dsts/service/cache
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// AddAssertionID - record the unique identifier (jti) of a challenge-less
+// client assertion presented by a device. Returns false if the device already
+// presented an assertion with the same identifier, signifying a replayed
+// assertion. The entry is retained for the duration within which the
+// assertion would be accepted.
+func AddAssertionID(requestID string, tenantID string, deviceID string,
+	jti string, ttl time.Duration) (bool, error) {
+	if !isEnabled {
+		return true, nil
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	added, err := cacheClient.SetNX(ctx,
+		fmt.Sprintf(assertionJtiPrefix, tenantID, deviceID, jti),
+		requestID, ttl).Result()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheSet)
+	if err != nil {
+		dstsLogger.Error("Failed to record the assertion ID in the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Assertion ID: ", jti),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	return added, nil
+}
@@ -112,3 +112,34 @@ func RemoveDevice(requestID string, deviceID string) {
 		metrics.MetricCacheDelDeviceFailures.Inc()
 	}
 }
+
+// RemoveDevices - remove cached information about the specified devices, in a
+// single request to the cache. Errors removing from the cache are not surfaced
+// to the caller.
+func RemoveDevices(requestID string, deviceIDs []string) {
+	if !isEnabled || (len(deviceIDs) == 0) {
+		return
+	}
+
+	keys := make([]string, 0, len(deviceIDs))
+	for _, deviceID := range deviceIDs {
+		keys = append(keys, fmt.Sprintf(devicePrefix, deviceID))
+	}
+
+	// Delete the requested device objects from the cache.
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err := cacheClient.Del(ctx, keys...).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheDel)
+	if err != nil {
+		dstsLogger.Error("Failed to remove the devices from the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.Int("Number of devices: ", len(deviceIDs)),
+			zap.Error(err),
+		)
+		metrics.MetricCacheDelDeviceFailures.Inc()
+	}
+}
@@ -0,0 +1,88 @@
+// package github.com/HPInc/krypton-dsts/service/cache
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// AddDeviceClaims - cache the claims returned by a claims enricher for the
+// specified device. Errors adding to the cache are not surfaced to the caller.
+func AddDeviceClaims(requestID string, enricher string, tenantID string,
+	deviceID string, claims interface{}, ttl time.Duration) {
+	if !isEnabled {
+		return
+	}
+
+	// Marshal the claims for caching.
+	cacheEntry, err := json.Marshal(claims)
+	if err != nil {
+		dstsLogger.Error("Failed to marshal device claims for caching!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Enricher: ", enricher),
+			zap.String("Device ID: ", deviceID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err = cacheClient.Set(ctx,
+		fmt.Sprintf(deviceClaimsPrefix, enricher, tenantID, deviceID),
+		cacheEntry, ttl).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheSet)
+	if err != nil {
+		dstsLogger.Error("Failed to add the device claims to the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Enricher: ", enricher),
+			zap.String("Device ID: ", deviceID),
+			zap.Error(err),
+		)
+	}
+}
+
+// GetDeviceClaims - retrieve the cached claims returned by a claims enricher
+// for the specified device.
+func GetDeviceClaims(requestID string, enricher string, tenantID string,
+	deviceID string) ([]byte, error) {
+	if !isEnabled {
+		return nil, ErrCacheNotFound
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	cacheEntry, err := cacheClient.Get(ctx,
+		fmt.Sprintf(deviceClaimsPrefix, enricher, tenantID, deviceID)).Result()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheGet)
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrCacheNotFound
+		}
+
+		dstsLogger.Error("Error while looking up the device claims in the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Enricher: ", enricher),
+			zap.String("Device ID: ", deviceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return []byte(cacheEntry), nil
+}
@@ -0,0 +1,100 @@
+// package github.com/HPInc/krypton-dsts/service/cache
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// AddDpopProofID - record the unique identifier (jti) of a DPoP proof that was
+// presented to the DSTS. Returns false if the proof was already presented
+// earlier, signifying a replayed DPoP proof. The entry is retained for the
+// duration within which the proof would be accepted.
+func AddDpopProofID(requestID string, jti string, ttl time.Duration) (bool,
+	error) {
+	if !isEnabled {
+		return true, nil
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	added, err := cacheClient.SetNX(ctx, fmt.Sprintf(dpopJtiPrefix, jti),
+		requestID, ttl).Result()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheSet)
+	if err != nil {
+		dstsLogger.Error("Failed to record the DPoP proof ID in the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("DPoP proof ID: ", jti),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// AddDpopNonce - add a DPoP nonce issued by the DSTS to the cache. Devices are
+// expected to include the nonce in subsequent DPoP proofs.
+func AddDpopNonce(requestID string, nonce string) error {
+	if !isEnabled {
+		return nil
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err := cacheClient.Set(ctx, fmt.Sprintf(dpopNoncePrefix, nonce),
+		requestID, ttlDpopNonce).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheSet)
+	if err != nil {
+		dstsLogger.Error("Failed to add the DPoP nonce to the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// IsValidDpopNonce - check whether the specified nonce was issued by the DSTS
+// and has not yet expired.
+func IsValidDpopNonce(requestID string, nonce string) (bool, error) {
+	if !isEnabled {
+		return true, nil
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err := cacheClient.Get(ctx, fmt.Sprintf(dpopNoncePrefix, nonce)).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheGet)
+	if err != nil {
+		if err == redis.Nil {
+			return false, nil
+		}
+
+		dstsLogger.Error("Failed to retrieve the DPoP nonce from the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	return true, nil
+}
@@ -39,15 +39,22 @@ const (
 	poolTimeout  = (time.Second * 4)
 
 	// Cache key prefix strings.
-	challengePrefix   = "challenge:%s"
-	appPrefix         = "app:%s"
-	devicePrefix      = "device:%s"
-	enrollTokenPrefix = "enroll_token:%s" // #nosec G101
+	challengePrefix    = "challenge:%s"
+	appPrefix          = "app:%s"
+	devicePrefix       = "device:%s"
+	enrollTokenPrefix  = "enroll_token:%s" // #nosec G101
+	dpopJtiPrefix      = "dpop_jti:%s"
+	dpopNoncePrefix    = "dpop_nonce:%s"
+	assertionJtiPrefix = "assertion_jti:%s:%s:%s"
+	deviceClaimsPrefix = "device_claims:%s:%s:%s"
+	tokenPolicyPrefix  = "token_policies:%s"
 
 	// TTLs for cache entries.
 	ttlDeviceAuthenticationChallenge = (time.Minute * 1)
 	ttlDevice                        = (time.Hour * 2)
 	ttlApp                           = (time.Hour * 6)
+	ttlDpopNonce                     = (time.Minute * 5)
+	ttlTokenPolicies                 = (time.Minute * 10)
 
 	// Caching operation names.
 	operationCacheSet = "set"
@@ -0,0 +1,106 @@
+// package github.com/HPInc/krypton-dsts/service/cache
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// AddTokenPolicies - cache the token policies configured for the specified
+// tenant. This function is typically called from a goroutine and errors adding
+// to the cache are not surfaced to the caller.
+func AddTokenPolicies(requestID string, tenantID string, policies interface{}) {
+	if !isEnabled {
+		return
+	}
+
+	// Marshal the token policies for caching.
+	cacheEntry, err := json.Marshal(policies)
+	if err != nil {
+		dstsLogger.Error("Failed to marshal token policies for caching!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err = cacheClient.Set(ctx, fmt.Sprintf(tokenPolicyPrefix, tenantID),
+		cacheEntry, ttlTokenPolicies).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheSet)
+	if err != nil {
+		dstsLogger.Error("Failed to add the token policies to the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+	}
+}
+
+// GetTokenPolicies - retrieve the cached token policies for the specified
+// tenant.
+func GetTokenPolicies(requestID string, tenantID string) ([]byte, error) {
+	if !isEnabled {
+		return nil, ErrCacheNotFound
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	cacheEntry, err := cacheClient.Get(ctx,
+		fmt.Sprintf(tokenPolicyPrefix, tenantID)).Result()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheGet)
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrCacheNotFound
+		}
+
+		dstsLogger.Error("Error while looking up the token policies in the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return []byte(cacheEntry), nil
+}
+
+// RemoveTokenPolicies - remove the cached token policies for the specified
+// tenant. Errors removing from the cache are not surfaced to the caller.
+func RemoveTokenPolicies(requestID string, tenantID string) {
+	if !isEnabled {
+		return
+	}
+
+	ctx, cancelFunc := context.WithTimeout(gCtx, cacheTimeout)
+	defer cancelFunc()
+
+	start := time.Now()
+	err := cacheClient.Del(ctx, fmt.Sprintf(tokenPolicyPrefix, tenantID)).Err()
+	metrics.ReportLatencyMetric(metrics.MetricCacheLatency, start,
+		operationCacheDel)
+	if err != nil {
+		dstsLogger.Error("Failed to remove the token policies from the cache!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+	}
+}
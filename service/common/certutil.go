@@ -6,7 +6,9 @@ package common
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"time"
@@ -22,6 +24,7 @@ var (
 	ErrInvalidExtKeyUsage                   = errors.New("certificate has invalid extended key usage")
 	ErrPrivateKeyPemDecodeFailed            = errors.New("failed to parse PEM encoded private key")
 	ErrPrivateKeyCreationFailed             = errors.New("failed to create private key")
+	ErrCertificateBindingMismatch           = errors.New("certificate does not match the certificate bound to the token")
 )
 
 // Parse the certificate from the provided DER bytes.
@@ -41,28 +44,119 @@ func GetCertificateThumbprint(cert *x509.Certificate) string {
 	return hex.EncodeToString(thumbprint[:])
 }
 
+// GetCertificateX5tS256 - return the base64url encoded SHA-256 thumbprint of
+// the certificate, as used in the 'x5t#S256' confirmation method (RFC 8705).
+func GetCertificateX5tS256(cert *x509.Certificate) string {
+	thumbprint := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(thumbprint[:])
+}
+
+// VerifyCertificateBinding - check whether the client certificate presented
+// to a resource server matches the 'x5t#S256' confirmation claim of a
+// certificate bound access token (RFC 8705 section 3).
+func VerifyCertificateBinding(x5tS256 string, cert *x509.Certificate) error {
+	if x5tS256 == "" || cert == nil {
+		return ErrCertificateBindingMismatch
+	}
+	if subtle.ConstantTimeCompare([]byte(x5tS256),
+		[]byte(GetCertificateX5tS256(cert))) != 1 {
+		return ErrCertificateBindingMismatch
+	}
+	return nil
+}
+
+// Names of the verification checks performed on certificates.
+const (
+	CertificateCheckValidity           = "certificate_validity"
+	CertificateCheckSignatureAlgorithm = "certificate_signature_algorithm"
+	CertificateCheckPublicKeyAlgorithm = "certificate_public_key_algorithm"
+	CertificateCheckKeyUsage           = "certificate_key_usage"
+	CertificateCheckExtKeyUsage        = "certificate_ext_key_usage"
+)
+
+// Verification checks performed on certificates, in the order in which they
+// are performed.
+var certificateChecks = []struct {
+	name  string
+	check func(*x509.Certificate) error
+}{
+	{CertificateCheckValidity, verifyCertificateValidity},
+	{CertificateCheckSignatureAlgorithm, verifyCertificateSignatureAlgorithm},
+	{CertificateCheckPublicKeyAlgorithm, verifyCertificatePublicKeyAlgorithm},
+	{CertificateCheckKeyUsage, verifyCertificateKeyUsage},
+	{CertificateCheckExtKeyUsage, verifyCertificateExtKeyUsage},
+}
+
+// CertificateCheckNames - return the names of the verification checks
+// performed on certificates.
+func CertificateCheckNames() []string {
+	names := make([]string, 0, len(certificateChecks))
+	for _, item := range certificateChecks {
+		names = append(names, item.name)
+	}
+	return names
+}
+
 // VerifyCertificate - perform some verification checks on the certificate.
 func VerifyCertificate(cert *x509.Certificate) error {
-	// Verify the certificate is currently valid and hasn't yet expired.
+	return VerifyCertificateWithHandler(cert, nil)
+}
+
+// VerifyCertificateWithHandler - perform the verification checks on the
+// certificate. If a handler is specified, it is invoked with the name of each
+// failed check and the handler's return value is used as the result of the
+// check. This allows the caller to ignore the failure of specific checks.
+func VerifyCertificateWithHandler(cert *x509.Certificate,
+	handler func(check string, err error) error) error {
+	for _, item := range certificateChecks {
+		err := item.check(cert)
+		if (err != nil) && (handler != nil) {
+			err = handler(item.name, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify the certificate is currently valid and hasn't yet expired.
+func verifyCertificateValidity(cert *x509.Certificate) error {
 	if cert.NotBefore.After(time.Now()) {
 		return ErrCertificateNotYetValid
 	}
 	if cert.NotAfter.Before(time.Now()) {
 		return ErrCertificateExpired
 	}
+	return nil
+}
 
-	// Check the signature algorithm and public key algorithm.
+// Check the signature algorithm of the certificate.
+func verifyCertificateSignatureAlgorithm(cert *x509.Certificate) error {
 	if cert.SignatureAlgorithm != x509.SHA256WithRSA {
 		return ErrInvalidCertificateSignatureAlgorithm
 	}
+	return nil
+}
+
+// Check the public key algorithm of the certificate.
+func verifyCertificatePublicKeyAlgorithm(cert *x509.Certificate) error {
 	if cert.PublicKeyAlgorithm != x509.RSA {
 		return ErrInvalidPublicKeyAlgorithm
 	}
+	return nil
+}
 
-	// Check if the key usage for the certificate is acceptable.
+// Check if the key usage for the certificate is acceptable.
+func verifyCertificateKeyUsage(cert *x509.Certificate) error {
 	if cert.KeyUsage != x509.KeyUsageDigitalSignature {
 		return ErrInvalidKeyUsage
 	}
+	return nil
+}
+
+// Check if the extended key usages for the certificate are acceptable.
+func verifyCertificateExtKeyUsage(cert *x509.Certificate) error {
 	for _, usage := range cert.ExtKeyUsage {
 		switch usage {
 		case x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth:
@@ -71,7 +165,6 @@ func VerifyCertificate(cert *x509.Certificate) error {
 			return ErrInvalidExtKeyUsage
 		}
 	}
-
 	return nil
 }
 
@@ -81,3 +174,23 @@ func VerifyDeviceIDInCertificateCommonName(cert *x509.Certificate,
 	deviceID string) bool {
 	return cert.Subject.CommonName == deviceID
 }
+
+// GetTenantIDFromCertificate - return the tenant ID in the certificate's
+// organization attribute, or an empty string if it is not present.
+func GetTenantIDFromCertificate(cert *x509.Certificate) string {
+	for _, item := range cert.Subject.Names {
+		if item.Type.Equal([]int{2, 5, 4, 10}) {
+			value, _ := item.Value.(string)
+			return value
+		}
+	}
+	return ""
+}
+
+// VerifyTenantIDInCertificateOrganization - check if the tenant ID in the
+// certificate's organization attribute matches the specified tenant ID.
+func VerifyTenantIDInCertificateOrganization(cert *x509.Certificate,
+	tenantID string) bool {
+	certTenantID := GetTenantIDFromCertificate(cert)
+	return (certTenantID != "") && (certTenantID == tenantID)
+}
@@ -0,0 +1,149 @@
+// package github.com/HPInc/krypton-dsts/service/common
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package common
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Return a certificate that passes all verification checks.
+func newTestCertificate() *x509.Certificate {
+	return &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: "device-1",
+			Names: []pkix.AttributeTypeAndValue{
+				{Type: []int{2, 5, 4, 10}, Value: "tenant-1"},
+			},
+		},
+		NotBefore:          time.Now().Add(-time.Hour),
+		NotAfter:           time.Now().Add(time.Hour),
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		PublicKeyAlgorithm: x509.RSA,
+		KeyUsage:           x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth,
+			x509.ExtKeyUsageServerAuth},
+	}
+}
+
+// Certificates failing each of the verification checks.
+var certificateCheckTests = []struct {
+	check    string
+	expected error
+	modify   func(cert *x509.Certificate)
+}{
+	{CertificateCheckValidity, ErrCertificateNotYetValid, func(cert *x509.Certificate) {
+		cert.NotBefore = time.Now().Add(time.Hour)
+	}},
+	{CertificateCheckValidity, ErrCertificateExpired, func(cert *x509.Certificate) {
+		cert.NotAfter = time.Now().Add(-time.Minute)
+	}},
+	{CertificateCheckSignatureAlgorithm, ErrInvalidCertificateSignatureAlgorithm, func(cert *x509.Certificate) {
+		cert.SignatureAlgorithm = x509.SHA1WithRSA
+	}},
+	{CertificateCheckPublicKeyAlgorithm, ErrInvalidPublicKeyAlgorithm, func(cert *x509.Certificate) {
+		cert.PublicKeyAlgorithm = x509.ECDSA
+	}},
+	{CertificateCheckKeyUsage, ErrInvalidKeyUsage, func(cert *x509.Certificate) {
+		cert.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign
+	}},
+	{CertificateCheckExtKeyUsage, ErrInvalidExtKeyUsage, func(cert *x509.Certificate) {
+		cert.ExtKeyUsage = append(cert.ExtKeyUsage, x509.ExtKeyUsageCodeSigning)
+	}},
+}
+
+func TestVerifyCertificate(t *testing.T) {
+	err := VerifyCertificate(newTestCertificate())
+	if err != nil {
+		t.Errorf("TestVerifyCertificate: valid certificate failed verification: %v", err)
+	}
+
+	for _, test := range certificateCheckTests {
+		cert := newTestCertificate()
+		test.modify(cert)
+		err = VerifyCertificate(cert)
+		if !errors.Is(err, test.expected) {
+			t.Errorf("TestVerifyCertificate: %s: expected %v, got %v", test.check,
+				test.expected, err)
+		}
+	}
+}
+
+func TestVerifyCertificateWithHandler(t *testing.T) {
+	for _, test := range certificateCheckTests {
+		// The handler is invoked with the name of the failed check, and the
+		// failure is returned if the handler returns it.
+		cert := newTestCertificate()
+		test.modify(cert)
+		var failedChecks []string
+		err := VerifyCertificateWithHandler(cert, func(check string, err error) error {
+			failedChecks = append(failedChecks, check)
+			return err
+		})
+		if !errors.Is(err, test.expected) {
+			t.Errorf("TestVerifyCertificateWithHandler: %s: expected %v, got %v",
+				test.check, test.expected, err)
+		}
+		if (len(failedChecks) != 1) || (failedChecks[0] != test.check) {
+			t.Errorf("TestVerifyCertificateWithHandler: %s: handler invoked for %v",
+				test.check, failedChecks)
+		}
+
+		// The failure is ignored if the handler returns nil.
+		err = VerifyCertificateWithHandler(cert, func(check string, err error) error {
+			return nil
+		})
+		if err != nil {
+			t.Errorf("TestVerifyCertificateWithHandler: %s: ignored failure returned %v",
+				test.check, err)
+		}
+	}
+
+	// The handler is not invoked for certificates that pass all checks.
+	err := VerifyCertificateWithHandler(newTestCertificate(),
+		func(check string, err error) error {
+			t.Errorf("TestVerifyCertificateWithHandler: handler invoked for %s", check)
+			return err
+		})
+	if err != nil {
+		t.Errorf("TestVerifyCertificateWithHandler: valid certificate failed verification: %v", err)
+	}
+
+	// Checks following an ignored failure are still performed.
+	cert := newTestCertificate()
+	cert.SignatureAlgorithm = x509.SHA1WithRSA
+	cert.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+	err = VerifyCertificateWithHandler(cert, func(check string, err error) error {
+		if check == CertificateCheckSignatureAlgorithm {
+			return nil
+		}
+		return err
+	})
+	if !errors.Is(err, ErrInvalidExtKeyUsage) {
+		t.Errorf("TestVerifyCertificateWithHandler: expected %v, got %v",
+			ErrInvalidExtKeyUsage, err)
+	}
+}
+
+func TestGetTenantIDFromCertificate(t *testing.T) {
+	cert := newTestCertificate()
+	if GetTenantIDFromCertificate(cert) != "tenant-1" {
+		t.Errorf("TestGetTenantIDFromCertificate: unexpected tenant ID %s",
+			GetTenantIDFromCertificate(cert))
+	}
+	if !VerifyTenantIDInCertificateOrganization(cert, "tenant-1") ||
+		VerifyTenantIDInCertificateOrganization(cert, "tenant-2") {
+		t.Errorf("TestGetTenantIDFromCertificate: tenant ID verification failed")
+	}
+
+	cert.Subject.Names = nil
+	if GetTenantIDFromCertificate(cert) != "" ||
+		VerifyTenantIDInCertificateOrganization(cert, "") {
+		t.Errorf("TestGetTenantIDFromCertificate: certificate without tenant ID was accepted")
+	}
+}
@@ -6,7 +6,11 @@ package common
 
 import (
 	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/binary"
+	"fmt"
 )
 
 type JSONWebKey struct {
@@ -15,6 +19,7 @@ type JSONWebKey struct {
 	KeyID   string      `json:"kid,omitempty"`
 	Alg     string      `json:"alg,omitempty"`
 	X5t     string      `json:"x5t,omitempty"`
+	X5tS256 string      `json:"x5t#S256,omitempty"`
 	K       *ByteBuffer `json:"k,omitempty"`
 	X       *ByteBuffer `json:"x,omitempty"`
 	Y       *ByteBuffer `json:"y,omitempty"`
@@ -41,3 +46,18 @@ func NewBufferFromInt(num uint64) *ByteBuffer {
 	binary.BigEndian.PutUint64(data, num)
 	return newBuffer(bytes.TrimLeft(data, "\x00"))
 }
+
+// GetRsaJwkThumbprint - compute the base64url encoded SHA-256 JWK thumbprint
+// (RFC 7638) of the specified RSA public key.
+func GetRsaJwkThumbprint(key *rsa.PublicKey) string {
+	// The required members of an RSA JWK, in lexicographic order and without
+	// whitespace.
+	canonicalJwk := fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+		base64.RawURLEncoding.EncodeToString(
+			// #nosec G115
+			NewBufferFromInt(uint64(key.E)).Data),
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()))
+
+	thumbprint := sha256.Sum256([]byte(canonicalJwk))
+	return base64.RawURLEncoding.EncodeToString(thumbprint[:])
+}
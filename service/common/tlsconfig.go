@@ -0,0 +1,200 @@
+// package github.com/HPInc/krypton-dsts/service/common
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Interval at which the certificate files are checked for changes.
+	certificateReloadCheckInterval = time.Minute
+)
+
+var ErrInvalidClientCAFile = errors.New("no valid certificates found in client CA file")
+
+// TlsConfigLoader - loads the server certificate (and optionally, the CA
+// certificates used to verify client certificates) from disk. The files are
+// periodically checked for changes and reloaded when they are renewed, without
+// requiring a restart of the listener.
+type TlsConfigLoader struct {
+	logger       *zap.Logger
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	clientAuth tls.ClientAuthType
+
+	lock        sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    map[string]time.Time
+	lastChecked time.Time
+}
+
+// NewTlsConfigLoader - initialize a TLS configuration loader and load the
+// certificates from the specified files. If clientCAFile is specified, clients
+// are required to present a certificate issued by one of the CAs within it.
+func NewTlsConfigLoader(logger *zap.Logger, certFile string, keyFile string,
+	clientCAFile string) (*TlsConfigLoader, error) {
+	l := &TlsConfigLoader{
+		logger:       logger,
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		modTimes:     make(map[string]time.Time),
+	}
+	if clientCAFile != "" {
+		l.clientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	err := l.load()
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// GetTlsConfig - returns the TLS configuration to be used by the listener,
+// negotiating the specified application protocols (ALPN). The configuration
+// is resolved for each incoming connection so that renewed certificates are
+// picked up.
+func (l *TlsConfigLoader) GetTlsConfig(nextProtos ...string) *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+	}
+
+	cfg := base.Clone()
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return l.getConfigForClient(base), nil
+	}
+	return cfg
+}
+
+// Return a copy of the base configuration with the currently loaded
+// certificates. Only the certificates and client authentication settings are
+// replaced, so that settings such as the application protocols are retained.
+func (l *TlsConfigLoader) getConfigForClient(base *tls.Config) *tls.Config {
+	l.reloadIfChanged()
+
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	cfg := base.Clone()
+	cfg.Certificates = []tls.Certificate{*l.certificate}
+	cfg.ClientCAs = l.clientCAs
+	cfg.ClientAuth = l.clientAuth
+	return cfg
+}
+
+// RequestClientCertificates - request (but do not require or verify) client
+// certificates during the TLS handshake. This is used by listeners that verify
+// client certificates against those registered in the database.
+func (l *TlsConfigLoader) RequestClientCertificates() {
+	if l.clientAuth == tls.NoClientCert {
+		l.clientAuth = tls.RequestClientCert
+	}
+}
+
+// Check if any of the certificate files were modified since they were last
+// loaded and reload them if so. Errors during reload are logged and the
+// previously loaded certificates continue to be used.
+func (l *TlsConfigLoader) reloadIfChanged() {
+	l.lock.RLock()
+	checkDue := time.Since(l.lastChecked) >= certificateReloadCheckInterval
+	l.lock.RUnlock()
+	if !checkDue {
+		return
+	}
+
+	changed := false
+	for _, file := range l.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			l.logger.Error("Failed to check the TLS certificate file for changes!",
+				zap.String("File:", file),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		l.lock.RLock()
+		if !info.ModTime().Equal(l.modTimes[file]) {
+			changed = true
+		}
+		l.lock.RUnlock()
+	}
+
+	if !changed {
+		l.lock.Lock()
+		l.lastChecked = time.Now()
+		l.lock.Unlock()
+		return
+	}
+
+	err := l.load()
+	if err != nil {
+		l.logger.Error("Failed to reload renewed TLS certificates! Continuing to use existing certificates.",
+			zap.Error(err),
+		)
+		return
+	}
+	l.logger.Info("Reloaded renewed TLS certificates.",
+		zap.String("Certificate file:", l.certFile),
+	)
+}
+
+// Load the server certificate and client CA certificates from disk.
+func (l *TlsConfigLoader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range l.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	certificate, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		return err
+	}
+
+	var clientCAs *x509.CertPool
+	if l.clientCAFile != "" {
+		pemBytes, err := os.ReadFile(l.clientCAFile)
+		if err != nil {
+			return err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pemBytes) {
+			return ErrInvalidClientCAFile
+		}
+	}
+
+	l.lock.Lock()
+	l.certificate = &certificate
+	l.clientCAs = clientCAs
+	l.modTimes = modTimes
+	l.lastChecked = time.Now()
+	l.lock.Unlock()
+	return nil
+}
+
+func (l *TlsConfigLoader) files() []string {
+	files := []string{l.certFile, l.keyFile}
+	if l.clientCAFile != "" {
+		files = append(files, l.clientCAFile)
+	}
+	return files
+}
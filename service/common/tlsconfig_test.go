@@ -0,0 +1,339 @@
+// package github.com/HPInc/krypton-dsts/service/common
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// A certificate and private key issued for the TLS configuration tests.
+type testTlsCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// Issue a certificate with the specified common name. The certificate is
+// self-signed, unless an issuer is specified.
+func newTestTlsCertificate(t *testing.T, commonName string, isCA bool,
+	issuer *testTlsCertificate) *testTlsCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("Failed to generate certificate serial number: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth,
+			x509.ExtKeyUsageServerAuth},
+	}
+	if isCA {
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+
+	parent, parentKey := template, key
+	if issuer != nil {
+		parent, parentKey = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent,
+		&key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("Failed to create test certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse test certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal test key: %v", err)
+	}
+
+	return &testTlsCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// Return the certificate for presentation by a TLS client.
+func (c *testTlsCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{
+		Certificate: [][]byte{c.cert.Raw},
+		PrivateKey:  c.key,
+	}
+}
+
+// Write the certificate and key to the specified files. The modification
+// time of the files is set to the specified time.
+func writeTestTlsCertificate(t *testing.T, c *testTlsCertificate,
+	certFile string, keyFile string, modTime time.Time) {
+	for file, contents := range map[string][]byte{certFile: c.certPEM,
+		keyFile: c.keyPEM} {
+		err := os.WriteFile(file, contents, 0600)
+		if err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+		err = os.Chtimes(file, modTime, modTime)
+		if err != nil {
+			t.Fatalf("Failed to set the modification time of %s: %v", file, err)
+		}
+	}
+}
+
+// Perform a TLS handshake between a client and a server using the specified
+// configurations. The connection states seen by the server and the client are
+// returned.
+func doTestTlsHandshake(serverConfig *tls.Config,
+	clientConfig *tls.Config) (tls.ConnectionState, tls.ConnectionState, error) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	server := tls.Server(serverConn, serverConfig)
+	serverErr := make(chan error, 1)
+	go func() {
+		err := server.Handshake()
+		if err != nil {
+			// Unblock the client if the server rejects the handshake.
+			serverConn.Close()
+		}
+		serverErr <- err
+	}()
+
+	client := tls.Client(clientConn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr == nil {
+		// The server verifies the client certificate after the client has
+		// completed its handshake in TLS 1.3. Exchange data so that errors
+		// are reported to the client.
+		go func() {
+			_, _ = server.Write([]byte{0})
+		}()
+		buf := make([]byte, 1)
+		_, clientErr = client.Read(buf)
+	}
+
+	err := <-serverErr
+	if err == nil {
+		err = clientErr
+	}
+	return server.ConnectionState(), client.ConnectionState(), err
+}
+
+// Return a client configuration that accepts any server certificate, so that
+// the certificate served can be checked.
+func newTestTlsClientConfig(certificates ...tls.Certificate) *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true, // #nosec G402
+		Certificates:       certificates,
+		NextProtos:         []string{"h2"},
+	}
+}
+
+func TestTlsConfigLoader_ReloadsRenewedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+
+	original := newTestTlsCertificate(t, "original", false, nil)
+	writeTestTlsCertificate(t, original, certFile, keyFile,
+		time.Now().Add(-time.Hour))
+	loader, err := NewTlsConfigLoader(zap.NewNop(), certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: failed to load certificates: %v", err)
+	}
+	serverConfig := loader.GetTlsConfig("h2", "http/1.1")
+
+	_, clientState, err := doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig())
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: handshake failed: %v", err)
+	}
+	if !clientState.PeerCertificates[0].Equal(original.cert) {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: original certificate was not served")
+	}
+
+	// The application protocols of the listener are negotiated.
+	if clientState.NegotiatedProtocol != "h2" {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: negotiated protocol %q, expected h2",
+			clientState.NegotiatedProtocol)
+	}
+
+	// Renew the certificate on disk. The files are checked for changes at
+	// most once a minute, so the original certificate continues to be served.
+	renewed := newTestTlsCertificate(t, "renewed", false, nil)
+	writeTestTlsCertificate(t, renewed, certFile, keyFile, time.Now())
+	_, clientState, err = doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig())
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: handshake failed: %v", err)
+	}
+	if !clientState.PeerCertificates[0].Equal(original.cert) {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: certificate was reloaded before the check interval elapsed")
+	}
+
+	// Once the check interval has elapsed, the renewed certificate is served.
+	loader.lock.Lock()
+	loader.lastChecked = time.Now().Add(-certificateReloadCheckInterval)
+	loader.lock.Unlock()
+	_, clientState, err = doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig())
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: handshake failed: %v", err)
+	}
+	if !clientState.PeerCertificates[0].Equal(renewed.cert) {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: renewed certificate was not served")
+	}
+	if clientState.NegotiatedProtocol != "h2" {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: negotiated protocol %q after reload, expected h2",
+			clientState.NegotiatedProtocol)
+	}
+
+	// If the renewed files are invalid, the loaded certificate continues to be
+	// served.
+	err = os.WriteFile(certFile, []byte("invalid"), 0600)
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: failed to write %s: %v",
+			certFile, err)
+	}
+	loader.lock.Lock()
+	loader.lastChecked = time.Now().Add(-certificateReloadCheckInterval)
+	loader.lock.Unlock()
+	_, clientState, err = doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig())
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ReloadsRenewedCertificate: handshake failed: %v", err)
+	}
+	if !clientState.PeerCertificates[0].Equal(renewed.cert) {
+		t.Errorf("TestTlsConfigLoader_ReloadsRenewedCertificate: invalid certificate file replaced the loaded certificate")
+	}
+}
+
+func TestTlsConfigLoader_ClientCAs(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	clientCAFile := filepath.Join(dir, "client-ca.crt")
+
+	writeTestTlsCertificate(t, newTestTlsCertificate(t, "server", false, nil),
+		certFile, keyFile, time.Now())
+	clientCA := newTestTlsCertificate(t, "client CA", true, nil)
+	err := os.WriteFile(clientCAFile, clientCA.certPEM, 0600)
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ClientCAs: failed to write %s: %v",
+			clientCAFile, err)
+	}
+
+	loader, err := NewTlsConfigLoader(zap.NewNop(), certFile, keyFile,
+		clientCAFile)
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ClientCAs: failed to load certificates: %v", err)
+	}
+	serverConfig := loader.GetTlsConfig("h2")
+
+	// Clients presenting a certificate issued by the client CA are accepted.
+	client := newTestTlsCertificate(t, "client", false, clientCA)
+	serverState, _, err := doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig(client.tlsCertificate()))
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ClientCAs: handshake failed: %v", err)
+	}
+	if (len(serverState.VerifiedChains) == 0) ||
+		!serverState.PeerCertificates[0].Equal(client.cert) {
+		t.Errorf("TestTlsConfigLoader_ClientCAs: client certificate was not verified")
+	}
+
+	// Clients presenting no certificate, or a certificate issued by another
+	// CA, are rejected.
+	_, _, err = doTestTlsHandshake(serverConfig, newTestTlsClientConfig())
+	if err == nil {
+		t.Errorf("TestTlsConfigLoader_ClientCAs: client without a certificate was accepted")
+	}
+	otherCA := newTestTlsCertificate(t, "other CA", true, nil)
+	other := newTestTlsCertificate(t, "other client", false, otherCA)
+	_, _, err = doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig(other.tlsCertificate()))
+	if err == nil {
+		t.Errorf("TestTlsConfigLoader_ClientCAs: client certificate issued by another CA was accepted")
+	}
+
+	// Client CA files without certificates are rejected.
+	err = os.WriteFile(clientCAFile, []byte("invalid"), 0600)
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_ClientCAs: failed to write %s: %v",
+			clientCAFile, err)
+	}
+	_, err = NewTlsConfigLoader(zap.NewNop(), certFile, keyFile, clientCAFile)
+	if err != ErrInvalidClientCAFile {
+		t.Errorf("TestTlsConfigLoader_ClientCAs: expected %v, got %v",
+			ErrInvalidClientCAFile, err)
+	}
+}
+
+func TestTlsConfigLoader_RequestClientCertificates(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	writeTestTlsCertificate(t, newTestTlsCertificate(t, "server", false, nil),
+		certFile, keyFile, time.Now())
+
+	loader, err := NewTlsConfigLoader(zap.NewNop(), certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_RequestClientCertificates: failed to load certificates: %v", err)
+	}
+	loader.RequestClientCertificates()
+	serverConfig := loader.GetTlsConfig("h2", "http/1.1")
+
+	// Client certificates are requested, but not required or verified.
+	serverState, _, err := doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig())
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_RequestClientCertificates: handshake failed: %v", err)
+	}
+	if len(serverState.PeerCertificates) != 0 {
+		t.Errorf("TestTlsConfigLoader_RequestClientCertificates: unexpected client certificate")
+	}
+
+	client := newTestTlsCertificate(t, "client", false, nil)
+	serverState, _, err = doTestTlsHandshake(serverConfig,
+		newTestTlsClientConfig(client.tlsCertificate()))
+	if err != nil {
+		t.Fatalf("TestTlsConfigLoader_RequestClientCertificates: handshake failed: %v", err)
+	}
+	if (len(serverState.PeerCertificates) == 0) ||
+		!serverState.PeerCertificates[0].Equal(client.cert) {
+		t.Errorf("TestTlsConfigLoader_RequestClientCertificates: client certificate was not presented to the server")
+	}
+	if len(serverState.VerifiedChains) != 0 {
+		t.Errorf("TestTlsConfigLoader_RequestClientCertificates: self-signed client certificate was verified")
+	}
+}
@@ -4,6 +4,8 @@
 // (C) HP Development Company, LP
 package config
 
+import "time"
+
 const ServiceName = "HP Device Security Token Service"
 
 type ServerConfig struct {
@@ -21,6 +23,94 @@ type ServerConfig struct {
 
 	// Specifies whether to log all incoming REST requests to the debug log.
 	DebugLogRestRequests bool `yaml:"log_rest_requests"`
+
+	// Specifies whether callers of the gRPC API must present an app access
+	// token authorizing them to invoke the requested RPC.
+	RpcAuthorizationEnabled bool `yaml:"rpc_authorization_enabled"`
+
+	// Specifies whether the gRPC and REST listeners are served over TLS.
+	TlsEnabled bool `yaml:"tls_enabled"`
+
+	// Path to the PEM encoded server certificate (chain) and private key
+	// used by the gRPC and REST listeners. Renewed certificates are picked up
+	// without requiring a restart.
+	TlsCertificateFile string `yaml:"tls_cert_file"`
+	TlsKeyFile         string `yaml:"tls_key_file"`
+
+	// Path to the PEM encoded CA certificates used to verify client
+	// certificates presented to the gRPC listener. If specified, backend
+	// callers are required to present a client certificate (mutual TLS).
+	RpcClientCAFile string `yaml:"rpc_client_ca_file"`
+
+	// Specifies whether the mTLS device token endpoint is enabled. Devices
+	// present their registered device certificate at the TLS layer and are
+	// issued certificate bound access tokens (RFC 8705). Requires TLS.
+	MtlsTokenEndpointEnabled bool `yaml:"mtls_token_endpoint_enabled"`
+
+	// Externally visible base URL of the REST server (eg.
+	// https://dsts.example.com). This is used to validate the 'htu' claim in
+	// DPoP proofs. If not specified, it is derived from the incoming request.
+	ExternalUrl string `yaml:"external_url"`
+
+	// Specifies whether DPoP proofs must include a nonce issued by the DSTS.
+	DpopNonceRequired bool `yaml:"dpop_nonce_required"`
+
+	// Issuer asserted in the 'iss' claim of access tokens issued by the DSTS
+	// and published in the discovery document. If not specified, the default
+	// issuer name is used.
+	TokenIssuer string `yaml:"token_issuer"`
+
+	// Key used to sign the page tokens returned by list RPCs. All instances
+	// of the DSTS must be configured with the same key. The key is required,
+	// unless test mode is enabled, in which case a random key is generated
+	// at startup if it is not specified.
+	PageTokenKey string
+}
+
+// TokenConfig - lifetime policy for access tokens issued by the DSTS.
+type TokenConfig struct {
+	// Lifetime of device access tokens (eg. 1h). Can be overridden per
+	// management service and per tenant.
+	DeviceAccessTokenLifetime time.Duration `yaml:"device_access_token_lifetime"`
+
+	// Lifetime of app access tokens (eg. 3h).
+	AppAccessTokenLifetime time.Duration `yaml:"app_access_token_lifetime"`
+
+	// Device access token lifetimes for devices managed by specific
+	// management services.
+	ManagementServices []ManagementServiceTokenConfig `yaml:"management_services"`
+
+	// Specifies whether refresh tokens are issued to devices alongside device
+	// access tokens, allowing them to obtain new access tokens without
+	// performing the challenge/assertion flow.
+	DeviceRefreshTokensEnabled bool `yaml:"device_refresh_tokens_enabled"`
+
+	// Lifetime of device refresh tokens (eg. 720h). Refresh tokens are
+	// rotated on use, and the rotated refresh token is issued a fresh lifetime.
+	DeviceRefreshTokenLifetime time.Duration `yaml:"device_refresh_token_lifetime"`
+
+	// Lifetime of quarantine tokens issued to lost devices (eg. 15m), for
+	// tenants with lost device quarantine enabled.
+	QuarantineTokenLifetime time.Duration `yaml:"quarantine_token_lifetime"`
+
+	// Maximum time to wait for the claims enrichers of a tenant to return
+	// claims for a device access token. Enrichers are invoked concurrently,
+	// and claims from enrichers that time out are omitted.
+	ClaimsEnricherTimeout time.Duration `yaml:"claims_enricher_timeout"`
+
+	// Duration for which claims returned by claims enrichers are cached.
+	ClaimsEnricherCacheTtl time.Duration `yaml:"claims_enricher_cache_ttl"`
+}
+
+// ManagementServiceTokenConfig - token lifetime policy for devices managed by
+// a device management service.
+type ManagementServiceTokenConfig struct {
+	// The ID of the management service (eg. hpcem).
+	ServiceId string `yaml:"service_id"`
+
+	// Lifetime of device access tokens issued to devices managed by the
+	// management service.
+	DeviceAccessTokenLifetime time.Duration `yaml:"device_access_token_lifetime"`
 }
 
 // Structured logging configuration settings.
@@ -81,6 +171,14 @@ type DatabaseConfig struct {
 
 	// SSL root certificate to use for connections.
 	SslRootCertificate string `yaml:"ssl_root_cert"`
+
+	// Duration for which tombstones of deleted devices are retained, during
+	// which the devices can be restored. Tombstones are retained indefinitely
+	// if not specified. Can be overridden per tenant.
+	TombstoneRetention time.Duration `yaml:"tombstone_retention"`
+
+	// Interval at which tombstones older than their retention are purged.
+	TombstonePurgeInterval time.Duration `yaml:"tombstone_purge_interval"`
 }
 
 type Config struct {
@@ -98,6 +196,12 @@ type Config struct {
 	// Device cache related configuration settings.
 	CacheConfig `yaml:"cache"`
 
+	// Access token lifetime policy settings.
+	TokenConfig `yaml:"tokens"`
+
+	// Per-tenant configuration settings.
+	Tenants []TenantConfig `yaml:"tenants"`
+
 	// Whether the service is running in test mode.
 	TestMode bool `yaml:"test_mode"`
 }
@@ -107,6 +107,11 @@ func (c *ConfigMgr) GetDatabaseConfig() *DatabaseConfig {
 	return &c.config.DatabaseConfig
 }
 
+// Return the access token lifetime policy settings.
+func (c *ConfigMgr) GetTokenConfig() *TokenConfig {
+	return &c.config.TokenConfig
+}
+
 // Return the logging configuration settings.
 func (c *ConfigMgr) GetLoggingConfig() *LoggingConfig {
 	return &c.config.LoggingConfig
@@ -136,7 +141,30 @@ func (c *ConfigMgr) Display() {
 		zap.String(" - Hostname:", c.config.ServerConfig.Host),
 		zap.Int(" - RPC Port:", c.config.ServerConfig.RpcPort),
 		zap.Int(" - Rest Port:", c.config.ServerConfig.RestPort),
+		zap.Bool(" - RPC authorization enabled:", c.config.ServerConfig.RpcAuthorizationEnabled),
+		zap.Bool(" - TLS enabled:", c.config.ServerConfig.TlsEnabled),
+		zap.String(" - TLS certificate file:", c.config.ServerConfig.TlsCertificateFile),
+		zap.String(" - RPC client CA file:", c.config.ServerConfig.RpcClientCAFile),
+		zap.Bool(" - mTLS token endpoint enabled:", c.config.ServerConfig.MtlsTokenEndpointEnabled),
+		zap.String(" - External URL:", c.config.ServerConfig.ExternalUrl),
+		zap.Bool(" - DPoP nonce required:", c.config.ServerConfig.DpopNonceRequired),
+		zap.String(" - Token issuer:", c.config.ServerConfig.TokenIssuer),
 	)
+	dstsLogger.Info("Token settings",
+		zap.Duration(" - Device access token lifetime:", c.config.TokenConfig.DeviceAccessTokenLifetime),
+		zap.Duration(" - App access token lifetime:", c.config.TokenConfig.AppAccessTokenLifetime),
+		zap.Bool(" - Device refresh tokens enabled:", c.config.TokenConfig.DeviceRefreshTokensEnabled),
+		zap.Duration(" - Device refresh token lifetime:", c.config.TokenConfig.DeviceRefreshTokenLifetime),
+		zap.Duration(" - Quarantine token lifetime:", c.config.TokenConfig.QuarantineTokenLifetime),
+		zap.Duration(" - Claims enricher timeout:", c.config.TokenConfig.ClaimsEnricherTimeout),
+		zap.Duration(" - Claims enricher cache TTL:", c.config.TokenConfig.ClaimsEnricherCacheTtl),
+	)
+	for _, service := range c.config.TokenConfig.ManagementServices {
+		dstsLogger.Info("Management service token settings",
+			zap.String(" - Service ID:", service.ServiceId),
+			zap.Duration(" - Device access token lifetime:", service.DeviceAccessTokenLifetime),
+		)
+	}
 	dstsLogger.Info("Logging settings",
 		zap.String(" - Log level:", c.config.LoggingConfig.LogLevel),
 	)
@@ -149,6 +177,8 @@ func (c *ConfigMgr) Display() {
 		zap.Bool(" - Debug logging enabled:", c.config.DatabaseConfig.DebugLoggingEnabled),
 		zap.String(" - SSL mode:", c.config.DatabaseConfig.SslMode),
 		zap.String(" - SSL root certificate:", c.config.DatabaseConfig.SslRootCertificate),
+		zap.Duration(" - Tombstone retention:", c.config.DatabaseConfig.TombstoneRetention),
+		zap.Duration(" - Tombstone purge interval:", c.config.DatabaseConfig.TombstonePurgeInterval),
 	)
 	dstsLogger.Info("Cache settings",
 		zap.Bool(" - Caching enabled:", c.config.CacheConfig.Enabled),
@@ -156,4 +186,20 @@ func (c *ConfigMgr) Display() {
 		zap.Int(" - Port:", c.config.CacheConfig.Port),
 		zap.Int(" - Database:", c.config.CacheConfig.CacheDatabase),
 	)
+
+	for _, tenant := range c.config.Tenants {
+		dstsLogger.Info("Tenant settings",
+			zap.String(" - Tenant ID:", tenant.Id),
+			zap.String(" - Issuer:", tenant.Issuer),
+			zap.Bool(" - Dedicated signing key:", tenant.DedicatedSigningKey),
+			zap.Duration(" - Device access token lifetime:", tenant.DeviceAccessTokenLifetime),
+			zap.Bool(" - Challenge-less authentication:", tenant.ChallengelessAuthentication),
+			zap.Strings(" - Device claims:", tenant.DeviceClaims),
+			zap.Strings(" - Claims enrichers:", tenant.ClaimsEnrichers),
+			zap.Strings(" - Posture claims:", tenant.PostureClaims),
+			zap.Any(" - Verification modes:", tenant.VerificationModes),
+			zap.Bool(" - Lost device quarantine:", tenant.LostDeviceQuarantine),
+			zap.Duration(" - Tombstone retention:", tenant.TombstoneRetention),
+		)
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -28,6 +29,25 @@ func (c *ConfigMgr) loadEnvironmentVariableOverrides() {
 		"DSTS_REST_PORT":                  {value: &c.config.ServerConfig.RestPort},
 		"DSTS_REGISTERED_APP_CONFIG_FILE": {value: &c.config.ServerConfig.RegisteredAppConfigFile},
 		"DSTS_REST_DEBUG_ENABLED":         {value: &c.config.ServerConfig.DebugLogRestRequests},
+		"DSTS_RPC_AUTHZ_ENABLED":          {value: &c.config.ServerConfig.RpcAuthorizationEnabled},
+		"DSTS_TLS_ENABLED":                {value: &c.config.ServerConfig.TlsEnabled},
+		"DSTS_TLS_CERT_FILE":              {value: &c.config.ServerConfig.TlsCertificateFile},
+		"DSTS_TLS_KEY_FILE":               {value: &c.config.ServerConfig.TlsKeyFile},
+		"DSTS_RPC_CLIENT_CA_FILE":         {value: &c.config.ServerConfig.RpcClientCAFile},
+		"DSTS_MTLS_TOKEN_ENABLED":         {value: &c.config.ServerConfig.MtlsTokenEndpointEnabled},
+		"DSTS_EXTERNAL_URL":               {value: &c.config.ServerConfig.ExternalUrl},
+		"DSTS_DPOP_NONCE_REQUIRED":        {value: &c.config.ServerConfig.DpopNonceRequired},
+		"DSTS_TOKEN_ISSUER":               {value: &c.config.ServerConfig.TokenIssuer},
+		"DSTS_PAGE_TOKEN_KEY":             {isSecret: true, value: &c.config.ServerConfig.PageTokenKey},
+
+		// Token configuration settings
+		"DSTS_DEVICE_TOKEN_LIFETIME":         {value: &c.config.TokenConfig.DeviceAccessTokenLifetime},
+		"DSTS_APP_TOKEN_LIFETIME":            {value: &c.config.TokenConfig.AppAccessTokenLifetime},
+		"DSTS_DEVICE_REFRESH_TOKENS_ENABLED": {value: &c.config.TokenConfig.DeviceRefreshTokensEnabled},
+		"DSTS_DEVICE_REFRESH_TOKEN_LIFETIME": {value: &c.config.TokenConfig.DeviceRefreshTokenLifetime},
+		"DSTS_QUARANTINE_TOKEN_LIFETIME":     {value: &c.config.TokenConfig.QuarantineTokenLifetime},
+		"DSTS_CLAIMS_ENRICHER_TIMEOUT":       {value: &c.config.TokenConfig.ClaimsEnricherTimeout},
+		"DSTS_CLAIMS_ENRICHER_CACHE_TTL":     {value: &c.config.TokenConfig.ClaimsEnricherCacheTtl},
 
 		// Cache configuration settings
 		"DSTS_CACHE_ENABLED":  {value: &c.config.CacheConfig.Enabled},
@@ -36,16 +56,17 @@ func (c *ConfigMgr) loadEnvironmentVariableOverrides() {
 		"DSTS_CACHE_PASSWORD": {isSecret: true, value: &c.config.CacheConfig.Password},
 
 		// Database configuration settings
-		"DSTS_DB_HOST":            {value: &c.config.DatabaseConfig.Host},
-		"DSTS_DB_PORT":            {value: &c.config.DatabaseConfig.Port},
-		"DSTS_DB_NAME":            {value: &c.config.DatabaseConfig.DatabaseName},
-		"DSTS_DB_USER":            {value: &c.config.DatabaseConfig.Username},
-		"DSTS_DB_PASSWORD":        {isSecret: true, value: &c.config.DatabaseConfig.Password},
-		"DSTS_DB_SCHEMA_LOCATION": {value: &c.config.DatabaseConfig.SchemaMigrationScripts},
-		"DSTS_DB_DEBUG_ENABLED":   {value: &c.config.DatabaseConfig.DebugLoggingEnabled},
-		"DSTS_DB_MIGRATE_ENABLED": {value: &c.config.DatabaseConfig.SchemaMigrationEnabled},
-		"DSTS_DB_SSL_MODE":        {value: &c.config.DatabaseConfig.SslMode},
-		"DSTS_DB_SSL_ROOT_CERT":   {value: &c.config.DatabaseConfig.SslRootCertificate},
+		"DSTS_DB_HOST":                {value: &c.config.DatabaseConfig.Host},
+		"DSTS_DB_PORT":                {value: &c.config.DatabaseConfig.Port},
+		"DSTS_DB_NAME":                {value: &c.config.DatabaseConfig.DatabaseName},
+		"DSTS_DB_USER":                {value: &c.config.DatabaseConfig.Username},
+		"DSTS_DB_PASSWORD":            {isSecret: true, value: &c.config.DatabaseConfig.Password},
+		"DSTS_DB_SCHEMA_LOCATION":     {value: &c.config.DatabaseConfig.SchemaMigrationScripts},
+		"DSTS_DB_DEBUG_ENABLED":       {value: &c.config.DatabaseConfig.DebugLoggingEnabled},
+		"DSTS_DB_MIGRATE_ENABLED":     {value: &c.config.DatabaseConfig.SchemaMigrationEnabled},
+		"DSTS_DB_SSL_MODE":            {value: &c.config.DatabaseConfig.SslMode},
+		"DSTS_DB_SSL_ROOT_CERT":       {value: &c.config.DatabaseConfig.SslRootCertificate},
+		"DSTS_DB_TOMBSTONE_RETENTION": {value: &c.config.DatabaseConfig.TombstoneRetention},
 	}
 	for k, v := range m {
 		e := os.Getenv(k)
@@ -85,6 +106,14 @@ func replaceConfigValue(envValue string, t *envSetting) {
 		} else {
 			*t.value.(*int) = i
 		}
+	case *time.Duration:
+		d, err := time.ParseDuration(envValue)
+		if err != nil {
+			dstsLogger.Error("Bad duration value in env",
+				zap.Error(err))
+		} else {
+			*t.value.(*time.Duration) = d
+		}
 	default:
 		dstsLogger.Error("There was a bad type map in env override",
 			zap.String("value", envValue))
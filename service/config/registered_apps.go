@@ -30,6 +30,14 @@ type RegisteredApp struct {
 
 	// Path to the key file in which the public key of this app is stored.
 	PublicKeyFilePath string `yaml:"public_key_file"`
+
+	// Scopes granted to the application (eg. devices:read). Access tokens
+	// issued to the app contain only scopes from this list.
+	Scopes []string `yaml:"scopes"`
+
+	// Tenants the application is allowed to act upon. If empty, the app is
+	// not restricted to specific tenants.
+	AllowedTenants []string `yaml:"allowed_tenants"`
 }
 
 // Load configuration information for registered apps from the YAML configuration
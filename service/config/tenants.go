@@ -0,0 +1,83 @@
+// package github.com/HPInc/krypton-dsts/service/config
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package config
+
+import "time"
+
+// TenantConfig - configuration settings that apply to a specific tenant. Tenants
+// that are not listed in the configuration file use the service defaults.
+type TenantConfig struct {
+	// Unique identifier of the tenant.
+	Id string `yaml:"tenant_id"`
+
+	// Issuer asserted in the 'iss' claim of device access tokens issued to
+	// devices belonging to the tenant (eg. https://dsts.example.com/tenants/<id>).
+	// If not specified, the service token issuer is used.
+	Issuer string `yaml:"issuer"`
+
+	// Specifies whether device access tokens for the tenant are signed using a
+	// signing key dedicated to the tenant. The key is generated when the DSTS
+	// starts up, if it doesn't already exist.
+	DedicatedSigningKey bool `yaml:"dedicated_signing_key"`
+
+	// Lifetime of device access tokens issued to devices belonging to the
+	// tenant. If specified, this overrides the service and management service
+	// token lifetimes.
+	DeviceAccessTokenLifetime time.Duration `yaml:"device_access_token_lifetime"`
+
+	// Specifies whether devices belonging to the tenant can authenticate
+	// without first requesting a challenge, by presenting a short-lived client
+	// assertion with a unique 'jti' claim. The challenge flow remains
+	// supported for these devices.
+	ChallengelessAuthentication bool `yaml:"challengeless_authentication"`
+
+	// Device attributes asserted as claims in device access tokens issued to
+	// devices belonging to the tenant (eg. hardware_hash, cert_expires_at,
+	// labels).
+	DeviceClaims []string `yaml:"device_claims"`
+
+	// Names of the registered claims enrichers invoked to obtain additional
+	// claims for device access tokens issued to the tenant's devices.
+	ClaimsEnrichers []string `yaml:"claims_enrichers"`
+
+	// Device posture attributes last reported by the device, copied into the
+	// 'posture' claim of device access tokens issued to devices belonging to
+	// the tenant (eg. os_version, disk_encryption).
+	PostureClaims []string `yaml:"posture_claims"`
+
+	// Mode of verification checks on the device authentication path, indexed
+	// by the name of the check. Supported modes are enforce, report_only and
+	// off. Failures of checks in report_only mode are logged and counted, but
+	// devices are still allowed to authenticate. Checks that are not listed
+	// are enforced.
+	VerificationModes map[string]string `yaml:"verification_modes"`
+
+	// Specifies whether devices belonging to the tenant that are reported lost
+	// are issued a short-lived quarantine token, restricted to the management
+	// service responsible for the device, instead of being blocked. This
+	// allows lost devices to receive wipe or locate commands.
+	LostDeviceQuarantine bool `yaml:"lost_device_quarantine"`
+
+	// Duration for which tombstones of the tenant's deleted devices are
+	// retained. If specified, this overrides the service tombstone retention.
+	TombstoneRetention time.Duration `yaml:"tombstone_retention"`
+}
+
+// Return the configuration settings for all tenants listed in the
+// configuration file.
+func (c *ConfigMgr) GetTenants() []TenantConfig {
+	return c.config.Tenants
+}
+
+// Return the configuration settings for the specified tenant, or nil if the
+// tenant is not listed in the configuration file.
+func (c *ConfigMgr) GetTenantConfig(tenantID string) *TenantConfig {
+	for i := range c.config.Tenants {
+		if c.config.Tenants[i].Id == tenantID {
+			return &c.config.Tenants[i]
+		}
+	}
+	return nil
+}
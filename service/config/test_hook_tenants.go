@@ -0,0 +1,15 @@
+// package github.com/HPInc/krypton-dsts/service/config
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package config
+
+// AddTestTenants - add configuration settings for tenants used by unit tests.
+// Must be invoked before the services consuming tenant settings are
+// initialized. Does nothing, if invoked outside of test mode.
+func (c *ConfigMgr) AddTestTenants(tenants []TenantConfig) {
+	if !c.IsTestModeEnabled() {
+		return
+	}
+	c.config.Tenants = append(c.config.Tenants, tenants...)
+}
@@ -0,0 +1,125 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// ApplyDeviceGroupAction - apply the action to all members of the specified
+// device group in a single transaction, and return the number of devices
+// updated. ErrNotFound is returned if the group does not exist.
+func ApplyDeviceGroupAction(requestID string, tenantID string, groupID string,
+	action DeviceGroupAction) (int64, error) {
+	update, ok := deviceGroupActionUpdates[action]
+	if !ok {
+		return 0, ErrInvalidRequest
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to apply device group action!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	// Ensure the group exists, and prevent it from being deleted until the
+	// transaction completes.
+	var lockedGroupID string
+	err = tx.QueryRow(ctx, queryLockDeviceGroup, groupID, tenantID).Scan(
+		&lockedGroupID)
+	if err != nil {
+		rollback(tx, ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to lookup the device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryUpdateDeviceGroupMembers,
+		update.column), groupID, tenantID, update.value)
+	if err != nil {
+		rollback(tx, ctx)
+		return 0, deviceGroupActionError(requestID, tenantID, groupID, err)
+	}
+
+	updated := []string{}
+	for rows.Next() {
+		var deviceID string
+		err = rows.Scan(&deviceID)
+		if err != nil {
+			rows.Close()
+			rollback(tx, ctx)
+			return 0, deviceGroupActionError(requestID, tenantID, groupID, err)
+		}
+		updated = append(updated, deviceID)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		rollback(tx, ctx)
+		return 0, deviceGroupActionError(requestID, tenantID, groupID,
+			rows.Err())
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbApplyGroupAction)
+	metrics.MetricDatabaseDevicesUpdated.Add(float64(len(updated)))
+
+	// Remove the cache entries for the updated devices. The next read of each
+	// device will refresh its cache entry.
+	cache.RemoveDevices(requestID, updated)
+
+	dstsLogger.Info("Applied the action to the members of the device group!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.String("Group ID", groupID),
+		zap.Int("Devices updated", len(updated)),
+	)
+	return int64(len(updated)), nil
+}
+
+// Log and count a failure to update the members of the device group.
+func deviceGroupActionError(requestID string, tenantID string, groupID string,
+	err error) error {
+	err = mapContextTimeoutError(err)
+	dstsLogger.Error("Failed to apply the action to the members of the device group!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.String("Group ID", groupID),
+		zap.Error(err),
+	)
+	metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+	return err
+}
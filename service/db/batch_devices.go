@@ -0,0 +1,246 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	// Maximum number of devices that can be created or updated in a batch.
+	MaxDeviceBatchSize = 1000
+)
+
+// DeviceUpdate - updates to be applied to a device in a batch of device
+// updates. The update map uses the same fields as UpdateDevice.
+type DeviceUpdate struct {
+	DeviceId  string
+	UpdateMap map[string]interface{}
+}
+
+// CreateDevices - create a batch of devices in a single transaction. The
+// result for each device is returned in the order the devices were specified.
+// Devices that already exist are reported as ErrDuplicateEntry and devices
+// with an invalid management service as ErrInvalidRequest, without failing the
+// rest of the batch. An error is returned if the batch could not be processed.
+func CreateDevices(requestID string, devices []Device) ([]error, error) {
+	results := make([]error, len(devices))
+	if len(devices) > MaxDeviceBatchSize {
+		return nil, ErrInvalidRequest
+	}
+
+	// Lookup the management service specified for each device, and queue the
+	// devices to be inserted. Devices that already exist are not inserted.
+	batch := &pgx.Batch{}
+	queued := []int{}
+	for i := range devices {
+		svc, err := lookupManagementService(devices[i].ServiceId)
+		if err != nil {
+			results[i] = ErrInvalidRequest
+			continue
+		}
+		devices[i].ServiceId = svc.ServiceId
+
+		d := &devices[i]
+		batch.Queue(queryInsertNewDeviceIfNotExists, d.DeviceId, d.TenantId,
+			d.IsEnabled, d.IsLost, d.CertificateThumbprint, d.CertificateIssuedAt,
+			d.CertificateExpiresAt, d.ServiceId, d.HardwareHash, d.Labels,
+			d.Metadata)
+		queued = append(queued, i)
+	}
+	if batch.Len() == 0 {
+		return results, nil
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to create devices!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	created := []string{}
+	br := tx.SendBatch(ctx, batch)
+	for _, i := range queued {
+		d := &devices[i]
+		err = br.QueryRow().Scan(&d.CreatedAt, &d.UpdatedAt, &d.ServiceId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				results[i] = ErrDuplicateEntry
+				continue
+			}
+
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to create a batch of devices.",
+				zap.String("Request ID", requestID),
+				zap.String("Device ID", d.DeviceId),
+				zap.Error(err),
+			)
+			metrics.MetricDatabaseCreateDeviceFailures.Inc()
+			_ = br.Close()
+			rollback(tx, ctx)
+			return nil, err
+		}
+		created = append(created, d.DeviceId)
+	}
+
+	err = br.Close()
+	if err != nil {
+		dstsLogger.Error("Failed to close batch result!",
+			zap.Error(err),
+		)
+		rollback(tx, ctx)
+		return nil, ErrInternalError
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbBatchCreateDevices)
+	metrics.MetricDatabaseDevicesCreated.Add(float64(len(created)))
+
+	// Invalidate any stale cache entries for the created devices. The next
+	// read of each device will populate the cache.
+	cache.RemoveDevices(requestID, created)
+
+	dstsLogger.Info("Created a batch of devices in the database!",
+		zap.String("Request ID", requestID),
+		zap.Int("Devices requested", len(devices)),
+		zap.Int("Devices created", len(created)),
+	)
+	return results, nil
+}
+
+// UpdateDevices - apply a batch of device updates to devices in the specified
+// tenant in a single transaction. The result for each update is returned in
+// the order the updates were specified. Devices that are not found are
+// reported as ErrNotFound and invalid updates as ErrInvalidRequest, without
+// failing the rest of the batch. An error is returned if the batch could not
+// be processed.
+func UpdateDevices(requestID string, tenantID string,
+	updates []DeviceUpdate) ([]error, error) {
+	results := make([]error, len(updates))
+	if len(updates) > MaxDeviceBatchSize {
+		return nil, ErrInvalidRequest
+	}
+
+	// Queue the queries for each device. The number of queries queued for
+	// each update is tracked so the results can be matched to the update.
+	batch := &pgx.Batch{}
+	queued := make([]int, len(updates))
+	for i, update := range updates {
+		deviceBatch := &pgx.Batch{}
+		err := queueDeviceUpdates(deviceBatch, update.DeviceId, tenantID,
+			update.UpdateMap)
+		if err == nil && deviceBatch.Len() == 0 {
+			err = ErrInvalidRequest
+		}
+		if err != nil {
+			results[i] = err
+			continue
+		}
+		batch.QueuedQueries = append(batch.QueuedQueries,
+			deviceBatch.QueuedQueries...)
+		queued[i] = deviceBatch.Len()
+	}
+	if batch.Len() == 0 {
+		return results, nil
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to update devices!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	updated := []string{}
+	br := tx.SendBatch(ctx, batch)
+	for i, count := range queued {
+		if count == 0 {
+			continue
+		}
+		found := true
+		for j := 0; j < count; j++ {
+			ct, err := br.Exec()
+			if err != nil {
+				dstsLogger.Error("Failed to update a batch of devices in the database!",
+					zap.String("Request ID", requestID),
+					zap.String("Tenant ID", tenantID),
+					zap.String("Device ID", updates[i].DeviceId),
+					zap.Error(err),
+				)
+				metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+				_ = br.Close()
+				rollback(tx, ctx)
+				return nil, mapContextTimeoutError(err)
+			}
+			if ct.RowsAffected() == 0 {
+				found = false
+			}
+		}
+		if !found {
+			metrics.MetricDatabaseDeviceNotFoundErrors.Inc()
+			results[i] = ErrNotFound
+			continue
+		}
+		updated = append(updated, updates[i].DeviceId)
+	}
+
+	err = br.Close()
+	if err != nil {
+		dstsLogger.Error("Failed to close batch result!",
+			zap.Error(err),
+		)
+		rollback(tx, ctx)
+		return nil, ErrInternalError
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbBatchUpdateDevices)
+	metrics.MetricDatabaseDevicesUpdated.Add(float64(len(updated)))
+
+	// Remove the cache entries for the updated devices. The next read of each
+	// device will refresh its cache entry.
+	cache.RemoveDevices(requestID, updated)
+
+	dstsLogger.Info("Updated a batch of devices in the database!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Int("Devices requested", len(updates)),
+		zap.Int("Devices updated", len(updated)),
+	)
+	return results, nil
+}
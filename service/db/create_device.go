@@ -47,7 +47,7 @@ func (d *Device) CreateDevice(requestID string) error {
 
 	response := tx.QueryRow(ctx, queryInsertNewDevice, d.DeviceId, d.TenantId,
 		d.IsEnabled, d.IsLost, d.CertificateThumbprint, d.CertificateIssuedAt,
-		d.CertificateExpiresAt, d.ServiceId, d.HardwareHash)
+		d.CertificateExpiresAt, d.ServiceId, d.HardwareHash, d.Labels, d.Metadata)
 	err = response.Scan(&d.CreatedAt, &d.UpdatedAt, &d.ServiceId)
 	if err != nil {
 		rollback(tx, ctx)
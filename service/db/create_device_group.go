@@ -0,0 +1,76 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// AddDeviceGroup - add the device group to the database. If a group with the
+// same name already exists in the tenant, ErrDuplicateEntry is returned. If
+// the tenant already has the maximum number of groups, ErrNotAllowed is
+// returned.
+func (g *DeviceGroup) AddDeviceGroup(requestID string) error {
+	var groupCount int
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbAddDeviceGroup)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to add device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", g.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = tx.QueryRow(ctx, queryCountDeviceGroups, g.TenantId).Scan(&groupCount)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to count the device groups for the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", g.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+	if groupCount >= MaxDeviceGroupsPerTenant {
+		rollback(tx, ctx)
+		dstsLogger.Error("Tenant already has the maximum number of device groups!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", g.TenantId),
+			zap.Int("Group count", groupCount),
+		)
+		return ErrNotAllowed
+	}
+
+	err = tx.QueryRow(ctx, queryInsertNewDeviceGroup, g.GroupId, g.TenantId,
+		g.Name, g.Description).Scan(&g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		rollback(tx, ctx)
+		dstsLogger.Error("Failed to add the device group to the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", g.TenantId),
+			zap.String("Group name", g.Name),
+			zap.Error(err),
+		)
+		if isDuplicateKeyError(err) {
+			return ErrDuplicateEntry
+		}
+		return mapContextTimeoutError(err)
+	}
+
+	return commit(tx, ctx)
+}
@@ -0,0 +1,77 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// AddRefreshToken - add the refresh token to the database. Expired refresh
+// tokens previously issued to the device are removed. When a refresh token is
+// rotated, its family is capped at the new refresh token and the refresh
+// token it replaced, which is retained so that it is detected if presented
+// again. Older redeemed refresh tokens in the family are removed.
+func (t *RefreshToken) AddRefreshToken(requestID string) error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbAddRefreshToken)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to add refresh token!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", t.DeviceId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, queryDeleteExpiredRefreshTokens, t.DeviceId, t.TenantId)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to remove expired refresh tokens for the device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", t.DeviceId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, queryDeleteOlderRedeemedRefreshTokens, t.FamilyId)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to remove redeemed refresh tokens in the family!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", t.DeviceId),
+			zap.String("Family ID", t.FamilyId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = tx.QueryRow(ctx, queryInsertNewRefreshToken, t.TokenHash, t.FamilyId,
+		t.DeviceId, t.TenantId, t.CertificateThumbprint, t.DpopJkt,
+		t.ExpiresAt).Scan(&t.CreatedAt)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to add the refresh token to the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", t.DeviceId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return commit(tx, ctx)
+}
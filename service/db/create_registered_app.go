@@ -13,20 +13,37 @@ import (
 	"go.uber.org/zap"
 )
 
+// NewRegisteredApp - initialize a registration for the specified app. If the
+// scopes or allowed tenants are nil, those of an existing registration for the
+// app are left unchanged when it is added to the database.
 func NewRegisteredApp(appID string, appName string, isEnabled bool,
-	publicKey *rsa.PublicKey) (*RegisteredApp, error) {
+	publicKey *rsa.PublicKey, scopes []string,
+	allowedTenants []string) (*RegisteredApp, error) {
 	var err error
 
 	if publicKey == nil || appID == "" {
 		return nil, ErrInvalidRequest
 	}
 
+	// Ensure all scopes granted to the app are supported.
+	for _, scope := range scopes {
+		if !IsSupportedAppScope(scope) {
+			dstsLogger.Error("Unsupported scope specified for registered app!",
+				zap.String("App ID:", appID),
+				zap.String("Scope:", scope),
+			)
+			return nil, ErrInvalidRequest
+		}
+	}
+
 	newApp := RegisteredApp{
-		AppId:     appID,
-		Name:      appName,
-		IsEnabled: isEnabled,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		AppId:          appID,
+		Name:           appName,
+		IsEnabled:      isEnabled,
+		Scopes:         scopes,
+		AllowedTenants: allowedTenants,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
 	}
 
 	// PEM encode the public key to store it in the registered apps table.
@@ -59,8 +76,8 @@ func (a *RegisteredApp) AddOrUpdateRegisteredApp() error {
 	}
 
 	response := tx.QueryRow(ctx, queryInsertNewRegisteredApp, a.AppId, a.Name,
-		a.IsEnabled, a.PublicKeyBytes)
-	err = response.Scan(&a.CreatedAt, &a.UpdatedAt)
+		a.IsEnabled, a.PublicKeyBytes, a.Scopes, a.AllowedTenants)
+	err = response.Scan(&a.Scopes, &a.AllowedTenants, &a.CreatedAt, &a.UpdatedAt)
 	if err != nil {
 		rollback(tx, ctx)
 		metrics.MetricDatabaseCreateDeviceFailures.Inc()
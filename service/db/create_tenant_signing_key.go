@@ -0,0 +1,92 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// NewTenantSigningKey - creates a tenant signing key record for storing in the
+// database. The specified RSA private key is PEM encoded in memory.
+func NewTenantSigningKey(tenantID string, keyID string,
+	privateKey *rsa.PrivateKey) (*TenantSigningKey, error) {
+	var err error
+
+	if privateKey == nil || tenantID == "" || keyID == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	newKey := TenantSigningKey{
+		TenantId:  tenantID,
+		KeyId:     keyID,
+		CreatedAt: time.Now(),
+	}
+
+	// PEM encode the private key to store it in the tenant signing key table.
+	newKey.PrivateKey, err = encodePrivateKey(privateKey)
+	if err != nil {
+		dstsLogger.Error("Failed to encode private key to memory!",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &newKey, nil
+}
+
+// AddTenantSigningKey - add the tenant signing key to the database. If the
+// tenant already has a dedicated signing key (eg. created concurrently by
+// another DSTS instance), ErrDuplicateEntry is returned.
+func (k *TenantSigningKey) AddTenantSigningKey() error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbAddTenantSigningKey)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to create tenant signing key!",
+			zap.String("Tenant ID", k.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = tx.QueryRow(ctx, queryInsertNewTenantSigningKey, k.TenantId, k.KeyId,
+		k.PrivateKey).Scan(&k.CreatedAt)
+	if err != nil {
+		rollback(tx, ctx)
+		if errors.Is(err, pgx.ErrNoRows) || isDuplicateKeyError(err) {
+			dstsLogger.Info("Tenant already has a dedicated signing key!",
+				zap.String("Tenant ID", k.TenantId),
+			)
+			return ErrDuplicateEntry
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to add the tenant signing key to the database!",
+			zap.String("Tenant ID", k.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = commit(tx, ctx)
+	if err == nil {
+		dstsLogger.Info("Successfully created a tenant signing key in the database!",
+			zap.String("Tenant ID", k.TenantId),
+			zap.String("Key ID", k.KeyId),
+		)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// AddTokenPolicy - add the token policy to the database. If a policy with the
+// same name already exists for the tenant, ErrDuplicateEntry is returned. If
+// the tenant already has the maximum number of policies, ErrNotAllowed is
+// returned. If the policy is assigned to a device group that does not exist,
+// ErrInvalidRequest is returned.
+func (p *TokenPolicy) AddTokenPolicy(requestID string) error {
+	var policyCount int
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbAddTokenPolicy)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to add token policy!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", p.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = tx.QueryRow(ctx, queryCountTokenPolicies, p.TenantId).Scan(&policyCount)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to count the token policies for the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", p.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+	if policyCount >= MaxTokenPoliciesPerTenant {
+		rollback(tx, ctx)
+		dstsLogger.Error("Tenant already has the maximum number of token policies!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", p.TenantId),
+			zap.Int("Policy count", policyCount),
+		)
+		return ErrNotAllowed
+	}
+
+	err = tx.QueryRow(ctx, queryInsertNewTokenPolicy, p.PolicyId, p.TenantId,
+		p.Name, p.Description, p.Expression, p.IsEnabled, p.GroupId).Scan(
+		&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		rollback(tx, ctx)
+		dstsLogger.Error("Failed to add the token policy to the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", p.TenantId),
+			zap.String("Policy name", p.Name),
+			zap.Error(err),
+		)
+		if isDuplicateKeyError(err) {
+			return ErrDuplicateEntry
+		}
+		if isForeignKeyViolationError(err) {
+			return ErrInvalidRequest
+		}
+		return mapContextTimeoutError(err)
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return err
+	}
+
+	cache.RemoveTokenPolicies(requestID, p.TenantId)
+	return nil
+}
@@ -0,0 +1,42 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// DeleteDeviceGroup - delete the specified device group in the specified
+// tenant. The devices in the group are not affected. Groups to which token
+// policies are assigned cannot be deleted, and ErrNotAllowed is returned.
+func DeleteDeviceGroup(requestID string, tenantID string, groupID string) error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbDeleteDeviceGroup)
+
+	result, err := gDbPool.Exec(ctx, queryDeleteDeviceGroup, groupID, tenantID)
+	if err != nil {
+		dstsLogger.Error("Failed to delete the device group from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		if isForeignKeyViolationError(err) {
+			return ErrNotAllowed
+		}
+		return mapContextTimeoutError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
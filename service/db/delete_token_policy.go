@@ -0,0 +1,42 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// DeleteTokenPolicy - delete the specified token policy for the specified
+// tenant.
+func DeleteTokenPolicy(requestID string, tenantID string, policyID string) error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbDeleteTokenPolicy)
+
+	result, err := gDbPool.Exec(ctx, queryDeleteTokenPolicy, policyID, tenantID)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to delete the token policy from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Policy ID", policyID),
+			zap.Error(err),
+		)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	cache.RemoveTokenPolicies(requestID, tenantID)
+	return nil
+}
@@ -0,0 +1,475 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Device filters follow the syntax described by AIP-160 (https://google.aip.dev/160)
+// and are compiled to parameterized SQL conditions. The following subset is
+// supported:
+//   - comparisons of a field with a value: = != < <= > >=
+//   - the logical operators AND, OR and NOT (or -), and parentheses. As in
+//     AIP-160, OR has a higher precedence than AND.
+//
+// Values are bare words (eg. true, hpcem, hp-cem) or double quoted strings. A
+// dash within a bare word is part of the word, while a dash preceding a term
+// negates it. Timestamps are specified as quoted RFC 3339 strings
+// (eg. "2024-06-01T00:00:00Z").
+//
+// Filters are applied to the devices of a single tenant. Apart from created_at,
+// hardware_hash and group_id, fields are not indexed, so filtering on them
+// scans the devices of the tenant.
+//
+// Examples:
+//   is_enabled = true AND is_lost = false
+//   management_service = "hpcem" OR management_service = "none"
+//   certificate_expires_at < "2024-07-01T00:00:00Z"
+//   group_id = "0f8fad5b-d9cb-469f-a165-70867728950e"
+
+const (
+	// Limits on the complexity of device filters.
+	maxDeviceFilterLength       = 1024
+	maxDeviceFilterDepth        = 16
+	maxDeviceFilterRestrictions = 32
+)
+
+// Types of the fields that can be used in device filters.
+const (
+	filterFieldBool = iota
+	filterFieldString
+	filterFieldTimestamp
+	filterFieldGroup
+)
+
+type deviceFilterField struct {
+	column    string
+	fieldType int
+}
+
+// Fields that can be used in device filters, and the corresponding columns in
+// the devices table.
+var deviceFilterFields = map[string]deviceFilterField{
+	"is_enabled":             {column: "is_enabled", fieldType: filterFieldBool},
+	"enabled":                {column: "is_enabled", fieldType: filterFieldBool},
+	"is_lost":                {column: "is_lost", fieldType: filterFieldBool},
+	"management_service":     {column: "service_id", fieldType: filterFieldString},
+	"hardware_hash":          {column: "hardware_hash", fieldType: filterFieldString},
+	"certificate_expires_at": {column: "certificate_expires_at", fieldType: filterFieldTimestamp},
+	"created_at":             {column: "created_at", fieldType: filterFieldTimestamp},
+	"updated_at":             {column: "updated_at", fieldType: filterFieldTimestamp},
+	"group_id":               {column: "group_id", fieldType: filterFieldGroup},
+}
+
+// Comparison operators supported for each field type.
+var deviceFilterOperators = map[int]map[string]bool{
+	filterFieldBool:   {"=": true, "!=": true},
+	filterFieldString: {"=": true, "!=": true},
+	filterFieldGroup:  {"=": true, "!=": true},
+	filterFieldTimestamp: {
+		"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	},
+}
+
+// Fields by which devices can be ordered, and the corresponding columns.
+var deviceOrderByFields = map[string]deviceOrderByField{
+	"created_at": {column: "created_at", value: func(d *Device) time.Time {
+		return d.CreatedAt
+	}},
+	"updated_at": {column: "updated_at", value: func(d *Device) time.Time {
+		return d.UpdatedAt
+	}},
+	"certificate_expires_at": {column: "certificate_expires_at", value: func(d *Device) time.Time {
+		return d.CertificateExpiresAt
+	}},
+}
+
+type deviceOrderByField struct {
+	column string
+	value  func(*Device) time.Time
+}
+
+// deviceOrder - the order in which devices are listed. Devices with the same
+// value of the ordering column are ordered by device ID.
+type deviceOrder struct {
+	field      deviceOrderByField
+	descending bool
+}
+
+// Parse an AIP-132 style order_by string (eg. "updated_at desc"). Devices are
+// ordered by creation time by default.
+func parseDeviceOrderBy(orderBy string) (*deviceOrder, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return &deviceOrder{field: deviceOrderByFields["created_at"]}, nil
+	}
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("%w: devices can only be ordered by a single field",
+			ErrInvalidFilter)
+	}
+
+	field, ok := deviceOrderByFields[parts[0]]
+	if !ok {
+		return nil, fmt.Errorf("%w: devices cannot be ordered by %q", ErrInvalidFilter,
+			parts[0])
+	}
+	order := &deviceOrder{field: field}
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			order.descending = true
+		default:
+			return nil, fmt.Errorf("%w: unsupported sort direction %q", ErrInvalidFilter,
+				parts[1])
+		}
+	}
+	return order, nil
+}
+
+// Return the SQL condition selecting devices after the cursor, and the ORDER
+// BY clause, for the order. The cursor parameters are at the specified
+// positions.
+func (o *deviceOrder) keysetCondition(valueParam int, idParam int) string {
+	comparison := ">"
+	if o.descending {
+		comparison = "<"
+	}
+	return fmt.Sprintf(" AND (%s,device_id) %s ($%d,$%d)", o.field.column,
+		comparison, valueParam, idParam)
+}
+
+func (o *deviceOrder) orderByClause() string {
+	direction := "ASC"
+	if o.descending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf(" ORDER BY %s %s,device_id %s", o.field.column,
+		direction, direction)
+}
+
+// Filter tokens.
+const (
+	filterTokenEOF = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+)
+
+type filterToken struct {
+	kind int
+	text string
+	pos  int
+}
+
+// deviceFilterCompiler - compiles a device filter to a SQL condition. Values
+// in the filter are added to the query arguments and referenced as
+// parameters.
+type deviceFilterCompiler struct {
+	tokens       []filterToken
+	pos          int
+	depth        int
+	restrictions int
+	args         []interface{}
+}
+
+// Compile the device filter to a SQL condition to be appended to the WHERE
+// clause of a device listing query. Values are appended to the specified query
+// arguments.
+func compileDeviceFilter(filter string,
+	args []interface{}) (string, []interface{}, error) {
+	if strings.TrimSpace(filter) == "" {
+		return "", args, nil
+	}
+	if len(filter) > maxDeviceFilterLength {
+		return "", nil, fmt.Errorf("%w: filter exceeds the maximum length of %d",
+			ErrInvalidFilter, maxDeviceFilterLength)
+	}
+
+	tokens, err := tokenizeDeviceFilter(filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c := &deviceFilterCompiler{tokens: tokens, args: args}
+	condition, err := c.parseSequence()
+	if err != nil {
+		return "", nil, err
+	}
+	if c.peek().kind != filterTokenEOF {
+		return "", nil, c.unexpected()
+	}
+	return " AND " + condition, c.args, nil
+}
+
+func tokenizeDeviceFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(' || r == ')' || r == '-':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator,
+				text: string(r), pos: i})
+			i++
+
+		case r == '=' || r == '<' || r == '>' || r == '!':
+			op := string(r)
+			if (i+1 < len(runes)) && (runes[i+1] == '=') {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected character '!' at position %d",
+					ErrInvalidFilter, i)
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenOperator,
+				text: op, pos: i})
+			i += len(op)
+
+		case r == '"':
+			var value strings.Builder
+			start := i
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("%w: unterminated string at position %d",
+						ErrInvalidFilter, start)
+				}
+				if runes[i] == '\\' && (i+1 < len(runes)) {
+					value.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString,
+				text: value.String(), pos: start})
+
+		case isFilterWordChar(r):
+			start := i
+			for (i < len(runes)) && (isFilterWordChar(runes[i]) ||
+				isFilterWordDash(runes, i)) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord,
+				text: string(runes[start:i]), pos: start})
+
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q at position %d",
+				ErrInvalidFilter, r, i)
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterTokenEOF, pos: len(runes)}), nil
+}
+
+func isFilterWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || (r == '_') || (r == '.')
+}
+
+// A dash between two word characters is part of a bare word (eg. hp-cem).
+func isFilterWordDash(runes []rune, i int) bool {
+	return (runes[i] == '-') && (i > 0) && isFilterWordChar(runes[i-1]) &&
+		(i+1 < len(runes)) && isFilterWordChar(runes[i+1])
+}
+
+func (c *deviceFilterCompiler) peek() filterToken {
+	return c.tokens[c.pos]
+}
+
+func (c *deviceFilterCompiler) next() filterToken {
+	tok := c.tokens[c.pos]
+	if tok.kind != filterTokenEOF {
+		c.pos++
+	}
+	return tok
+}
+
+// Consume the next token if it is the specified keyword or operator.
+func (c *deviceFilterCompiler) accept(kind int, text string) bool {
+	tok := c.peek()
+	if (tok.kind == kind) && (tok.text == text) {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+func (c *deviceFilterCompiler) unexpected() error {
+	tok := c.peek()
+	if tok.kind == filterTokenEOF {
+		return fmt.Errorf("%w: unexpected end of filter", ErrInvalidFilter)
+	}
+	return fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidFilter,
+		tok.text, tok.pos)
+}
+
+// sequence = factor {"AND" factor}
+func (c *deviceFilterCompiler) parseSequence() (string, error) {
+	c.depth++
+	defer func() { c.depth-- }()
+	if c.depth > maxDeviceFilterDepth {
+		return "", fmt.Errorf("%w: filter is nested too deeply", ErrInvalidFilter)
+	}
+
+	return c.parseJunction("AND", c.parseFactor)
+}
+
+// factor = term {"OR" term}
+func (c *deviceFilterCompiler) parseFactor() (string, error) {
+	return c.parseJunction("OR", c.parseTerm)
+}
+
+func (c *deviceFilterCompiler) parseJunction(keyword string,
+	parseOperand func() (string, error)) (string, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return "", err
+	}
+
+	operands := []string{operand}
+	for c.accept(filterTokenWord, keyword) {
+		operand, err = parseOperand()
+		if err != nil {
+			return "", err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operand, nil
+	}
+	return "(" + strings.Join(operands, " "+keyword+" ") + ")", nil
+}
+
+// term = ["NOT" | "-"] simple
+// simple = "(" sequence ")" | restriction
+func (c *deviceFilterCompiler) parseTerm() (string, error) {
+	if c.accept(filterTokenWord, "NOT") || c.accept(filterTokenOperator, "-") {
+		operand, err := c.parseTerm()
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + operand, nil
+	}
+
+	if c.accept(filterTokenOperator, "(") {
+		condition, err := c.parseSequence()
+		if err != nil {
+			return "", err
+		}
+		if !c.accept(filterTokenOperator, ")") {
+			return "", c.unexpected()
+		}
+
+		// Conditions combining multiple restrictions are already enclosed in
+		// parentheses.
+		return condition, nil
+	}
+
+	return c.parseRestriction()
+}
+
+// restriction = field comparator value
+func (c *deviceFilterCompiler) parseRestriction() (string, error) {
+	tok := c.peek()
+	if tok.kind != filterTokenWord {
+		return "", c.unexpected()
+	}
+	field, ok := deviceFilterFields[tok.text]
+	if !ok {
+		return "", fmt.Errorf("%w: unsupported field %q at position %d",
+			ErrInvalidFilter, tok.text, tok.pos)
+	}
+	c.next()
+
+	c.restrictions++
+	if c.restrictions > maxDeviceFilterRestrictions {
+		return "", fmt.Errorf("%w: filter has more than %d restrictions",
+			ErrInvalidFilter, maxDeviceFilterRestrictions)
+	}
+
+	opToken := c.peek()
+	if opToken.kind != filterTokenOperator {
+		return "", c.unexpected()
+	}
+	if !deviceFilterOperators[field.fieldType][opToken.text] {
+		return "", fmt.Errorf("%w: operator %s is not supported for field %q",
+			ErrInvalidFilter, opToken.text, tok.text)
+	}
+	c.next()
+
+	valueToken := c.peek()
+	if (valueToken.kind != filterTokenWord) && (valueToken.kind != filterTokenString) {
+		return "", c.unexpected()
+	}
+	c.next()
+	value, err := parseDeviceFilterValue(tok.text, field, valueToken)
+	if err != nil {
+		return "", err
+	}
+	c.args = append(c.args, value)
+
+	// Group membership is matched against the members of the device group.
+	if field.fieldType == filterFieldGroup {
+		condition := fmt.Sprintf("EXISTS(SELECT 1 FROM device_group_members "+
+			"WHERE device_group_members.tenant_id=devices.tenant_id AND "+
+			"device_group_members.device_id=devices.device_id AND "+
+			"device_group_members.group_id=$%d)", len(c.args))
+		if opToken.text == "!=" {
+			return "NOT " + condition, nil
+		}
+		return condition, nil
+	}
+
+	// Nullable columns compare unequal to a value using IS DISTINCT FROM, so
+	// devices without a value are matched.
+	if (opToken.text == "!=") && (field.column == "hardware_hash") {
+		return fmt.Sprintf("%s IS DISTINCT FROM $%d", field.column,
+			len(c.args)), nil
+	}
+	op := opToken.text
+	if op == "!=" {
+		op = "<>"
+	}
+	return fmt.Sprintf("%s %s $%d", field.column, op, len(c.args)), nil
+}
+
+// Convert the value to the type of the field.
+func parseDeviceFilterValue(name string, field deviceFilterField,
+	tok filterToken) (interface{}, error) {
+	switch field.fieldType {
+	case filterFieldBool:
+		switch tok.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("%w: field %q requires a value of true or false",
+			ErrInvalidFilter, name)
+
+	case filterFieldTimestamp:
+		value, err := time.Parse(time.RFC3339, tok.text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %q requires an RFC 3339 timestamp (eg. \"2024-06-01T00:00:00Z\")",
+				ErrInvalidFilter, name)
+		}
+		return value.UTC(), nil
+	}
+	return tok.text, nil
+}
@@ -0,0 +1,63 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompileDeviceFilter_DashedValues(t *testing.T) {
+	tests := []struct {
+		filter    string
+		condition string
+		args      []interface{}
+	}{
+		// Dashes within bare values are part of the value.
+		{`management_service = hp-cem`, " AND service_id = $1",
+			[]interface{}{"hp-cem"}},
+		{`group_id = 0f8fad5b-d9cb-469f-a165-70867728950e`,
+			" AND EXISTS(SELECT 1 FROM device_group_members WHERE " +
+				"device_group_members.tenant_id=devices.tenant_id AND " +
+				"device_group_members.device_id=devices.device_id AND " +
+				"device_group_members.group_id=$1)",
+			[]interface{}{"0f8fad5b-d9cb-469f-a165-70867728950e"}},
+
+		// Dashes preceding a term negate it.
+		{`-management_service = hp-cem`, " AND NOT service_id = $1",
+			[]interface{}{"hp-cem"}},
+		{`is_enabled = true AND -(is_lost = true)`,
+			" AND (is_enabled = $1 AND NOT is_lost = $2)",
+			[]interface{}{true, true}},
+	}
+
+	for _, test := range tests {
+		condition, args, err := compileDeviceFilter(test.filter, nil)
+		if err != nil {
+			t.Errorf("TestCompileDeviceFilter_DashedValues: %s: failed to compile: %v",
+				test.filter, err)
+			continue
+		}
+		if condition != test.condition {
+			t.Errorf("TestCompileDeviceFilter_DashedValues: %s: compiled to %q, expected %q",
+				test.filter, condition, test.condition)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("TestCompileDeviceFilter_DashedValues: %s: arguments %v, expected %v",
+				test.filter, args, test.args)
+		}
+	}
+
+	// Dashes not between word characters are not part of the value.
+	for _, filter := range []string{`management_service = hp-`,
+		`management_service = -hp`} {
+		_, _, err := compileDeviceFilter(filter, nil)
+		if !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("TestCompileDeviceFilter_DashedValues: %s: expected %v, got %v",
+				filter, ErrInvalidFilter, err)
+		}
+	}
+}
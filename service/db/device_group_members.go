@@ -0,0 +1,190 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// AddDeviceGroupMembers - add the specified devices to the device group in a
+// single transaction. The result for each device is returned in the order the
+// devices were specified. Devices that are not found in the tenant are
+// reported as ErrNotFound, without failing the rest of the batch. Adding a
+// device that is already a member of the group has no effect. ErrNotFound is
+// returned if the group does not exist.
+func AddDeviceGroupMembers(requestID string, tenantID string, groupID string,
+	deviceIDs []string) ([]error, error) {
+	return updateDeviceGroupMembers(requestID, tenantID, groupID, deviceIDs,
+		queryAddDeviceGroupMember, operationDbAddGroupMembers)
+}
+
+// RemoveDeviceGroupMembers - remove the specified devices from the device
+// group in a single transaction. The result for each device is returned in the
+// order the devices were specified. Devices that are not members of the group
+// are reported as ErrNotFound, without failing the rest of the batch.
+// ErrNotFound is returned if the group does not exist.
+func RemoveDeviceGroupMembers(requestID string, tenantID string, groupID string,
+	deviceIDs []string) ([]error, error) {
+	return updateDeviceGroupMembers(requestID, tenantID, groupID, deviceIDs,
+		queryRemoveDeviceGroupMember, operationDbRemoveGroupMembers)
+}
+
+// Execute the membership query for each of the specified devices. Devices for
+// which the query affects no rows are reported as ErrNotFound.
+func updateDeviceGroupMembers(requestID string, tenantID string,
+	groupID string, deviceIDs []string, query string,
+	operation string) ([]error, error) {
+	results := make([]error, len(deviceIDs))
+	if len(deviceIDs) > MaxDeviceBatchSize {
+		return nil, ErrInvalidRequest
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to update device group members!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Ensure the group exists, and prevent it from being deleted until the
+	// transaction completes.
+	var lockedGroupID string
+	err = tx.QueryRow(ctx, queryLockDeviceGroup, groupID, tenantID).Scan(
+		&lockedGroupID)
+	if err != nil {
+		rollback(tx, ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to lookup the device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	batch := &pgx.Batch{}
+	for _, deviceID := range deviceIDs {
+		batch.Queue(query, groupID, tenantID, deviceID)
+	}
+
+	br := tx.SendBatch(ctx, batch)
+	for i := range deviceIDs {
+		ct, err := br.Exec()
+		if err != nil {
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to update the members of the device group!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.String("Group ID", groupID),
+				zap.String("Device ID", deviceIDs[i]),
+				zap.Error(err),
+			)
+			_ = br.Close()
+			rollback(tx, ctx)
+			return nil, err
+		}
+		if ct.RowsAffected() == 0 {
+			results[i] = ErrNotFound
+		}
+	}
+
+	err = br.Close()
+	if err != nil {
+		dstsLogger.Error("Failed to close batch result!",
+			zap.Error(err),
+		)
+		rollback(tx, ctx)
+		return nil, ErrInternalError
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start, operation)
+	dstsLogger.Info("Updated the members of the device group!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.String("Group ID", groupID),
+		zap.Int("Devices requested", len(deviceIDs)),
+	)
+	return results, nil
+}
+
+// ListDeviceGroupsForDevice - return the IDs of the device groups to which the
+// specified device belongs.
+func ListDeviceGroupsForDevice(requestID string, tenantID string,
+	deviceID string) ([]string, error) {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbListGroupsForDevice)
+
+	rows, err := gDbPool.Query(ctx, queryListDeviceGroupsForDevice, tenantID,
+		deviceID)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to query the device groups for the device!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Device ID", deviceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	groupIDs := []string{}
+	for rows.Next() {
+		var groupID string
+		err = rows.Scan(&groupID)
+		if err != nil {
+			dstsLogger.Error("Failed to read the device group for the device!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.String("Device ID", deviceID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Error iterating over the device groups for the device!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Device ID", deviceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return groupIDs, nil
+}
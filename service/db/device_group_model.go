@@ -0,0 +1,97 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Limits on the length of device group attributes.
+	maxDeviceGroupIdLength          = 36
+	maxDeviceGroupNameLength        = 100
+	maxDeviceGroupDescriptionLength = 500
+
+	// Maximum number of device groups that can be created in a tenant.
+	MaxDeviceGroupsPerTenant = 500
+)
+
+// DeviceGroupAction - an action applied to all members of a device group.
+type DeviceGroupAction int
+
+const (
+	DeviceGroupActionDisable DeviceGroupAction = iota
+	DeviceGroupActionEnable
+	DeviceGroupActionMarkLost
+	DeviceGroupActionMarkFound
+)
+
+// The column of the devices table updated by each device group action, and
+// the value it is set to.
+var deviceGroupActionUpdates = map[DeviceGroupAction]struct {
+	column string
+	value  bool
+}{
+	DeviceGroupActionDisable:   {column: "is_enabled", value: false},
+	DeviceGroupActionEnable:    {column: "is_enabled", value: true},
+	DeviceGroupActionMarkLost:  {column: "is_lost", value: true},
+	DeviceGroupActionMarkFound: {column: "is_lost", value: false},
+}
+
+// DeviceGroup - schema for the device_groups table in the database. Device
+// groups are named sets of devices within a tenant, used to apply actions to
+// all members of the group and to assign token policies to the members.
+type DeviceGroup struct {
+	// Unique identifier of the group.
+	GroupId string `json:"group_id"`
+
+	// The tenant to which the group belongs.
+	TenantId string `json:"tenant_id"`
+
+	// Name of the group, unique within the tenant.
+	Name string `json:"name"`
+
+	// Description of the group.
+	Description string `json:"description,omitempty"`
+
+	// Number of devices in the group. Not stored in the device_groups table.
+	MemberCount int64 `json:"member_count"`
+
+	// Creation and modification timestamps for the group.
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewDeviceGroup - initialize a new device group for the specified tenant.
+func NewDeviceGroup(tenantID string, name string,
+	description string) (*DeviceGroup, error) {
+	group := &DeviceGroup{
+		GroupId:     uuid.NewString(),
+		TenantId:    tenantID,
+		Name:        name,
+		Description: description,
+	}
+
+	err := group.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// Validate - check that the device group attributes are within the limits
+// supported by the database.
+func (g *DeviceGroup) Validate() error {
+	if (g.TenantId == "") || (g.Name == "") {
+		return ErrInvalidRequest
+	}
+	if (len(g.Name) > maxDeviceGroupNameLength) ||
+		(len(g.Description) > maxDeviceGroupDescriptionLength) {
+		return ErrInvalidRequest
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Device labels follow the syntax of Kubernetes labels. Label keys consist of
+// an optional DNS subdomain prefix and a name, separated by a slash (eg.
+// example.com/ring). Names and non-empty values consist of alphanumeric
+// characters, '-', '_' and '.', and begin and end with an alphanumeric
+// character.
+//
+// Devices are selected by their labels using Kubernetes-style label
+// selectors, consisting of comma separated requirements which must all be
+// satisfied. Examples:
+//   site=bristol,ring in (canary,pilot)
+//   department!=finance,!decommissioned
+
+const (
+	// Limits on the labels and metadata attached to a device.
+	maxDeviceLabels              = 64
+	maxDeviceLabelNameLength     = 63
+	maxDeviceLabelPrefixLength   = 253
+	maxDeviceLabelValueLength    = 63
+	maxDeviceMetadataEntries     = 32
+	maxDeviceMetadataKeyLength   = 128
+	maxDeviceMetadataValueLength = 1024
+)
+
+// ValidateDeviceLabels - check that the labels and metadata to be attached to
+// a device are well formed and within the supported limits.
+func ValidateDeviceLabels(labels map[string]string,
+	metadata map[string]string) error {
+	if len(labels) > maxDeviceLabels {
+		return fmt.Errorf("%w: a device can have at most %d labels",
+			ErrInvalidLabels, maxDeviceLabels)
+	}
+	for key, value := range labels {
+		err := validateLabelKey(key)
+		if err != nil {
+			return err
+		}
+		err = validateLabelValue(key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(metadata) > maxDeviceMetadataEntries {
+		return fmt.Errorf("%w: a device can have at most %d metadata entries",
+			ErrInvalidLabels, maxDeviceMetadataEntries)
+	}
+	for key, value := range metadata {
+		if (key == "") || (len(key) > maxDeviceMetadataKeyLength) {
+			return fmt.Errorf("%w: metadata keys must be between 1 and %d characters",
+				ErrInvalidLabels, maxDeviceMetadataKeyLength)
+		}
+		if len(value) > maxDeviceMetadataValueLength {
+			return fmt.Errorf("%w: the value of metadata key %q exceeds %d characters",
+				ErrInvalidLabels, key, maxDeviceMetadataValueLength)
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	prefix, suffix, found := strings.Cut(key, "/")
+	if found {
+		if (prefix == "") || (len(prefix) > maxDeviceLabelPrefixLength) ||
+			!isDnsSubdomain(prefix) {
+			return fmt.Errorf("%w: label key %q has an invalid prefix",
+				ErrInvalidLabels, key)
+		}
+		name = suffix
+	}
+	if (name == "") || (len(name) > maxDeviceLabelNameLength) ||
+		!isLabelName(name) {
+		return fmt.Errorf("%w: label key %q has an invalid name", ErrInvalidLabels,
+			key)
+	}
+	return nil
+}
+
+func validateLabelValue(key string, value string) error {
+	if (len(value) > maxDeviceLabelValueLength) ||
+		((value != "") && !isLabelName(value)) {
+		return fmt.Errorf("%w: label %q has an invalid value", ErrInvalidLabels,
+			key)
+	}
+	return nil
+}
+
+// Label names and values begin and end with an alphanumeric character, and
+// may contain '-', '_' and '.' in between.
+func isLabelName(name string) bool {
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if isAsciiAlphanumeric(c) {
+			continue
+		}
+		if (i == 0) || (i == len(name)-1) ||
+			((c != '-') && (c != '_') && (c != '.')) {
+			return false
+		}
+	}
+	return true
+}
+
+// DNS subdomains consist of dot separated lower case labels, each beginning
+// and ending with an alphanumeric character.
+func isDnsSubdomain(name string) bool {
+	for _, part := range strings.Split(name, ".") {
+		if (part == "") || (len(part) > 63) {
+			return false
+		}
+		for i := 0; i < len(part); i++ {
+			c := part[i]
+			if ((c >= 'a') && (c <= 'z')) || ((c >= '0') && (c <= '9')) {
+				continue
+			}
+			if (c != '-') || (i == 0) || (i == len(part)-1) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isAsciiAlphanumeric(c byte) bool {
+	return ((c >= 'a') && (c <= 'z')) || ((c >= 'A') && (c <= 'Z')) ||
+		((c >= '0') && (c <= '9'))
+}
+
+// labelSelectorCompiler - compiles a label selector to a SQL condition on the
+// labels column. Conditions use the JSONB containment (@>) and existence (?)
+// operators, so they can be served by the GIN index on the labels column.
+type labelSelectorCompiler struct {
+	tokens       []filterToken
+	pos          int
+	restrictions int
+	args         []interface{}
+}
+
+// Compile the label selector to a SQL condition to be appended to the WHERE
+// clause of a device listing query. Labels being matched are appended to the
+// specified query arguments.
+func compileLabelSelector(selector string,
+	args []interface{}) (string, []interface{}, error) {
+	if strings.TrimSpace(selector) == "" {
+		return "", args, nil
+	}
+	if len(selector) > maxDeviceFilterLength {
+		return "", nil, fmt.Errorf("%w: label selector exceeds the maximum length of %d",
+			ErrInvalidFilter, maxDeviceFilterLength)
+	}
+
+	tokens, err := tokenizeLabelSelector(selector)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c := &labelSelectorCompiler{tokens: tokens, args: args}
+	var conditions []string
+	for {
+		condition, err := c.parseRequirement()
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if c.peek().kind == filterTokenEOF {
+			break
+		}
+		if !c.accept(",") {
+			return "", nil, c.unexpected()
+		}
+	}
+	return " AND " + strings.Join(conditions, " AND "), c.args, nil
+}
+
+func tokenizeLabelSelector(selector string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(selector); {
+		c := selector[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+
+		case (c == '(') || (c == ')') || (c == ','):
+			tokens = append(tokens, filterToken{kind: filterTokenOperator,
+				text: string(c), pos: i})
+			i++
+
+		case (c == '=') || (c == '!'):
+			op := string(c)
+			if (i+1 < len(selector)) && (selector[i+1] == '=') {
+				op += "="
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenOperator,
+				text: op, pos: i})
+			i += len(op)
+
+		case isLabelSelectorWordChar(c):
+			start := i
+			for (i < len(selector)) && isLabelSelectorWordChar(selector[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord,
+				text: selector[start:i], pos: start})
+
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q at position %d in label selector",
+				ErrInvalidFilter, c, i)
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterTokenEOF, pos: len(selector)}), nil
+}
+
+func isLabelSelectorWordChar(c byte) bool {
+	return isAsciiAlphanumeric(c) || (c == '-') || (c == '_') || (c == '.') ||
+		(c == '/')
+}
+
+func (c *labelSelectorCompiler) peek() filterToken {
+	return c.tokens[c.pos]
+}
+
+// Consume the next token if it is the specified operator.
+func (c *labelSelectorCompiler) accept(op string) bool {
+	tok := c.peek()
+	if (tok.kind == filterTokenOperator) && (tok.text == op) {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+func (c *labelSelectorCompiler) unexpected() error {
+	tok := c.peek()
+	if tok.kind == filterTokenEOF {
+		return fmt.Errorf("%w: unexpected end of label selector", ErrInvalidFilter)
+	}
+	return fmt.Errorf("%w: unexpected %q at position %d in label selector",
+		ErrInvalidFilter, tok.text, tok.pos)
+}
+
+// requirement = "!" key | key [("=" | "==" | "!=") value | ("in" | "notin") set]
+func (c *labelSelectorCompiler) parseRequirement() (string, error) {
+	if c.accept("!") {
+		key, err := c.parseKey()
+		if err != nil {
+			return "", err
+		}
+		condition, err := c.labelExists(key)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + condition, nil
+	}
+
+	key, err := c.parseKey()
+	if err != nil {
+		return "", err
+	}
+
+	tok := c.peek()
+	switch {
+	case (tok.kind == filterTokenEOF) ||
+		((tok.kind == filterTokenOperator) && (tok.text == ",")):
+		return c.labelExists(key)
+
+	case c.accept("=") || c.accept("=="):
+		value, err := c.parseValue(key)
+		if err != nil {
+			return "", err
+		}
+		return c.labelEquals(key, value)
+
+	case c.accept("!="):
+		value, err := c.parseValue(key)
+		if err != nil {
+			return "", err
+		}
+		condition, err := c.labelEquals(key, value)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + condition, nil
+
+	case (tok.kind == filterTokenWord) && ((tok.text == "in") || (tok.text == "notin")):
+		c.pos++
+		condition, err := c.parseSet(key)
+		if err != nil {
+			return "", err
+		}
+		if tok.text == "notin" {
+			return "NOT " + condition, nil
+		}
+		return condition, nil
+	}
+	return "", c.unexpected()
+}
+
+func (c *labelSelectorCompiler) parseKey() (string, error) {
+	tok := c.peek()
+	if tok.kind != filterTokenWord {
+		return "", c.unexpected()
+	}
+	err := validateLabelKey(tok.text)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid label key %q at position %d",
+			ErrInvalidFilter, tok.text, tok.pos)
+	}
+	c.pos++
+	return tok.text, nil
+}
+
+// Values may be empty, in which case devices with the label set to an empty
+// value are matched.
+func (c *labelSelectorCompiler) parseValue(key string) (string, error) {
+	tok := c.peek()
+	if tok.kind != filterTokenWord {
+		return "", nil
+	}
+	err := validateLabelValue(key, tok.text)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid label value %q at position %d",
+			ErrInvalidFilter, tok.text, tok.pos)
+	}
+	c.pos++
+	return tok.text, nil
+}
+
+// set = "(" value {"," value} ")"
+func (c *labelSelectorCompiler) parseSet(key string) (string, error) {
+	if !c.accept("(") || (c.peek().text == ")") {
+		return "", c.unexpected()
+	}
+
+	var conditions []string
+	for {
+		value, err := c.parseValue(key)
+		if err != nil {
+			return "", err
+		}
+		condition, err := c.labelEquals(key, value)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+
+		if c.accept(")") {
+			break
+		}
+		if !c.accept(",") {
+			return "", c.unexpected()
+		}
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", nil
+}
+
+func (c *labelSelectorCompiler) addRestriction(value interface{}) error {
+	c.restrictions++
+	if c.restrictions > maxDeviceFilterRestrictions {
+		return fmt.Errorf("%w: label selector has more than %d restrictions",
+			ErrInvalidFilter, maxDeviceFilterRestrictions)
+	}
+	c.args = append(c.args, value)
+	return nil
+}
+
+func (c *labelSelectorCompiler) labelEquals(key string, value string) (string, error) {
+	err := c.addRestriction(map[string]string{key: value})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("labels @> $%d::jsonb", len(c.args)), nil
+}
+
+func (c *labelSelectorCompiler) labelExists(key string) (string, error) {
+	err := c.addRestriction(key)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("labels ? $%d", len(c.args)), nil
+}
@@ -47,4 +47,10 @@ type Device struct {
 
 	// The hardware hash of the device.
 	HardwareHash string `json:"hardware_hash,omitempty"`
+
+	// Labels attached to the device, used to select devices.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Free-form metadata attached to the device.
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
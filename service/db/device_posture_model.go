@@ -0,0 +1,40 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"time"
+)
+
+// Supported disk encryption states reported by devices.
+const (
+	DiskEncryptionEncrypted          = "encrypted"
+	DiskEncryptionPartiallyEncrypted = "partially_encrypted"
+	DiskEncryptionNotEncrypted       = "not_encrypted"
+	DiskEncryptionUnknown            = "unknown"
+)
+
+// DevicePosture - schema for the device_posture table in the database. Holds
+// the posture last reported by the device.
+type DevicePosture struct {
+	// The device which reported the posture.
+	DeviceId string `json:"-"`
+	TenantId string `json:"-"`
+
+	// Version of the operating system running on the device.
+	OsVersion string `json:"os_version,omitempty"`
+
+	// Security patch level of the operating system.
+	PatchLevel string `json:"patch_level,omitempty"`
+
+	// Disk encryption state of the device.
+	DiskEncryption string `json:"disk_encryption,omitempty"`
+
+	// Version of the device agent that reported the posture.
+	AgentVersion string `json:"agent_version,omitempty"`
+
+	// Timestamp at which the posture was reported.
+	ReportedAt time.Time `json:"-"`
+}
@@ -0,0 +1,34 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import "time"
+
+// DeviceTransfer - schema for the history of devices transferred between
+// tenants in the database.
+type DeviceTransfer struct {
+	// Unique identifier assigned to the transfer.
+	TransferId string `json:"transfer_id"`
+
+	// The unique immutable identifier assigned to the transferred device.
+	DeviceId string `json:"deviceid"`
+
+	// The tenant from which the device was transferred.
+	SourceTenantId string `json:"source_tenantid"`
+
+	// The tenant to which the device was transferred.
+	TargetTenantId string `json:"target_tenantid"`
+
+	// Thumbprint of the device certificate issued to the device in the source
+	// tenant.
+	PreviousCertificateThumbprint string `json:"prev_cert_thumbprint"`
+
+	// Thumbprint of the device certificate issued to the device in the target
+	// tenant.
+	CertificateThumbprint string `json:"cert_thumbprint"`
+
+	// Timestamp at which the device was transferred.
+	TransferredAt time.Time `json:"transferred_at"`
+}
@@ -29,6 +29,10 @@ var (
 	ErrDecodePrivateKey  = errors.New("failed to decode the private key")
 	ErrDecodePublicKey   = errors.New("failed to decode the public key")
 	ErrDatabaseBusy      = errors.New("no available database resources to process this request")
+	ErrTooManyStreams    = errors.New("too many device streams are in progress")
+	ErrTokenReused       = errors.New("a previously redeemed token was presented")
+	ErrInvalidFilter     = errors.New("invalid filter")
+	ErrInvalidLabels     = errors.New("invalid device labels or metadata")
 )
 
 func isDuplicateKeyError(err error) bool {
@@ -41,6 +45,18 @@ func isDuplicateKeyError(err error) bool {
 	return false
 }
 
+// Check if the specified error was caused by a reference to a row that does
+// not exist, or by deleting a row that is still referenced.
+func isForeignKeyViolationError(err error) bool {
+	pgErr, ok := err.(*pgconn.PgError)
+	if ok {
+		if pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return true
+		}
+	}
+	return false
+}
+
 // Check if the specified error was caused due to a context deadline being
 // exceeded. If so, map the error to a database busy error, so we can return
 // an HTTP 429 (Server Busy) error for the client to retry later.
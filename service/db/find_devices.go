@@ -0,0 +1,109 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// FoundDevice - a device located by FindDevices.
+type FoundDevice struct {
+	Device
+
+	// Specifies whether the device was found using the thumbprint of its
+	// previous certificate.
+	MatchedPreviousCertificate bool
+
+	// Specifies whether another device ID shares the hardware hash of the
+	// device, within the tenant searched or across tenants.
+	DuplicateHardwareHash bool
+}
+
+// FindDevices - find devices by their current or previous certificate
+// thumbprint, or by hardware hash. Exactly one of the thumbprint and hardware
+// hash must be specified. If the tenant ID is empty, devices are found across
+// all tenants. At most maxDbQueryPageSize devices are returned.
+func FindDevices(requestID string, tenantID string, thumbprint string,
+	hardwareHash string) ([]FoundDevice, error) {
+	var query, value string
+	switch {
+	case (thumbprint != "") && (hardwareHash == ""):
+		query = queryFindDevicesByThumbprint
+		value = strings.ToLower(thumbprint)
+	case (thumbprint == "") && (hardwareHash != ""):
+		query = queryFindDevicesByHardwareHash
+		value = hardwareHash
+	default:
+		return nil, ErrInvalidRequest
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+
+	response, err := gDbPool.Query(ctx, query, value, tenantID,
+		maxDbQueryPageSize)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to find devices in the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseFindDevicesFailures.Inc()
+		return nil, err
+	}
+	defer response.Close()
+
+	foundDevices := []FoundDevice{}
+	for response.Next() {
+		var found FoundDevice
+		err = response.Scan(&found.DeviceId, &found.TenantId,
+			&found.IsEnabled, &found.IsLost, &found.CertificateThumbprint,
+			&found.CertificateIssuedAt, &found.CertificateExpiresAt,
+			&found.CreatedAt, &found.UpdatedAt, &found.ServiceId,
+			&found.HardwareHash, &found.Labels, &found.Metadata,
+			&found.PreviousCertificateThumbprint,
+			&found.DuplicateHardwareHash)
+		if err != nil {
+			dstsLogger.Error("Failed to read a found device from the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			metrics.MetricDatabaseFindDevicesFailures.Inc()
+			return nil, err
+		}
+		found.MatchedPreviousCertificate = (thumbprint != "") &&
+			(found.CertificateThumbprint != value)
+		foundDevices = append(foundDevices, found)
+	}
+
+	if response.Err() != nil {
+		err = mapContextTimeoutError(response.Err())
+		dstsLogger.Error("Failed to find devices in the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseFindDevicesFailures.Inc()
+		return nil, err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbFindDevices)
+	metrics.MetricDatabaseDevicesRetrieved.Add(float64(len(foundDevices)))
+	dstsLogger.Info("Found devices in the database!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Int("Number of devices", len(foundDevices)),
+	)
+	return foundDevices, nil
+}
@@ -31,6 +31,12 @@ func GetDevice(requestID string, tenantID string,
 				zap.String("Device ID", deviceID),
 				zap.String("Tenant ID", tenantID),
 			)
+		} else if foundDevice.TenantId != tenantID {
+			// Devices are cached by device ID. The cached device belongs to
+			// another tenant, for instance if it was transferred between
+			// tenants, so lookup the device in the database.
+			foundDevice = Device{}
+			err = ErrNotFound
 		}
 	}
 
@@ -49,7 +55,8 @@ func GetDevice(requestID string, tenantID string,
 			&foundDevice.IsEnabled, &foundDevice.IsLost, &foundDevice.CertificateThumbprint,
 			&foundDevice.CertificateIssuedAt, &foundDevice.CertificateExpiresAt,
 			&foundDevice.PreviousCertificateThumbprint, &foundDevice.CreatedAt, &foundDevice.UpdatedAt,
-			&foundDevice.ServiceId, &foundDevice.HardwareHash)
+			&foundDevice.ServiceId, &foundDevice.HardwareHash, &foundDevice.Labels,
+			&foundDevice.Metadata)
 		if err != nil {
 			dstsLogger.Error("Failed to find the specified device in the database!",
 				zap.String("Request ID", requestID),
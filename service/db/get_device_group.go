@@ -0,0 +1,55 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// GetDeviceGroup - retrieve the specified device group in the specified
+// tenant, including the number of devices in the group.
+func GetDeviceGroup(requestID string, tenantID string,
+	groupID string) (*DeviceGroup, error) {
+	var group DeviceGroup
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetDeviceGroup)
+
+	err := scanDeviceGroup(gDbPool.QueryRow(ctx, queryGetDeviceGroup, groupID,
+		tenantID), &group)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to retrieve the device group from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Group ID", groupID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// scanDeviceGroup - scan a row returned by a device group query into the
+// group.
+func scanDeviceGroup(row pgx.Row, group *DeviceGroup) error {
+	return row.Scan(&group.GroupId, &group.TenantId, &group.Name,
+		&group.Description, &group.CreatedAt, &group.UpdatedAt,
+		&group.MemberCount)
+}
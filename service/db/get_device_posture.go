@@ -0,0 +1,49 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// GetDevicePosture - retrieve the posture last reported by the specified
+// device within the specified tenant.
+func GetDevicePosture(requestID string, tenantID string,
+	deviceID string) (*DevicePosture, error) {
+	var posture DevicePosture
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetDevicePosture)
+
+	err := gDbPool.QueryRow(ctx, queryGetDevicePosture, deviceID,
+		tenantID).Scan(&posture.DeviceId, &posture.TenantId, &posture.OsVersion,
+		&posture.PatchLevel, &posture.DiskEncryption, &posture.AgentVersion,
+		&posture.ReportedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to retrieve the device posture from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &posture, nil
+}
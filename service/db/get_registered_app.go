@@ -43,7 +43,8 @@ func GetRegisteredApp(requestID string, appID string) (*RegisteredApp,
 
 		response := gDbPool.QueryRow(ctx, queryGetRegisteredApp, appID)
 		err = response.Scan(&foundApp.AppId, &foundApp.Name, &foundApp.IsEnabled,
-			&foundApp.PublicKeyBytes, &foundApp.CreatedAt, &foundApp.UpdatedAt)
+			&foundApp.PublicKeyBytes, &foundApp.Scopes, &foundApp.AllowedTenants,
+			&foundApp.CreatedAt, &foundApp.UpdatedAt)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				dstsLogger.Error("Registered app was not found!",
@@ -0,0 +1,54 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// GetTenant - retrieve information about the specified tenant, including its
+// lifecycle state. Tenants that are not recorded in the database are active.
+// The tenant is always read from the database rather than the cache, so that
+// changes to its state take effect immediately.
+func GetTenant(requestID string, tenantID string) (*Tenant, error) {
+	var tenant Tenant
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetTenant)
+
+	err := scanTenant(gDbPool.QueryRow(ctx, queryGetTenant, tenantID), &tenant)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to get the tenant from the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		tenant = Tenant{
+			TenantId: tenantID,
+			State:    TenantStateActive,
+		}
+	}
+
+	return &tenant, nil
+}
+
+// Scan a tenant returned by a tenant query.
+func scanTenant(row pgx.Row, tenant *Tenant) error {
+	return row.Scan(&tenant.TenantId, &tenant.State, &tenant.CreatedAt,
+		&tenant.UpdatedAt, &tenant.PurgeStartedAt, &tenant.PurgedAt)
+}
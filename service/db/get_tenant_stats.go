@@ -0,0 +1,69 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// GetTenantStats - retrieve counts of the devices in the specified tenant, by
+// state, management service and device certificate expiry.
+func GetTenantStats(requestID string, tenantID string) (*TenantStats, error) {
+	stats := TenantStats{
+		DevicesByService: map[string]int64{},
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetTenantStats)
+
+	err := gDbPool.QueryRow(ctx, queryGetTenantDeviceStats, tenantID).Scan(
+		&stats.TotalDevices, &stats.EnabledDevices, &stats.LostDevices,
+		&stats.CertificatesExpired, &stats.CertificatesExpiringIn30Days,
+		&stats.CertificatesExpiringIn90Days, &stats.CertificatesExpiringLater)
+	if err != nil {
+		return nil, tenantStatsError(requestID, tenantID, err)
+	}
+
+	rows, err := gDbPool.Query(ctx, queryGetTenantDevicesByService, tenantID)
+	if err != nil {
+		return nil, tenantStatsError(requestID, tenantID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var serviceID string
+		var count int64
+		err = rows.Scan(&serviceID, &count)
+		if err != nil {
+			return nil, tenantStatsError(requestID, tenantID, err)
+		}
+		stats.DevicesByService[serviceID] = count
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, tenantStatsError(requestID, tenantID, err)
+	}
+
+	return &stats, nil
+}
+
+func tenantStatsError(requestID string, tenantID string, err error) error {
+	err = mapContextTimeoutError(err)
+	dstsLogger.Error("Failed to get device statistics for the tenant!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Error(err),
+	)
+	metrics.MetricDatabaseGetTenantStatsFailures.Inc()
+	return err
+}
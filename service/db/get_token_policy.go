@@ -0,0 +1,49 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// GetTokenPolicy - retrieve the specified token policy for the specified
+// tenant.
+func GetTokenPolicy(requestID string, tenantID string,
+	policyID string) (*TokenPolicy, error) {
+	var policy TokenPolicy
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetTokenPolicy)
+
+	err := gDbPool.QueryRow(ctx, queryGetTokenPolicy, policyID,
+		tenantID).Scan(&policy.PolicyId, &policy.TenantId, &policy.Name,
+		&policy.Description, &policy.Expression, &policy.IsEnabled,
+		&policy.GroupId, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to retrieve the token policy from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.String("Policy ID", policyID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &policy, nil
+}
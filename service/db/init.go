@@ -30,25 +30,71 @@ const (
 	// Database connection retry interval
 	connectionRetryInterval                = (time.Second * 5)
 	dbOperationTimeout                     = (time.Second * 5)
+	dbBatchOperationTimeout                = (time.Second * 30)
 	defaultIdleInTransactionSessionTimeout = (time.Second * 10)
 	defaultStatementTimeout                = (time.Second * 10)
 
 	// Maximum number of records to return in one query.
 	maxDbQueryPageSize = 100
 
+	// Maximum duration of a device stream, and the maximum time the database
+	// waits for the consumer of a device stream to request the next chunk.
+	maxDbStreamDuration      = (time.Minute * 30)
+	dbStreamIdleTimeout      = (time.Minute * 1)
+	maxDbStreamChunkSize     = 1000
+	defaultDbStreamChunkSize = 100
+
+	// Device streams hold a database connection for their duration. At most
+	// a quarter of the connection pool is used by device streams, and each
+	// tenant can have a limited number of device streams in progress.
+	dbStreamConnectionShare = 4
+	maxDbStreamsPerTenant   = 2
+
 	// Database operations.
 	operationDbCreateDevice          = "CreateDevice"
 	operationDbGetDevice             = "GetDevice"
 	operationDbGetTombstonedDevice   = "GetTombstonedDevice"
+	operationDbListTombstonedDevices = "ListTombstonedDevices"
+	operationDbRestoreDevice         = "RestoreDevice"
+	operationDbPurgeTombstones       = "PurgeTombstonedDevices"
 	operationDbDeleteDevice          = "DeleteDevice"
 	operationDbUpdateDevice          = "UpdateDevice"
+	operationDbBatchCreateDevices    = "BatchCreateDevices"
+	operationDbBatchUpdateDevices    = "BatchUpdateDevices"
 	operationDbListDevices           = "ListDevices"
+	operationDbStreamDevices         = "StreamDevices"
+	operationDbFindDevices           = "FindDevices"
 	operationDbCreateEnrollmentToken = "CreateEnrollmentToken"
 	operationDbGetEnrollmentToken    = "GetEnrollmentToken"
 	operationDbDeleteEnrollmentToken = "DeleteEnrollmentToken"
 	operationDbAddSigningKey         = "AddSigningKey"
 	operationDbGetSigningKey         = "GetSigningKey"
 	operationDbDeleteSigningKey      = "DeleteSigningKey"
+	operationDbAddTenantSigningKey   = "AddTenantSigningKey"
+	operationDbListTenantSigningKeys = "ListTenantSigningKeys"
+	operationDbAddRefreshToken       = "AddRefreshToken"
+	operationDbRedeemRefreshToken    = "RedeemRefreshToken"
+	operationDbUpdateDevicePosture   = "UpdateDevicePosture"
+	operationDbGetDevicePosture      = "GetDevicePosture"
+	operationDbAddTokenPolicy        = "AddTokenPolicy"
+	operationDbGetTokenPolicy        = "GetTokenPolicy"
+	operationDbListTokenPolicies     = "ListTokenPolicies"
+	operationDbUpdateTokenPolicy     = "UpdateTokenPolicy"
+	operationDbDeleteTokenPolicy     = "DeleteTokenPolicy"
+	operationDbTransferDevice        = "TransferDevice"
+	operationDbGetDeviceTransfer     = "GetDeviceTransfer"
+	operationDbGetTenant             = "GetTenant"
+	operationDbUpdateTenantState     = "UpdateTenantState"
+	operationDbPurgeTenant           = "PurgeTenant"
+	operationDbGetTenantStats        = "GetTenantStats"
+	operationDbAddDeviceGroup        = "AddDeviceGroup"
+	operationDbGetDeviceGroup        = "GetDeviceGroup"
+	operationDbListDeviceGroups      = "ListDeviceGroups"
+	operationDbDeleteDeviceGroup     = "DeleteDeviceGroup"
+	operationDbAddGroupMembers       = "AddDeviceGroupMembers"
+	operationDbRemoveGroupMembers    = "RemoveDeviceGroupMembers"
+	operationDbListGroupsForDevice   = "ListDeviceGroupsForDevice"
+	operationDbApplyGroupAction      = "ApplyDeviceGroupAction"
 	operationDbAddRegisteredApp      = "AddRegisteredApp"
 	operationDbGetRegisteredApp      = "GetRegisteredApp"
 	operationDbDeleteRegisteredApp   = "DeleteRegisteredApp"
@@ -89,6 +135,12 @@ func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) error {
 		return err
 	}
 
+	// Purge tombstones of deleted devices older than their retention.
+	startTombstonePurge(cfgMgr)
+
+	// Resume purging the data of deleted tenants.
+	startTenantPurges()
+
 	// Register applications specified in the configuration file.
 	return initRegisteredApps(cfgMgr.GetRegisteredApps())
 }
@@ -96,6 +148,12 @@ func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) error {
 // Shutdown - close the connection to the device database. Also close the
 // connection to the device cache.
 func Shutdown() {
+	// Stop the tombstone purge job.
+	stopTombstonePurge()
+
+	// Stop tenant purge jobs in progress.
+	stopTenantPurges()
+
 	// Shutdown the device database and close connections.
 	shutdownDeviceDatabase()
 
@@ -128,6 +186,8 @@ func initPgxConfig(dbConfig *config.DatabaseConfig, connStr string) (*pgxpool.Co
 		}
 	}
 
+	maxDbStreams = max(1, int(pgxConfig.MaxConns)/dbStreamConnectionShare)
+
 	runtimeParams := pgxConfig.ConnConfig.RuntimeParams
 	runtimeParams["application_name"] = config.ServiceName
 	runtimeParams["idle_in_transaction_session_timeout"] =
@@ -0,0 +1,63 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// ListDeviceGroups - retrieve the device groups in the specified tenant,
+// ordered by name.
+func ListDeviceGroups(requestID string, tenantID string) ([]DeviceGroup, error) {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbListDeviceGroups)
+
+	rows, err := gDbPool.Query(ctx, queryListDeviceGroups, tenantID)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to query device groups from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := []DeviceGroup{}
+	for rows.Next() {
+		var group DeviceGroup
+		err = scanDeviceGroup(rows, &group)
+		if err != nil {
+			dstsLogger.Error("Failed to read device group from the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Error iterating over device groups!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return groups, nil
+}
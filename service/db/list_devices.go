@@ -6,8 +6,7 @@ package db
 
 import (
 	"context"
-	"errors"
-	"strings"
+	"fmt"
 	"time"
 
 	"github.com/HPInc/krypton-dsts/service/metrics"
@@ -15,86 +14,94 @@ import (
 	"go.uber.org/zap"
 )
 
-func trimQuotes(str string) string {
-	s := strings.TrimSpace(str)
-
-	if len(s) >= 2 {
-		if s[0] == '"' && s[len(s)-1] == '"' {
-			return s[1 : len(s)-1]
-		}
-	}
-	return s
-}
-
+// ListDevicesPaginated - return a page of the devices in the tenant matching
+// the filter and label selector. Devices are returned in order of their creation time and device
+// ID, so pages remain stable while devices are added or removed.
 func (d *Device) ListDevicesPaginated(requestID string, tenantID string,
-	filter string, page *Paginator) ([]Device, error) {
-	var (
-		err      error
-		start    time.Time
-		response pgx.Rows
-	)
+	filter string, labelSelector string, page *Paginator) (*DevicesPage, error) {
+	var response pgx.Rows
 
-	devices := []Device{}
-
-	start = time.Now()
+	start := time.Now()
 	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
 	defer cancelFunc()
 
-	if filter != "" {
-		// A filter was specified in the request. Parse the specified filter
-		// and figure out what is being requested.
-		queries := strings.Split(filter, "=")
-		if len(queries) != 2 {
-			dstsLogger.Error("Invalid filter format requested.",
-				zap.String("Filter requested: ", filter),
-			)
-			return nil, errors.New("invalid filter format requested")
-		}
-
-		switch strings.TrimSpace(queries[0]) {
-		case "enabled":
-			// List all devices in the tenant filtered by their current isEnabled
-			// state.
-			// filter: enabled=true or enabled=false
-			stateRequested := trimQuotes(queries[1])
-			if stateRequested != "true" && stateRequested != "false" {
-				dstsLogger.Error("Invalid value for enabled filter requested.",
-					zap.String("Filter requested: ", filter),
-				)
-				return nil, errors.New("invalid filter value requested")
-			}
+	// Compile the filter and the sort order specified in the request.
+	conditions, args, err := compileDeviceFilter(filter,
+		[]interface{}{tenantID})
+	if err != nil {
+		dstsLogger.Error("Invalid filter requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Filter requested: ", filter),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	labelConditions, args, err := compileLabelSelector(labelSelector, args)
+	if err != nil {
+		dstsLogger.Error("Invalid label selector requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Label selector requested: ", labelSelector),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	conditions += labelConditions
+	order, err := parseDeviceOrderBy(page.OrderBy)
+	if err != nil {
+		dstsLogger.Error("Invalid sort order requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Order requested: ", page.OrderBy),
+			zap.Error(err),
+		)
+		return nil, err
+	}
 
-			// Look for all devices in the tenant that match the requested isEnabled
-			// state.
-			response, err = gDbPool.Query(ctx, queryListEnabledDevicesInTenant, tenantID)
+	result := &DevicesPage{Devices: []Device{}}
 
-		default:
-			dstsLogger.Error("Unsupported filter requested",
-				zap.String("Filter requested: ", filter),
+	// Count the devices matching the filter, if requested.
+	if page.IncludeTotalCount {
+		err = gDbPool.QueryRow(ctx, queryCountDevicesInTenant+conditions,
+			args...).Scan(&result.TotalCount)
+		if err != nil {
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to count the devices for the specified tenant!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Tenant ID: ", tenantID),
+				zap.Error(err),
 			)
-			return nil, errors.New("invalid filter format requested")
+			metrics.MetricDatabaseListDevicesFailures.Inc()
+			return nil, err
 		}
-	} else {
-		// No filter was specified in the request. List all devices belonging to
-		// the specified tenant.
-		response, err = gDbPool.Query(ctx, queryListAllDevicesInTenant, tenantID)
 	}
 
+	// Start after the last device returned in the previous page. One more
+	// device than the page size is requested, to determine whether there are
+	// more results.
+	if page.Cursor != nil {
+		args = append(args, page.Cursor.SortValue, page.Cursor.DeviceId)
+		conditions += order.keysetCondition(len(args)-1, len(args))
+	}
+	limit := page.GetLimit()
+	args = append(args, limit+1)
+	conditions += order.orderByClause() + fmt.Sprintf(" LIMIT $%d", len(args))
+
+	response, err = gDbPool.Query(ctx, queryListAllDevicesInTenant+conditions,
+		args...)
 	if err != nil {
+		err = mapContextTimeoutError(err)
 		dstsLogger.Error("Failed to get a list of devices for the specified tenant!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
 			zap.Error(err),
 		)
+		metrics.MetricDatabaseListDevicesFailures.Inc()
 		return nil, err
 	}
 	defer response.Close()
 
 	for response.Next() {
 		var foundDevice Device
-		err = response.Scan(&foundDevice.DeviceId, &foundDevice.TenantId,
-			&foundDevice.IsEnabled, &foundDevice.IsLost, &foundDevice.CertificateThumbprint,
-			&foundDevice.CertificateIssuedAt, &foundDevice.CertificateExpiresAt,
-			&foundDevice.CreatedAt, &foundDevice.UpdatedAt, &foundDevice.ServiceId,
-			&foundDevice.HardwareHash)
+		err = scanListedDevice(response, &foundDevice)
 		if err != nil {
 			dstsLogger.Error("Failed to get a list of devices from the database!",
 				zap.String("Request ID: ", requestID),
@@ -103,28 +110,49 @@ func (d *Device) ListDevicesPaginated(requestID string, tenantID string,
 			)
 			return nil, err
 		}
-		devices = append(devices, foundDevice)
+		result.Devices = append(result.Devices, foundDevice)
 	}
 
 	if response.Err() != nil {
+		err = mapContextTimeoutError(response.Err())
 		dstsLogger.Error("Failed to retreive list of devices for the specified tenant!",
 			zap.String("Request ID: ", requestID),
 			zap.String("Tenant ID: ", tenantID),
 			zap.String("Filter: ", filter),
+			zap.Error(err),
 		)
 		metrics.MetricDatabaseListDevicesFailures.Inc()
 		return nil, err
 	}
 
+	if len(result.Devices) > limit {
+		result.Devices = result.Devices[:limit]
+		last := result.Devices[limit-1]
+		result.NextCursor = &DeviceCursor{
+			SortValue: order.field.value(&last),
+			DeviceId:  last.DeviceId,
+		}
+	}
+
 	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
 		operationDbListDevices)
-	metrics.MetricDatabaseDevicesRetrieved.Add(float64(len(devices)))
+	metrics.MetricDatabaseDevicesRetrieved.Add(float64(len(result.Devices)))
 
 	dstsLogger.Info("Retrieved devices for the specified tenant!",
 		zap.String("Request ID: ", requestID),
 		zap.String("Tenant ID: ", tenantID),
 		zap.String("Filter: ", filter),
-		zap.Int("Number of records: ", len(devices)),
+		zap.Int("Number of records: ", len(result.Devices)),
 	)
-	return devices, nil
+	return result, nil
+}
+
+// scanListedDevice - scan a row returned by a device listing query into the
+// device.
+func scanListedDevice(rows pgx.Rows, device *Device) error {
+	return rows.Scan(&device.DeviceId, &device.TenantId,
+		&device.IsEnabled, &device.IsLost, &device.CertificateThumbprint,
+		&device.CertificateIssuedAt, &device.CertificateExpiresAt,
+		&device.CreatedAt, &device.UpdatedAt, &device.ServiceId,
+		&device.HardwareHash, &device.Labels, &device.Metadata)
 }
@@ -0,0 +1,58 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// ListTenantSigningKeys - retrieve all tenant signing keys from the database.
+func ListTenantSigningKeys() ([]TenantSigningKey, error) {
+	var keys []TenantSigningKey
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbListTenantSigningKeys)
+
+	rows, err := gDbPool.Query(ctx, queryListTenantSigningKeys)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to query tenant signing keys from the database!",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key TenantSigningKey
+		err = rows.Scan(&key.TenantId, &key.KeyId, &key.PrivateKey,
+			&key.CreatedAt)
+		if err != nil {
+			dstsLogger.Error("Failed to read tenant signing key from the database!",
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Error iterating over tenant signing keys!",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return keys, nil
+}
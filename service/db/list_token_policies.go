@@ -0,0 +1,84 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// ListTokenPolicies - retrieve the token policies configured for the specified
+// tenant, ordered by name. Policies are served from the cache if available,
+// since they are evaluated each time a device access token is requested.
+func ListTokenPolicies(requestID string, tenantID string) ([]TokenPolicy, error) {
+	var policies []TokenPolicy
+
+	cacheEntry, err := cache.GetTokenPolicies(requestID, tenantID)
+	if err == nil {
+		err = json.Unmarshal(cacheEntry, &policies)
+		if err == nil {
+			return policies, nil
+		}
+		dstsLogger.Error("Failed to unmarshal token policies from cache",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+		)
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbListTokenPolicies)
+
+	rows, err := gDbPool.Query(ctx, queryListTokenPolicies, tenantID)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to query token policies from the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies = []TokenPolicy{}
+	for rows.Next() {
+		var policy TokenPolicy
+		err = rows.Scan(&policy.PolicyId, &policy.TenantId, &policy.Name,
+			&policy.Description, &policy.Expression, &policy.IsEnabled,
+			&policy.GroupId, &policy.CreatedAt, &policy.UpdatedAt)
+		if err != nil {
+			dstsLogger.Error("Failed to read token policy from the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Error iterating over token policies!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Add the policies to the cache on a separate goroutine.
+	go cache.AddTokenPolicies(requestID, tenantID, policies)
+	return policies, nil
+}
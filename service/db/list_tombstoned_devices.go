@@ -0,0 +1,94 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// ListTombstonedDevices - return a page of the tombstoned devices in the
+// tenant, in order of the time they were tombstoned and their device ID.
+func ListTombstonedDevices(requestID string, tenantID string,
+	page *Paginator) (*TombstonedDevicesPage, error) {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+
+	// Start after the last device returned in the previous page. One more
+	// device than the page size is requested, to determine whether there are
+	// more results.
+	query := queryListTombstonedDevicesInTenant
+	args := []interface{}{tenantID}
+	if page.Cursor != nil {
+		args = append(args, page.Cursor.SortValue, page.Cursor.DeviceId)
+		query += " AND (tombstoned_at,device_id) > ($2,$3)"
+	}
+	limit := page.GetLimit()
+	args = append(args, limit+1)
+	query += fmt.Sprintf(" ORDER BY tombstoned_at,device_id LIMIT $%d", len(args))
+
+	response, err := gDbPool.Query(ctx, query, args...)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to get a list of tombstoned devices for the specified tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseListTombstonedDevicesFailures.Inc()
+		return nil, err
+	}
+	defer response.Close()
+
+	result := &TombstonedDevicesPage{Devices: []TombstonedDevice{}}
+	for response.Next() {
+		var foundDevice TombstonedDevice
+		err = response.Scan(&foundDevice.DeviceId, &foundDevice.TenantId,
+			&foundDevice.TombstonedAt)
+		if err != nil {
+			dstsLogger.Error("Failed to get a list of tombstoned devices from the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		result.Devices = append(result.Devices, foundDevice)
+	}
+
+	if response.Err() != nil {
+		err = mapContextTimeoutError(response.Err())
+		dstsLogger.Error("Failed to retrieve list of tombstoned devices for the specified tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseListTombstonedDevicesFailures.Inc()
+		return nil, err
+	}
+
+	if len(result.Devices) > limit {
+		result.Devices = result.Devices[:limit]
+		last := result.Devices[limit-1]
+		result.NextCursor = &DeviceCursor{
+			SortValue: last.TombstonedAt,
+			DeviceId:  last.DeviceId,
+		}
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbListTombstonedDevices)
+	dstsLogger.Info("Retrieved tombstoned devices for the specified tenant!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Int("Number of records", len(result.Devices)),
+	)
+	return result, nil
+}
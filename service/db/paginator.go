@@ -4,14 +4,48 @@
 // (C) HP Development Company, LP
 package db
 
+import "time"
+
+// Paginator - specifies the page of results requested from a list operation.
+// Results are returned in a stable order, and each page starts after the
+// cursor returned with the previous page (keyset pagination).
 type Paginator struct {
-	Limit int    // Number of results to return.
-	Page  int    // Start page
-	Sort  string // Sort order requested for results.
+	Limit   int           // Number of results to return.
+	OrderBy string        // Sort order requested for results.
+	Cursor  *DeviceCursor // Position after which results are returned.
+
+	// Specifies whether the total number of matching results is counted.
+	IncludeTotalCount bool
+}
+
+// DeviceCursor - the position of a device in the order in which devices are
+// listed, comprising the value of the field by which devices are ordered and
+// the device ID.
+type DeviceCursor struct {
+	SortValue time.Time
+	DeviceId  string
 }
 
-func (p *Paginator) GetOffset() int {
-	return (p.GetPage() - 1) * p.GetLimit()
+// DevicesPage - a page of devices returned by a list operation.
+type DevicesPage struct {
+	Devices []Device
+
+	// Cursor from which the next page of results is returned. Nil if there
+	// are no more results.
+	NextCursor *DeviceCursor
+
+	// Total number of matching devices, if requested.
+	TotalCount int64
+}
+
+// TombstonedDevicesPage - a page of tombstoned devices returned by a list
+// operation. Tombstoned devices are ordered by the time they were tombstoned.
+type TombstonedDevicesPage struct {
+	Devices []TombstonedDevice
+
+	// Cursor from which the next page of results is returned. Nil if there
+	// are no more results.
+	NextCursor *DeviceCursor
 }
 
 func (p *Paginator) GetLimit() int {
@@ -23,17 +57,3 @@ func (p *Paginator) GetLimit() int {
 	}
 	return p.Limit
 }
-
-func (p *Paginator) GetPage() int {
-	if p.Page == 0 {
-		p.Page = 1
-	}
-	return p.Page
-}
-
-func (p *Paginator) GetSort() string {
-	if p.Sort == "" {
-		p.Sort = "created_at asc"
-	}
-	return p.Sort
-}
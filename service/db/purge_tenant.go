@@ -0,0 +1,278 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+var (
+	// Tenants whose data is currently being purged, used to ensure a tenant
+	// is only purged by one job at a time.
+	tenantPurgesInProgress = map[string]bool{}
+	tenantPurgesLock       sync.Mutex
+
+	// Used to stop tenant purge jobs on shutdown.
+	tenantPurgeStop chan struct{}
+	tenantPurgeWg   sync.WaitGroup
+)
+
+// PurgeTenant - mark the specified tenant deleted and start a job to purge
+// all of its data: devices, tombstones, device groups, token policies, device
+// transfers, the enrollment token and the tenant signing key. Only suspended
+// tenants can be purged; ErrNotAllowed is returned for active tenants. Purging
+// a deleted tenant resumes the purge if it did not complete.
+func PurgeTenant(requestID string, tenantID string) (*Tenant, error) {
+	var tenant Tenant
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbPurgeTenant)
+
+	err := scanTenant(gDbPool.QueryRow(ctx, queryStartTenantPurge, tenantID),
+		&tenant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			dstsLogger.Error("Only suspended tenants can be purged!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+			)
+			return nil, ErrNotAllowed
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to mark the tenant deleted!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabasePurgeTenantFailures.Inc()
+		return nil, err
+	}
+
+	startTenantPurge(requestID, tenantID)
+	return &tenant, nil
+}
+
+// startTenantPurges - resume purging the data of deleted tenants, whose
+// purge did not complete before the service was last shut down.
+func startTenantPurges() {
+	tenantPurgeStop = make(chan struct{})
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+
+	rows, err := gDbPool.Query(ctx, queryListIncompleteTenantPurges)
+	if err != nil {
+		dstsLogger.Error("Failed to list tenants with incomplete purges!",
+			zap.Error(mapContextTimeoutError(err)),
+		)
+		return
+	}
+	defer rows.Close()
+
+	tenantIDs := []string{}
+	for rows.Next() {
+		var tenantID string
+		if err = rows.Scan(&tenantID); err != nil {
+			dstsLogger.Error("Failed to scan tenant with incomplete purge!",
+				zap.Error(err),
+			)
+			return
+		}
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+
+	for _, tenantID := range tenantIDs {
+		startTenantPurge("tenant-purge", tenantID)
+	}
+}
+
+// stopTenantPurges - stop any tenant purge jobs in progress. Purges that did
+// not complete are resumed when the service is next started.
+func stopTenantPurges() {
+	tenantPurgesLock.Lock()
+	stop := tenantPurgeStop
+	tenantPurgeStop = nil
+	tenantPurgesLock.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	tenantPurgeWg.Wait()
+}
+
+// startTenantPurge - purge the data of the specified tenant on a separate
+// goroutine, unless a purge of the tenant is already in progress.
+func startTenantPurge(requestID string, tenantID string) {
+	tenantPurgesLock.Lock()
+	defer tenantPurgesLock.Unlock()
+	if tenantPurgesInProgress[tenantID] {
+		dstsLogger.Info("A purge of the tenant is already in progress.",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+		)
+		return
+	}
+	stop := tenantPurgeStop
+	if stop == nil {
+		// The service is shutting down. The purge is resumed when the
+		// service is next started.
+		return
+	}
+	tenantPurgesInProgress[tenantID] = true
+
+	tenantPurgeWg.Add(1)
+	go func() {
+		defer tenantPurgeWg.Done()
+		defer func() {
+			tenantPurgesLock.Lock()
+			delete(tenantPurgesInProgress, tenantID)
+			tenantPurgesLock.Unlock()
+		}()
+
+		if err := purgeTenantData(requestID, tenantID, stop); err != nil {
+			dstsLogger.Error("Failed to purge the data of the tenant!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			metrics.MetricDatabasePurgeTenantFailures.Inc()
+		}
+	}()
+}
+
+// purgeTenantData - delete all data of the specified deleted tenant and mark
+// its purge complete. Devices are deleted in batches, so that purging large
+// tenants does not hold long running transactions.
+func purgeTenantData(requestID string, tenantID string,
+	stop chan struct{}) error {
+	var purged int64
+	start := time.Now()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	go func() {
+		select {
+		case <-stop:
+			cancelFunc()
+		case <-ctx.Done():
+		}
+	}()
+
+	// Delete token policies before the device groups to which they may be
+	// assigned.
+	err := execTenantPurgeQuery(ctx, queryPurgeTenantTokenPolicies, tenantID)
+	if err != nil {
+		return err
+	}
+	cache.RemoveTokenPolicies(requestID, tenantID)
+
+	err = execTenantPurgeQuery(ctx, queryPurgeTenantDeviceGroups, tenantID)
+	if err != nil {
+		return err
+	}
+
+	for {
+		deviceIDs, err := purgeTenantDeviceBatch(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		if len(deviceIDs) == 0 {
+			break
+		}
+		cache.RemoveDevices(requestID, deviceIDs)
+		purged += int64(len(deviceIDs))
+	}
+
+	// Deleting devices tombstones them, so tombstones are deleted after all
+	// devices in the tenant have been deleted.
+	err = execTenantPurgeQuery(ctx, queryPurgeTenantTombstones, tenantID)
+	if err != nil {
+		return err
+	}
+
+	err = execTenantPurgeQuery(ctx, queryDeleteEnrollmentToken, tenantID)
+	if err != nil {
+		return err
+	}
+	cache.RemoveEnrollmentToken(requestID, tenantID)
+
+	err = execTenantPurgeQuery(ctx, queryPurgeTenantSigningKey, tenantID)
+	if err != nil {
+		return err
+	}
+
+	// Transfers of devices into or out of the tenant are deleted as well.
+	err = execTenantPurgeQuery(ctx, queryPurgeTenantDeviceTransfers, tenantID)
+	if err != nil {
+		return err
+	}
+
+	err = execTenantPurgeQuery(ctx, queryCompleteTenantPurge, tenantID)
+	if err != nil {
+		return err
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbPurgeTenant)
+	metrics.MetricDatabaseTenantsPurged.Inc()
+	dstsLogger.Info("Purged the data of the deleted tenant.",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Int64("Number of devices purged", purged),
+	)
+	return nil
+}
+
+// Delete a batch of devices in the tenant and return their device IDs.
+func purgeTenantDeviceBatch(ctx context.Context, tenantID string) ([]string,
+	error) {
+	batchCtx, cancelFunc := context.WithTimeout(ctx, dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	rows, err := gDbPool.Query(batchCtx, queryPurgeTenantDevices, tenantID,
+		tenantPurgeBatchSize)
+	if err != nil {
+		return nil, mapContextTimeoutError(err)
+	}
+	defer rows.Close()
+
+	deviceIDs := []string{}
+	for rows.Next() {
+		var deviceID string
+		if err = rows.Scan(&deviceID); err != nil {
+			return nil, err
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, mapContextTimeoutError(err)
+	}
+	return deviceIDs, nil
+}
+
+// Execute a query deleting data of the tenant being purged.
+func execTenantPurgeQuery(ctx context.Context, query string,
+	tenantID string) error {
+	queryCtx, cancelFunc := context.WithTimeout(ctx, dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	_, err := gDbPool.Exec(queryCtx, query, tenantID)
+	if err != nil {
+		return mapContextTimeoutError(err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// Default interval at which tombstones are purged.
+	defaultTombstonePurgeInterval = time.Hour
+)
+
+var (
+	// Service default tombstone retention, and overrides for tenants. A zero
+	// retention indicates tombstones are retained indefinitely.
+	defaultTombstoneRetention time.Duration
+	tenantTombstoneRetention  = map[string]time.Duration{}
+
+	// Used to stop the tombstone purge job on shutdown.
+	tombstonePurgeStop chan struct{}
+	tombstonePurgeWg   sync.WaitGroup
+)
+
+// startTombstonePurge - start a job which periodically purges tombstones of
+// deleted devices that are older than the retention configured for the
+// service or for the tenant. Purged devices can no longer be restored.
+func startTombstonePurge(cfgMgr *config.ConfigMgr) {
+	dbConfig := cfgMgr.GetDatabaseConfig()
+	defaultTombstoneRetention = dbConfig.TombstoneRetention
+	for _, tenant := range cfgMgr.GetTenants() {
+		if tenant.TombstoneRetention > 0 {
+			tenantTombstoneRetention[tenant.Id] = tenant.TombstoneRetention
+		}
+	}
+	if (defaultTombstoneRetention <= 0) && (len(tenantTombstoneRetention) == 0) {
+		dstsLogger.Info("Tombstone retention is not configured. Tombstones will not be purged.")
+		return
+	}
+
+	interval := dbConfig.TombstonePurgeInterval
+	if interval <= 0 {
+		interval = defaultTombstonePurgeInterval
+	}
+
+	tombstonePurgeStop = make(chan struct{})
+	tombstonePurgeWg.Add(1)
+	go func() {
+		defer tombstonePurgeWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_, _ = PurgeTombstonedDevices("tombstone-purge")
+			case <-tombstonePurgeStop:
+				return
+			}
+		}
+	}()
+}
+
+// stopTombstonePurge - stop the tombstone purge job, if it was started.
+func stopTombstonePurge() {
+	if tombstonePurgeStop == nil {
+		return
+	}
+	close(tombstonePurgeStop)
+	tombstonePurgeWg.Wait()
+	tombstonePurgeStop = nil
+}
+
+// PurgeTombstonedDevices - purge the tombstones of deleted devices that are
+// older than the retention configured for their tenant, or the service
+// default retention. Returns the number of tombstones purged.
+func PurgeTombstonedDevices(requestID string) (int64, error) {
+	var purged int64
+	start := time.Now()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		dbBatchOperationTimeout)
+	defer cancelFunc()
+
+	// Purge tombstones for tenants that override the service retention.
+	tenantIDs := []string{}
+	for tenantID, retention := range tenantTombstoneRetention {
+		tenantIDs = append(tenantIDs, tenantID)
+		ct, err := gDbPool.Exec(ctx, queryPurgeTenantTombstonedDevices,
+			tenantID, int64(retention.Seconds()))
+		if err != nil {
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to purge tombstoned devices for the tenant!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+				zap.Error(err),
+			)
+			metrics.MetricDatabasePurgeTombstonesFailures.Inc()
+			return purged, err
+		}
+		purged += ct.RowsAffected()
+	}
+
+	// Purge tombstones for all other tenants, using the service retention.
+	if defaultTombstoneRetention > 0 {
+		ct, err := gDbPool.Exec(ctx, queryPurgeTombstonedDevices,
+			int64(defaultTombstoneRetention.Seconds()), tenantIDs)
+		if err != nil {
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to purge tombstoned devices!",
+				zap.String("Request ID", requestID),
+				zap.Error(err),
+			)
+			metrics.MetricDatabasePurgeTombstonesFailures.Inc()
+			return purged, err
+		}
+		purged += ct.RowsAffected()
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbPurgeTombstones)
+	metrics.MetricDatabaseTombstonesPurged.Add(float64(purged))
+	dstsLogger.Info("Purged tombstoned devices older than their retention.",
+		zap.String("Request ID", requestID),
+		zap.Int64("Number of tombstones purged", purged),
+	)
+	return purged, nil
+}
@@ -9,25 +9,60 @@ const (
 	// Device lifecycle management queries
 	queryInsertNewDevice = `INSERT INTO devices(device_id,tenant_id,is_enabled,is_lost,
 		certificate_thumbprint,certificate_issued_at,certificate_expires_at,
-		created_at,updated_at,service_id,hardware_hash) 
-		VALUES($1,$2,$3,$4,$5,$6,$7,now(),now(),$8,$9)
+		created_at,updated_at,service_id,hardware_hash,labels,metadata) 
+		VALUES($1,$2,$3,$4,$5,$6,$7,now(),now(),$8,$9,COALESCE($10,'{}'::jsonb),
+		COALESCE($11,'{}'::jsonb))
+		RETURNING created_at,updated_at,service_id`
+	queryInsertNewDeviceIfNotExists = `INSERT INTO devices(device_id,tenant_id,is_enabled,
+		is_lost,certificate_thumbprint,certificate_issued_at,certificate_expires_at,
+		created_at,updated_at,service_id,hardware_hash,labels,metadata) 
+		VALUES($1,$2,$3,$4,$5,$6,$7,now(),now(),$8,$9,COALESCE($10,'{}'::jsonb),
+		COALESCE($11,'{}'::jsonb))
+		ON CONFLICT (device_id,tenant_id) DO NOTHING
 		RETURNING created_at,updated_at,service_id`
 
 	queryDeviceByID = `SELECT device_id,tenant_id,is_enabled,is_lost,certificate_thumbprint,
 		certificate_issued_at,certificate_expires_at,
 		COALESCE(previous_certificate_thumbprint,'') AS previous_certificate_thumbprint,
-		created_at,updated_at,service_id, COALESCE(hardware_hash,'') AS hardware_hash FROM devices 
+		created_at,updated_at,service_id, COALESCE(hardware_hash,'') AS hardware_hash,
+		labels,metadata FROM devices 
 		WHERE devices.device_id=$1 and devices.tenant_id=$2`
 	queryTombstonedDeviceByID = `SELECT device_id,tenant_id,tombstoned_at FROM tombstoned_devices 
 		WHERE tombstoned_devices.device_id=$1 and tombstoned_devices.tenant_id=$2`
+	queryListTombstonedDevicesInTenant = `SELECT device_id,tenant_id,tombstoned_at 
+		FROM tombstoned_devices WHERE tombstoned_devices.tenant_id=$1`
+	queryDeleteTombstonedDevice = `DELETE FROM tombstoned_devices 
+		WHERE tombstoned_devices.device_id=$1 and tombstoned_devices.tenant_id=$2`
+	queryPurgeTenantTombstonedDevices = `DELETE FROM tombstoned_devices 
+		WHERE tombstoned_devices.tenant_id=$1 AND 
+		tombstoned_devices.tombstoned_at < now() - ($2 * interval '1 second')`
+	queryPurgeTombstonedDevices = `DELETE FROM tombstoned_devices 
+		WHERE tombstoned_devices.tombstoned_at < now() - ($1 * interval '1 second') 
+		AND NOT (tombstoned_devices.tenant_id = ANY($2))`
 	queryListAllDevicesInTenant = `SELECT device_id,tenant_id,is_enabled,is_lost,
 		certificate_thumbprint,certificate_issued_at,certificate_expires_at,created_at,
-		updated_at,service_id,COALESCE(hardware_hash,'') AS hardware_hash FROM devices 
+		updated_at,service_id,COALESCE(hardware_hash,'') AS hardware_hash,labels,
+		metadata FROM devices 
 		WHERE devices.tenant_id=$1`
-	queryListEnabledDevicesInTenant = `SELECT device_id,tenant_id,is_enabled,is_lost,
+	queryCountDevicesInTenant = `SELECT COUNT(*) FROM devices WHERE devices.tenant_id=$1`
+
+	// Find devices by certificate thumbprint or hardware hash, within a tenant
+	// or across all tenants if the tenant ID ($2) is empty. Devices sharing
+	// their hardware hash with another device ID in the same scope are flagged.
+	queryFindDevicesColumns = `SELECT device_id,tenant_id,is_enabled,is_lost,
 		certificate_thumbprint,certificate_issued_at,certificate_expires_at,created_at,
-		updated_at,service_id,COALESCE(hardware_hash,'') AS hardware_hash FROM devices 
-		WHERE devices.tenant_id=$1 and is_enabled=true`
+		updated_at,service_id,COALESCE(hardware_hash,'') AS hardware_hash,labels,metadata,
+		COALESCE(previous_certificate_thumbprint,'') AS previous_certificate_thumbprint,
+		EXISTS(SELECT 1 FROM devices AS other WHERE other.hardware_hash=devices.hardware_hash
+		AND other.device_id<>devices.device_id AND ($2='' OR other.tenant_id=$2))
+		AS duplicate_hardware_hash FROM devices `
+	queryFindDevicesByThumbprint = queryFindDevicesColumns +
+		`WHERE (devices.certificate_thumbprint=$1 OR
+		devices.previous_certificate_thumbprint=$1) AND ($2='' OR devices.tenant_id=$2)
+		ORDER BY devices.tenant_id,devices.device_id LIMIT $3`
+	queryFindDevicesByHardwareHash = queryFindDevicesColumns +
+		`WHERE devices.hardware_hash=$1 AND ($2='' OR devices.tenant_id=$2)
+		ORDER BY devices.tenant_id,devices.device_id LIMIT $3`
 
 	queryUpdateDeviceIsEnabled = `UPDATE devices SET updated_at=now(),is_enabled=$3 WHERE 
 		devices.device_id=$1 and devices.tenant_id=$2`
@@ -36,12 +71,29 @@ const (
 	queryUpdateDeviceCertificate = `UPDATE devices SET updated_at=now(),certificate_thumbprint=$3,
 		certificate_issued_at=$4,certificate_expires_at=$5 WHERE devices.device_id=$1 and 
 		devices.tenant_id=$2`
+	queryUpdateDeviceLabels = `UPDATE devices SET updated_at=now(),labels=COALESCE($3,'{}'::jsonb)
+		WHERE 
+		devices.device_id=$1 and devices.tenant_id=$2`
+	queryUpdateDeviceMetadata = `UPDATE devices SET updated_at=now(),metadata=COALESCE($3,'{}'::jsonb)
+		WHERE 
+		devices.device_id=$1 and devices.tenant_id=$2`
 	queryDeletePreviousCertThumbprint = `UPDATE devices SET updated_at=now(),previous_certificate_thumbprint='' 
 		WHERE devices.device_id=$1 and devices.tenant_id=$2`
 
 	queryDeleteDeviceByID = `DELETE FROM devices WHERE devices.device_id=$1 and 
 		devices.tenant_id=$2`
 
+	// Device transfer queries
+	queryLockDeviceForTransfer = `SELECT service_id,COALESCE(hardware_hash,''),
+		certificate_thumbprint FROM devices WHERE devices.device_id=$1 and 
+		devices.tenant_id=$2 FOR UPDATE`
+	queryInsertDeviceTransfer = `INSERT INTO device_transfers(transfer_id,device_id,
+		source_tenant_id,target_tenant_id,previous_certificate_thumbprint,
+		certificate_thumbprint,transferred_at) VALUES($1,$2,$3,$4,$5,$6,now()) 
+		RETURNING transferred_at`
+	queryIsDeviceTransferredSince = `SELECT EXISTS(SELECT 1 FROM device_transfers 
+		WHERE device_id=$1 AND source_tenant_id=$2 AND transferred_at>=$3)`
+
 	// Management service queries
 	queryGetManagementServices = `SELECT service_id,name,is_default FROM management_services`
 
@@ -56,6 +108,145 @@ const (
 
 	queryDeleteSigningKey = `DELETE FROM signing_keys WHERE signing_keys.key_id=$1`
 
+	// Device refresh token management queries
+	queryInsertNewRefreshToken = `INSERT INTO device_refresh_tokens(token_hash,
+		family_id,device_id,tenant_id,certificate_thumbprint,dpop_jkt,created_at,
+		expires_at) VALUES($1,$2,$3,$4,$5,$6,now(),$7) RETURNING created_at`
+	queryDeleteExpiredRefreshTokens = `DELETE FROM device_refresh_tokens 
+		WHERE device_id=$1 AND tenant_id=$2 AND expires_at < now()`
+	queryDeleteOlderRedeemedRefreshTokens = `DELETE FROM device_refresh_tokens 
+		WHERE family_id=$1 AND is_redeemed AND token_hash <> (
+		SELECT token_hash FROM device_refresh_tokens WHERE family_id=$1 
+		AND is_redeemed ORDER BY created_at DESC, token_hash LIMIT 1)`
+	queryGetRefreshTokenForUpdate = `SELECT family_id,device_id,tenant_id,
+		certificate_thumbprint,dpop_jkt,is_redeemed,created_at,expires_at 
+		FROM device_refresh_tokens WHERE token_hash=$1 FOR UPDATE`
+	queryRedeemRefreshToken = `UPDATE device_refresh_tokens SET is_redeemed=true 
+		WHERE token_hash=$1`
+	queryDeleteRefreshTokenFamily = `DELETE FROM device_refresh_tokens 
+		WHERE family_id=$1`
+
+	// Device posture queries
+	queryUpsertDevicePosture = `INSERT INTO device_posture(device_id,tenant_id,
+		os_version,patch_level,disk_encryption,agent_version,reported_at) 
+		VALUES($1,$2,$3,$4,$5,$6,now()) ON CONFLICT(device_id,tenant_id) DO UPDATE 
+		SET os_version=$3,patch_level=$4,disk_encryption=$5,agent_version=$6,
+		reported_at=now() RETURNING reported_at`
+	queryGetDevicePosture = `SELECT device_id,tenant_id,os_version,patch_level,
+		disk_encryption,agent_version,reported_at FROM device_posture 
+		WHERE device_id=$1 AND tenant_id=$2`
+
+	// Token policy management queries
+	queryInsertNewTokenPolicy = `INSERT INTO token_policies(policy_id,tenant_id,name,
+		description,expression,is_enabled,group_id,created_at,updated_at) 
+		VALUES($1,$2,$3,$4,$5,$6,NULLIF($7,''),now(),now()) RETURNING created_at,updated_at`
+	queryCountTokenPolicies = `SELECT COUNT(*) FROM token_policies WHERE tenant_id=$1`
+	queryGetTokenPolicy     = `SELECT policy_id,tenant_id,name,description,expression,
+		is_enabled,COALESCE(group_id,'') AS group_id,created_at,updated_at FROM token_policies 
+		WHERE policy_id=$1 AND tenant_id=$2`
+	queryListTokenPolicies = `SELECT policy_id,tenant_id,name,description,expression,
+		is_enabled,COALESCE(group_id,'') AS group_id,created_at,updated_at FROM token_policies 
+		WHERE tenant_id=$1 ORDER BY name`
+	queryUpdateTokenPolicy = `UPDATE token_policies SET name=$3,description=$4,
+		expression=$5,is_enabled=$6,group_id=NULLIF($7,''),updated_at=now() 
+		WHERE policy_id=$1 AND tenant_id=$2 RETURNING created_at,updated_at`
+	queryDeleteTokenPolicy = `DELETE FROM token_policies WHERE policy_id=$1 AND tenant_id=$2`
+
+	// Device group management queries
+	queryInsertNewDeviceGroup = `INSERT INTO device_groups(group_id,tenant_id,name,
+		description,created_at,updated_at) VALUES($1,$2,$3,$4,now(),now()) 
+		RETURNING created_at,updated_at`
+	queryCountDeviceGroups  = `SELECT COUNT(*) FROM device_groups WHERE tenant_id=$1`
+	queryDeviceGroupColumns = `SELECT device_groups.group_id,device_groups.tenant_id,
+		name,description,created_at,updated_at,(SELECT COUNT(*) FROM device_group_members 
+		WHERE device_group_members.group_id=device_groups.group_id AND 
+		device_group_members.tenant_id=device_groups.tenant_id) AS member_count 
+		FROM device_groups `
+	queryGetDeviceGroup = queryDeviceGroupColumns +
+		`WHERE device_groups.group_id=$1 AND device_groups.tenant_id=$2`
+	queryLockDeviceGroup = `SELECT group_id FROM device_groups 
+		WHERE group_id=$1 AND tenant_id=$2 FOR SHARE`
+	queryListDeviceGroups = queryDeviceGroupColumns +
+		`WHERE device_groups.tenant_id=$1 ORDER BY name`
+	queryDeleteDeviceGroup = `DELETE FROM device_groups WHERE group_id=$1 AND tenant_id=$2`
+
+	// Add the device to the group, if the device exists in the tenant. Adding
+	// an existing member has no effect. Returns the device, if it was found.
+	queryAddDeviceGroupMember = `WITH device AS (SELECT device_id FROM devices 
+		WHERE device_id=$3 AND tenant_id=$2), added AS (INSERT INTO 
+		device_group_members(group_id,tenant_id,device_id,added_at) 
+		SELECT $1,$2,device_id,now() FROM device ON CONFLICT DO NOTHING) 
+		SELECT device_id FROM device`
+	queryRemoveDeviceGroupMember = `DELETE FROM device_group_members 
+		WHERE group_id=$1 AND tenant_id=$2 AND device_id=$3`
+	queryListDeviceGroupsForDevice = `SELECT group_id FROM device_group_members 
+		WHERE tenant_id=$1 AND device_id=$2`
+
+	// Group-wide device updates. The devices updated are returned so their
+	// cache entries can be removed.
+	queryUpdateDeviceGroupMembers = `UPDATE devices SET updated_at=now(),%s=$3 
+		WHERE devices.tenant_id=$2 AND devices.device_id IN (SELECT device_id 
+		FROM device_group_members WHERE group_id=$1 AND tenant_id=$2) 
+		RETURNING devices.device_id`
+
+	// Tenant lifecycle queries. Tenants that are not recorded in the tenants
+	// table are active.
+	queryTenantColumns = `SELECT tenant_id,state,created_at,updated_at,
+		purge_started_at,purged_at FROM tenants `
+	queryGetTenant = queryTenantColumns + `WHERE tenant_id=$1`
+
+	// Update the state of the tenant, unless it has been deleted.
+	queryUpdateTenantState = `INSERT INTO tenants(tenant_id,state,created_at,
+		updated_at) VALUES($1,$2,now(),now()) ON CONFLICT(tenant_id) DO UPDATE 
+		SET state=$2,updated_at=now() WHERE tenants.state<>'deleted' 
+		RETURNING tenant_id,state,created_at,updated_at,purge_started_at,purged_at`
+
+	// Mark a suspended tenant deleted and start purging its data. Purging of
+	// a deleted tenant can be restarted.
+	queryStartTenantPurge = `UPDATE tenants SET state='deleted',
+		purge_started_at=COALESCE(purge_started_at,now()),purged_at=NULL,
+		updated_at=now() WHERE tenant_id=$1 AND state IN ('suspended','deleted') 
+		RETURNING tenant_id,state,created_at,updated_at,purge_started_at,purged_at`
+	queryListIncompleteTenantPurges = `SELECT tenant_id FROM tenants 
+		WHERE state='deleted' AND purged_at IS NULL`
+	queryCompleteTenantPurge = `UPDATE tenants SET purged_at=now(),updated_at=now() 
+		WHERE tenant_id=$1 AND state='deleted'`
+
+	// Purge the data of a deleted tenant. Devices are deleted a batch at a
+	// time, and the deleted devices are returned so their cache entries can
+	// be removed. Token policies are deleted before the device groups to
+	// which they may be assigned.
+	queryPurgeTenantDevices = `DELETE FROM devices WHERE tenant_id=$1 AND 
+		device_id IN (SELECT device_id FROM devices WHERE tenant_id=$1 LIMIT $2) 
+		RETURNING device_id`
+	queryPurgeTenantTokenPolicies   = `DELETE FROM token_policies WHERE tenant_id=$1`
+	queryPurgeTenantDeviceGroups    = `DELETE FROM device_groups WHERE tenant_id=$1`
+	queryPurgeTenantTombstones      = `DELETE FROM tombstoned_devices WHERE tenant_id=$1`
+	queryPurgeTenantSigningKey      = `DELETE FROM tenant_signing_keys WHERE tenant_id=$1`
+	queryPurgeTenantDeviceTransfers = `DELETE FROM device_transfers
+		WHERE source_tenant_id=$1 OR target_tenant_id=$1`
+
+	// Device counts for the tenant, by state and certificate expiry.
+	queryGetTenantDeviceStats = `SELECT COUNT(*),
+		COUNT(*) FILTER (WHERE is_enabled),
+		COUNT(*) FILTER (WHERE is_lost),
+		COUNT(*) FILTER (WHERE certificate_expires_at < now()),
+		COUNT(*) FILTER (WHERE certificate_expires_at >= now() AND 
+			certificate_expires_at < now() + interval '30 days'),
+		COUNT(*) FILTER (WHERE certificate_expires_at >= now() + interval '30 days' AND 
+			certificate_expires_at < now() + interval '90 days'),
+		COUNT(*) FILTER (WHERE certificate_expires_at >= now() + interval '90 days') 
+		FROM devices WHERE tenant_id=$1`
+	queryGetTenantDevicesByService = `SELECT service_id,COUNT(*) FROM devices 
+		WHERE tenant_id=$1 GROUP BY service_id`
+
+	// Tenant signing key management queries
+	queryInsertNewTenantSigningKey = `INSERT INTO tenant_signing_keys(tenant_id,key_id,
+		private_key,created_at) VALUES($1,$2,$3,now()) ON CONFLICT(tenant_id) DO NOTHING
+		RETURNING created_at`
+	queryListTenantSigningKeys = `SELECT tenant_id,key_id,private_key,created_at 
+		FROM tenant_signing_keys`
+
 	// Enrollment token management queries
 	// #nosec G101
 	queryInsertNewEnrollmentToken = `INSERT INTO enrollment_tokens(tenant_id,token,
@@ -72,13 +263,19 @@ const (
 		enrollment_tokens.tenant_id=$1`
 
 	// Registered app management queries
+	// Scopes and allowed tenants that are not specified ($5 and $6 are NULL)
+	// leave those of an existing app unchanged.
 	queryInsertNewRegisteredApp = `INSERT INTO registered_apps(app_id,name,is_enabled,
-		public_key_bytes,created_at,updated_at) VALUES($1,$2,$3,$4,now(),now()) 
-		ON CONFLICT(app_id) DO UPDATE SET name=$2,is_enabled=$3,public_key_bytes=$4,
-		updated_at=now() RETURNING created_at,updated_at`
+		public_key_bytes,scopes,allowed_tenants,created_at,updated_at) 
+		VALUES($1,$2,$3,$4,COALESCE($5::TEXT[],'{}'),COALESCE($6::TEXT[],'{}'),
+		now(),now()) ON CONFLICT(app_id) DO UPDATE SET name=$2,is_enabled=$3,
+		public_key_bytes=$4,scopes=COALESCE($5::TEXT[],registered_apps.scopes),
+		allowed_tenants=COALESCE($6::TEXT[],registered_apps.allowed_tenants),
+		updated_at=now() RETURNING scopes,allowed_tenants,created_at,updated_at`
 
-	queryGetRegisteredApp = `SELECT app_id,name,is_enabled,public_key_bytes,
-		created_at,updated_at FROM registered_apps WHERE registered_apps.app_id=$1`
+	queryGetRegisteredApp = `SELECT app_id,name,is_enabled,public_key_bytes,scopes,
+		allowed_tenants,created_at,updated_at FROM registered_apps 
+		WHERE registered_apps.app_id=$1`
 
 	queryDeleteRegisteredApp = `DELETE FROM registered_apps WHERE 
 		registered_apps.app_id=$1`
@@ -0,0 +1,117 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// RedeemRefreshToken - look up the refresh token with the specified hash and
+// mark it redeemed, so it cannot be exchanged again. If the refresh token was
+// already redeemed, it has been stolen or replayed - all refresh tokens in its
+// family are revoked and ErrTokenReused is returned.
+//
+// The refresh token is locked while the specified validation function runs,
+// and is only redeemed if validation succeeds. A refresh token presented with
+// a request that fails validation (for instance, when a DPoP nonce must first
+// be obtained) can therefore be presented again.
+func RedeemRefreshToken(requestID string, tokenHash string,
+	validate func(*RefreshToken) error) (*RefreshToken, error) {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbRedeemRefreshToken)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to redeem refresh token!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	token := RefreshToken{TokenHash: tokenHash}
+	err = tx.QueryRow(ctx, queryGetRefreshTokenForUpdate, tokenHash).Scan(
+		&token.FamilyId, &token.DeviceId, &token.TenantId,
+		&token.CertificateThumbprint, &token.DpopJkt, &token.IsRedeemed,
+		&token.CreatedAt, &token.ExpiresAt)
+	if err != nil {
+		rollback(tx, ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			dstsLogger.Error("The presented refresh token was not found in the database!",
+				zap.String("Request ID", requestID),
+			)
+			return nil, ErrNotFound
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to retrieve the refresh token from the database!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// The refresh token was already redeemed. Revoke the refresh token family.
+	if token.IsRedeemed {
+		_, err = tx.Exec(ctx, queryDeleteRefreshTokenFamily, token.FamilyId)
+		if err != nil {
+			rollback(tx, ctx)
+			err = mapContextTimeoutError(err)
+			dstsLogger.Error("Failed to revoke the refresh token family!",
+				zap.String("Request ID", requestID),
+				zap.String("Family ID", token.FamilyId),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		err = commit(tx, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		dstsLogger.Warn("Redeemed refresh token was presented again - revoked refresh token family!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", token.TenantId),
+			zap.String("Device ID", token.DeviceId),
+			zap.String("Family ID", token.FamilyId),
+		)
+		return nil, ErrTokenReused
+	}
+
+	err = validate(&token)
+	if err != nil {
+		rollback(tx, ctx)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, queryRedeemRefreshToken, tokenHash)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to mark the refresh token as redeemed!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token.IsRedeemed = true
+	return &token, nil
+}
@@ -0,0 +1,49 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// RefreshToken - schema for the device_refresh_tokens table in the database.
+// The refresh token itself is never stored - only its SHA-256 hash.
+type RefreshToken struct {
+	// SHA-256 hash of the refresh token.
+	TokenHash string
+
+	// Identifies the chain of refresh tokens obtained by rotating the
+	// refresh token originally issued to the device.
+	FamilyId string
+
+	// The device to which the refresh token was issued.
+	DeviceId string
+	TenantId string
+
+	// Thumbprint of the device certificate presented by the device when the
+	// refresh token family was issued.
+	CertificateThumbprint string
+
+	// JWK thumbprint of the device key to which the refresh token is bound,
+	// if the device presented a DPoP proof when the refresh token family was
+	// issued.
+	DpopJkt string
+
+	// Whether the refresh token has already been exchanged for new tokens.
+	IsRedeemed bool
+
+	// Creation and expiry timestamps for the refresh token.
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// GetRefreshTokenHash - return the hash of the specified refresh token, as
+// stored in the database.
+func GetRefreshTokenHash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -10,12 +10,59 @@ import (
 	"encoding/pem"
 	"errors"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/HPInc/krypton-dsts/service/config"
 	"go.uber.org/zap"
 )
 
+// Scopes that can be granted to registered apps.
+const (
+	ScopeDevicesRead           = "devices:read"
+	ScopeDevicesWrite          = "devices:write"
+	ScopeDevicesReadAllTenants = "devices:read_all_tenants"
+	ScopeEnrollmentTokensRead  = "enrollment_tokens:read"
+	ScopeEnrollmentTokensWrite = "enrollment_tokens:write"
+	ScopeSigningKeysRead       = "signing_keys:read"
+	ScopeTokenPoliciesRead     = "token_policies:read"
+	ScopeTokenPoliciesWrite    = "token_policies:write"
+	ScopeTenantsRead           = "tenants:read"
+	ScopeTenantsWrite          = "tenants:write"
+	ScopeTokensIntrospect      = "tokens:introspect"
+)
+
+var supportedAppScopes = map[string]bool{
+	ScopeDevicesRead:           true,
+	ScopeDevicesWrite:          true,
+	ScopeDevicesReadAllTenants: true,
+	ScopeEnrollmentTokensRead:  true,
+	ScopeEnrollmentTokensWrite: true,
+	ScopeSigningKeysRead:       true,
+	ScopeTokenPoliciesRead:     true,
+	ScopeTokenPoliciesWrite:    true,
+	ScopeTenantsRead:           true,
+	ScopeTenantsWrite:          true,
+	ScopeTokensIntrospect:      true,
+}
+
+// IsSupportedAppScope - check whether the specified scope can be granted to
+// registered apps.
+func IsSupportedAppScope(scope string) bool {
+	return supportedAppScopes[scope]
+}
+
+// GetSupportedAppScopes - return the sorted list of scopes that can be granted
+// to registered apps.
+func GetSupportedAppScopes() []string {
+	scopes := make([]string, 0, len(supportedAppScopes))
+	for scope := range supportedAppScopes {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
 type RegisteredApp struct {
 	// The ID of the registered app.
 	AppId string `json:"app_id"`
@@ -31,6 +78,14 @@ type RegisteredApp struct {
 	// disabled apps.
 	IsEnabled bool `json:"enabled"`
 
+	// Scopes granted to the app. Access tokens issued to the app are
+	// restricted to these scopes.
+	Scopes []string `json:"scopes"`
+
+	// Tenants the app is allowed to act upon. An empty list indicates the app
+	// is not restricted to specific tenants.
+	AllowedTenants []string `json:"allowed_tenants"`
+
 	// Creation and modification timestamps for the device object.
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -95,7 +150,7 @@ func initRegisteredApps(appConfig *[]config.RegisteredApp) error {
 
 			// Register the application with the DSTS.
 			newApp, err := NewRegisteredApp(item.Id, item.Name, item.IsEnabled,
-				pKey.(*rsa.PublicKey))
+				pKey.(*rsa.PublicKey), item.Scopes, item.AllowedTenants)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,112 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// RestoreDevice - restore a deleted device within the specified tenant. The
+// tombstone of the device is removed and the device is re-created, using the
+// certificate information specified for the device.
+func (d *Device) RestoreDevice(requestID string) error {
+
+	// Lookup the management service specified for the restored device. If no
+	// management service was specified, the default management service
+	// configured in the database will be used.
+	svc, err := lookupManagementService(d.ServiceId)
+	if err != nil {
+		dstsLogger.Error("Device management service for the device was not provided",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", d.DeviceId),
+		)
+		return ErrInvalidRequest
+	}
+	d.ServiceId = svc.ServiceId
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbRestoreDevice)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to restore device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", d.DeviceId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Remove the tombstone of the device. Only tombstoned devices can be
+	// restored.
+	ct, err := tx.Exec(ctx, queryDeleteTombstonedDevice, d.DeviceId, d.TenantId)
+	if err != nil {
+		rollback(tx, ctx)
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to remove the tombstone of the device.",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", d.DeviceId),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseRestoreDeviceFailures.Inc()
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		rollback(tx, ctx)
+		dstsLogger.Error("Tombstoned device with the specified device ID was not found in the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", d.TenantId),
+			zap.String("Device ID", d.DeviceId),
+		)
+		metrics.MetricDatabaseTombstonedDeviceNotFoundErrors.Inc()
+		return ErrNotFound
+	}
+
+	response := tx.QueryRow(ctx, queryInsertNewDevice, d.DeviceId, d.TenantId,
+		d.IsEnabled, d.IsLost, d.CertificateThumbprint, d.CertificateIssuedAt,
+		d.CertificateExpiresAt, d.ServiceId, d.HardwareHash, d.Labels, d.Metadata)
+	err = response.Scan(&d.CreatedAt, &d.UpdatedAt, &d.ServiceId)
+	if err != nil {
+		rollback(tx, ctx)
+		metrics.MetricDatabaseRestoreDeviceFailures.Inc()
+		if isDuplicateKeyError(err) {
+			dstsLogger.Error("Failed to restore the device. Device already exists!",
+				zap.String("Request ID", requestID),
+				zap.String("Device ID", d.DeviceId),
+				zap.Error(err),
+			)
+			return ErrDuplicateEntry
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to restore the device.",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", d.DeviceId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = commit(tx, ctx)
+	if err == nil {
+		dstsLogger.Info("Restored the tombstoned device!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", d.TenantId),
+			zap.String("Device ID", d.DeviceId),
+		)
+
+		// Add the device to the cache on a separate goroutine.
+		go cache.AddDevice(requestID, d.DeviceId, d)
+	}
+	return err
+}
@@ -0,0 +1,2 @@
+-- Drop the token policies table.
+DROP TABLE IF EXISTS token_policies;
@@ -0,0 +1,17 @@
+-- Create the table for storing the token issuance policies configured for
+-- tenants. Each policy is an expression that must evaluate to true for a
+-- device access token to be issued.
+CREATE TABLE IF NOT EXISTS token_policies
+(
+  policy_id VARCHAR(36) NOT NULL,
+  tenant_id VARCHAR(36) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  description VARCHAR(500) NOT NULL DEFAULT '',
+  expression TEXT NOT NULL,
+  is_enabled BOOLEAN DEFAULT true,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(policy_id),
+  UNIQUE(tenant_id,name)
+);
+CREATE INDEX IF NOT EXISTS token_policy_tenant_idx ON token_policies(tenant_id);
@@ -0,0 +1,2 @@
+-- Drop the index used for keyset pagination of devices.
+DROP INDEX IF EXISTS tenant_id_created_at_idx;
@@ -0,0 +1,3 @@
+-- Index used to list the devices in a tenant a page at a time, ordered by
+-- their creation time and device ID (keyset pagination).
+CREATE INDEX IF NOT EXISTS tenant_id_created_at_idx ON devices(tenant_id, created_at, device_id);
@@ -0,0 +1,3 @@
+-- Drop the indexes used to find devices by certificate thumbprint.
+DROP INDEX IF EXISTS previous_certificate_thumbprint_idx;
+DROP INDEX IF EXISTS certificate_thumbprint_idx;
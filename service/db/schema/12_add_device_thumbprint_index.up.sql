@@ -0,0 +1,4 @@
+-- Indexes used to find devices by their current or previous certificate
+-- thumbprint.
+CREATE INDEX IF NOT EXISTS certificate_thumbprint_idx ON devices(certificate_thumbprint);
+CREATE INDEX IF NOT EXISTS previous_certificate_thumbprint_idx ON devices(previous_certificate_thumbprint);
@@ -0,0 +1,3 @@
+-- Drop the indexes used to list and purge tombstoned devices.
+DROP INDEX IF EXISTS tombstoned_at_idx;
+DROP INDEX IF EXISTS tombstoned_tenant_id_tombstoned_at_idx;
@@ -0,0 +1,7 @@
+-- Index used to list the tombstoned devices in a tenant a page at a time,
+-- ordered by the time they were tombstoned and their device ID.
+CREATE INDEX IF NOT EXISTS tombstoned_tenant_id_tombstoned_at_idx
+  ON tombstoned_devices(tenant_id, tombstoned_at, device_id);
+
+-- Index used to purge tombstones older than their retention.
+CREATE INDEX IF NOT EXISTS tombstoned_at_idx ON tombstoned_devices(tombstoned_at);
@@ -0,0 +1,4 @@
+-- Drop the device labels and metadata.
+DROP INDEX IF EXISTS labels_idx;
+ALTER TABLE devices DROP COLUMN IF EXISTS metadata;
+ALTER TABLE devices DROP COLUMN IF EXISTS labels;
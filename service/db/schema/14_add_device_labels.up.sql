@@ -0,0 +1,6 @@
+-- Labels and free-form metadata attached to devices.
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+-- Index used to select devices using label selectors.
+CREATE INDEX IF NOT EXISTS labels_idx ON devices USING GIN (labels);
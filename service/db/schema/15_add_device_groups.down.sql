@@ -0,0 +1,5 @@
+-- Drop the device groups and the assignment of token policies to groups.
+ALTER TABLE token_policies DROP CONSTRAINT IF EXISTS fk_token_policy_device_group;
+ALTER TABLE token_policies DROP COLUMN IF EXISTS group_id;
+DROP TABLE IF EXISTS device_group_members;
+DROP TABLE IF EXISTS device_groups;
@@ -0,0 +1,40 @@
+-- Create the table for storing groups of devices within a tenant.
+CREATE TABLE IF NOT EXISTS device_groups
+(
+  group_id VARCHAR(36) NOT NULL,
+  tenant_id VARCHAR(36) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  description VARCHAR(500) NOT NULL DEFAULT '',
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(group_id,tenant_id),
+  UNIQUE(tenant_id,name)
+);
+
+-- Create the table for storing the members of device groups. Members are
+-- removed when the device or the group is deleted.
+CREATE TABLE IF NOT EXISTS device_group_members
+(
+  group_id VARCHAR(36) NOT NULL,
+  tenant_id VARCHAR(36) NOT NULL,
+  device_id VARCHAR(36) NOT NULL,
+  added_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(group_id,tenant_id,device_id),
+  CONSTRAINT fk_device_group
+    FOREIGN KEY(group_id,tenant_id)
+      REFERENCES device_groups(group_id,tenant_id) ON DELETE CASCADE,
+  CONSTRAINT fk_device
+    FOREIGN KEY(device_id,tenant_id)
+      REFERENCES devices(device_id,tenant_id) ON DELETE CASCADE
+);
+
+-- Index used to find the groups to which a device belongs.
+CREATE INDEX IF NOT EXISTS device_group_members_device_idx
+  ON device_group_members(tenant_id,device_id);
+
+-- Token policies can be assigned to a device group, in which case they only
+-- apply to members of the group. Groups with assigned policies cannot be
+-- deleted.
+ALTER TABLE token_policies ADD COLUMN IF NOT EXISTS group_id VARCHAR(36) NULL;
+ALTER TABLE token_policies ADD CONSTRAINT fk_token_policy_device_group
+  FOREIGN KEY(group_id,tenant_id) REFERENCES device_groups(group_id,tenant_id);
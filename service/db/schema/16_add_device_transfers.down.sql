@@ -0,0 +1,3 @@
+-- Drop the device transfer history.
+DROP INDEX IF EXISTS device_transfers_device_idx;
+DROP TABLE IF EXISTS device_transfers;
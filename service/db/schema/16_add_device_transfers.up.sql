@@ -0,0 +1,16 @@
+-- Create the table recording the history of devices transferred between
+-- tenants. Transfer records are retained after the device is deleted, so
+-- they do not reference the devices table.
+CREATE TABLE IF NOT EXISTS device_transfers
+(
+  transfer_id VARCHAR(36) NOT NULL,
+  device_id VARCHAR(36) NOT NULL,
+  source_tenant_id VARCHAR(36) NOT NULL,
+  target_tenant_id VARCHAR(36) NOT NULL,
+  previous_certificate_thumbprint CHAR(64),
+  certificate_thumbprint CHAR(64) NOT NULL,
+  transferred_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(transfer_id)
+);
+CREATE INDEX IF NOT EXISTS device_transfers_device_idx
+  ON device_transfers(device_id, transferred_at);
@@ -0,0 +1,2 @@
+-- Drop the tenants table.
+DROP TABLE IF EXISTS tenants;
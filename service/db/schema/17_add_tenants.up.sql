@@ -0,0 +1,16 @@
+-- Create the table recording the lifecycle state of tenants. Tenants are
+-- identified by the tenant ID recorded on their devices and enrollment
+-- tokens; tenants without an entry in this table are active. Devices of
+-- suspended tenants cannot obtain tokens. Deleted tenants have their data
+-- purged, which is complete once purged_at is set.
+CREATE TABLE IF NOT EXISTS tenants
+(
+  tenant_id VARCHAR(36) NOT NULL,
+  state VARCHAR(16) NOT NULL DEFAULT 'active',
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  purge_started_at TIMESTAMP NULL,
+  purged_at TIMESTAMP NULL,
+  PRIMARY KEY(tenant_id),
+  CONSTRAINT tenant_state_check CHECK (state IN ('active','suspended','deleted'))
+);
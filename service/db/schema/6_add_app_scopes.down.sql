@@ -0,0 +1,4 @@
+-- Drop the scopes and allowed tenants columns from the registered apps table.
+ALTER TABLE registered_apps
+  DROP COLUMN scopes,
+  DROP COLUMN allowed_tenants;
@@ -0,0 +1,16 @@
+-- Add the scopes granted to each registered app and the list of tenants the
+-- app is allowed to act upon. An empty list of allowed tenants indicates the
+-- app is not restricted to specific tenants.
+ALTER TABLE registered_apps
+  ADD COLUMN scopes TEXT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN allowed_tenants TEXT[] NOT NULL DEFAULT '{}';
+
+-- Existing apps were previously issued unrestricted tokens. Grant them all
+-- scopes so that their access remains unchanged.
+UPDATE registered_apps SET scopes = ARRAY[
+  'devices:read',
+  'devices:write',
+  'enrollment_tokens:read',
+  'enrollment_tokens:write',
+  'signing_keys:read'
+];
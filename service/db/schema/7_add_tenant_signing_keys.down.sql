@@ -0,0 +1,2 @@
+-- Drop the tenant signing keys table.
+DROP TABLE IF EXISTS tenant_signing_keys;
@@ -0,0 +1,12 @@
+-- Create the table for storing token signing keys dedicated to tenants. Device
+-- access tokens for tenants without a dedicated signing key are signed using
+-- the primary signing key.
+CREATE TABLE IF NOT EXISTS tenant_signing_keys
+(
+  tenant_id VARCHAR(36) NOT NULL,
+  key_id VARCHAR(100) NOT NULL,
+  private_key BYTEA NOT NULL,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(tenant_id),
+  UNIQUE(key_id)
+);
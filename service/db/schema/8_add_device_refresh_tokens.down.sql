@@ -0,0 +1,4 @@
+-- Drop the device refresh tokens table and trigger.
+DROP TRIGGER IF EXISTS revoke_device_refresh_tokens_trigger ON devices;
+DROP FUNCTION IF EXISTS revoke_device_refresh_tokens;
+DROP TABLE IF EXISTS device_refresh_tokens;
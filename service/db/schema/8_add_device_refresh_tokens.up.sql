@@ -0,0 +1,42 @@
+-- Create the table for storing device refresh tokens. Only a SHA-256 hash of
+-- each refresh token is stored. Refresh tokens are rotated on use - tokens
+-- descending from the same original refresh token share a family ID, so the
+-- entire family can be revoked if a redeemed refresh token is presented again.
+CREATE TABLE IF NOT EXISTS device_refresh_tokens
+(
+  token_hash CHAR(64) NOT NULL,
+  family_id VARCHAR(36) NOT NULL,
+  device_id VARCHAR(36) NOT NULL,
+  tenant_id VARCHAR(36) NOT NULL,
+  certificate_thumbprint CHAR(64) NOT NULL,
+  dpop_jkt VARCHAR(64) NOT NULL DEFAULT '',
+  is_redeemed BOOLEAN DEFAULT false,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMP NOT NULL,
+  PRIMARY KEY(token_hash),
+  CONSTRAINT fk_refresh_token_device
+    FOREIGN KEY(device_id,tenant_id)
+      REFERENCES devices(device_id,tenant_id)
+      ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS refresh_token_family_idx ON device_refresh_tokens(family_id);
+CREATE INDEX IF NOT EXISTS refresh_token_device_idx ON device_refresh_tokens(device_id,tenant_id);
+
+-- Revoke the refresh tokens issued to a device when it is disabled, reported
+-- lost or its device certificate changes.
+CREATE OR REPLACE FUNCTION revoke_device_refresh_tokens()
+RETURNS TRIGGER LANGUAGE plpgsql AS
+$$
+BEGIN
+  IF (NOT NEW.is_enabled) OR NEW.is_lost OR
+     (NEW.certificate_thumbprint IS DISTINCT FROM OLD.certificate_thumbprint) THEN
+    DELETE FROM device_refresh_tokens
+      WHERE device_id=NEW.device_id AND tenant_id=NEW.tenant_id;
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER revoke_device_refresh_tokens_trigger
+  AFTER UPDATE on devices
+  FOR EACH ROW EXECUTE PROCEDURE revoke_device_refresh_tokens();
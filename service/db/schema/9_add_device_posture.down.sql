@@ -0,0 +1,2 @@
+-- Drop the device posture table.
+DROP TABLE IF EXISTS device_posture;
@@ -0,0 +1,17 @@
+-- Create the table for storing the posture last reported by each device in
+-- the assertions it presents to obtain device access tokens.
+CREATE TABLE IF NOT EXISTS device_posture
+(
+  device_id VARCHAR(36) NOT NULL,
+  tenant_id VARCHAR(36) NOT NULL,
+  os_version VARCHAR(64) NOT NULL DEFAULT '',
+  patch_level VARCHAR(64) NOT NULL DEFAULT '',
+  disk_encryption VARCHAR(32) NOT NULL DEFAULT '',
+  agent_version VARCHAR(64) NOT NULL DEFAULT '',
+  reported_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(device_id,tenant_id),
+  CONSTRAINT fk_device_posture_device
+    FOREIGN KEY(device_id,tenant_id)
+      REFERENCES devices(device_id,tenant_id)
+      ON DELETE CASCADE
+);
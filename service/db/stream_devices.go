@@ -0,0 +1,227 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+var (
+	// Number of device streams in progress, in total and for each tenant.
+	activeDbStreams       int
+	activeTenantDbStreams = map[string]int{}
+	dbStreamsLock         sync.Mutex
+
+	// Maximum number of device streams in progress across all tenants,
+	// based on the size of the connection pool.
+	maxDbStreams = 1
+)
+
+// DeviceStreamHandler - invoked with each chunk of devices read from a device
+// stream, along with a cursor positioned after the last device in the chunk.
+type DeviceStreamHandler func(devices []Device, cursor *DeviceCursor) error
+
+// StreamDevices - read the devices in the tenant matching the filter and label
+// selector using a database cursor, and invoke the handler with each chunk of
+// devices. The next chunk is fetched only after the handler returns, so a slow
+// consumer applies backpressure to the stream rather than buffering the
+// tenant's devices in memory. If a cursor is specified, the stream resumes
+// after that device. ErrTooManyStreams is returned if the maximum number of
+// device streams for the service or the tenant are already in progress.
+func StreamDevices(ctx context.Context, requestID string, tenantID string,
+	filter string, labelSelector string, orderBy string, cursor *DeviceCursor,
+	chunkSize int, handler DeviceStreamHandler) error {
+	if !acquireDbStream(tenantID) {
+		dstsLogger.Error("Too many device streams are in progress!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+		)
+		metrics.MetricDatabaseStreamDevicesFailures.Inc()
+		return ErrTooManyStreams
+	}
+	defer releaseDbStream(tenantID)
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(ctx, maxDbStreamDuration)
+	defer cancelFunc()
+
+	if chunkSize <= 0 {
+		chunkSize = defaultDbStreamChunkSize
+	}
+	if chunkSize > maxDbStreamChunkSize {
+		chunkSize = maxDbStreamChunkSize
+	}
+
+	// Compile the filter and the sort order specified in the request.
+	conditions, args, err := compileDeviceFilter(filter,
+		[]interface{}{tenantID})
+	if err != nil {
+		dstsLogger.Error("Invalid filter requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Filter requested: ", filter),
+			zap.Error(err),
+		)
+		return err
+	}
+	labelConditions, args, err := compileLabelSelector(labelSelector, args)
+	if err != nil {
+		dstsLogger.Error("Invalid label selector requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Label selector requested: ", labelSelector),
+			zap.Error(err),
+		)
+		return err
+	}
+	conditions += labelConditions
+	order, err := parseDeviceOrderBy(orderBy)
+	if err != nil {
+		dstsLogger.Error("Invalid sort order requested.",
+			zap.String("Request ID: ", requestID),
+			zap.String("Order requested: ", orderBy),
+			zap.Error(err),
+		)
+		return err
+	}
+	if cursor != nil {
+		args = append(args, cursor.SortValue, cursor.DeviceId)
+		conditions += order.keysetCondition(len(args)-1, len(args))
+	}
+	conditions += order.orderByClause()
+
+	// Cursors are only valid within a transaction. The transaction is read
+	// only and is always rolled back once the stream completes.
+	tx, err := gDbPool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to begin a transaction to stream devices!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseStreamDevicesFailures.Inc()
+		return err
+	}
+	defer rollback(tx, context.Background())
+
+	// The transaction is idle while the consumer processes each chunk, so
+	// extend the idle timeout configured for the connection.
+	_, err = tx.Exec(ctx, "SET LOCAL idle_in_transaction_session_timeout = "+
+		strconv.Itoa(int(dbStreamIdleTimeout.Milliseconds())))
+	if err == nil {
+		_, err = tx.Exec(ctx, "DECLARE device_stream NO SCROLL CURSOR FOR "+
+			queryListAllDevicesInTenant+conditions, args...)
+	}
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to open a cursor to stream devices!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		metrics.MetricDatabaseStreamDevicesFailures.Inc()
+		return err
+	}
+
+	fetchQuery := fmt.Sprintf("FETCH FORWARD %d FROM device_stream", chunkSize)
+	streamed := 0
+	for {
+		devices, err := fetchDeviceChunk(ctx, tx, fetchQuery)
+		if err != nil {
+			dstsLogger.Error("Failed to fetch the next chunk of devices to stream!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Tenant ID: ", tenantID),
+				zap.Int("Devices streamed: ", streamed),
+				zap.Error(err),
+			)
+			metrics.MetricDatabaseStreamDevicesFailures.Inc()
+			return err
+		}
+		if len(devices) == 0 {
+			break
+		}
+
+		last := devices[len(devices)-1]
+		err = handler(devices, &DeviceCursor{
+			SortValue: order.field.value(&last),
+			DeviceId:  last.DeviceId,
+		})
+		if err != nil {
+			return err
+		}
+		streamed += len(devices)
+		metrics.MetricDatabaseDevicesRetrieved.Add(float64(len(devices)))
+
+		if len(devices) < chunkSize {
+			break
+		}
+	}
+
+	metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbStreamDevices)
+	dstsLogger.Info("Streamed devices for the specified tenant!",
+		zap.String("Request ID: ", requestID),
+		zap.String("Tenant ID: ", tenantID),
+		zap.String("Filter: ", filter),
+		zap.Int("Number of records: ", streamed),
+	)
+	return nil
+}
+
+// fetchDeviceChunk - fetch the next chunk of devices from the device stream
+// cursor.
+func fetchDeviceChunk(ctx context.Context, tx pgx.Tx,
+	fetchQuery string) ([]Device, error) {
+	rows, err := tx.Query(ctx, fetchQuery)
+	if err != nil {
+		return nil, mapContextTimeoutError(err)
+	}
+	defer rows.Close()
+
+	devices := []Device{}
+	for rows.Next() {
+		var foundDevice Device
+		err = scanListedDevice(rows, &foundDevice)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, foundDevice)
+	}
+	if rows.Err() != nil {
+		return nil, mapContextTimeoutError(rows.Err())
+	}
+	return devices, nil
+}
+
+// Reserve a device stream for the tenant, if the maximum number of device
+// streams for the service and the tenant are not already in progress.
+func acquireDbStream(tenantID string) bool {
+	dbStreamsLock.Lock()
+	defer dbStreamsLock.Unlock()
+	if (activeDbStreams >= maxDbStreams) ||
+		(activeTenantDbStreams[tenantID] >= maxDbStreamsPerTenant) {
+		return false
+	}
+	activeDbStreams++
+	activeTenantDbStreams[tenantID]++
+	return true
+}
+
+func releaseDbStream(tenantID string) {
+	dbStreamsLock.Lock()
+	defer dbStreamsLock.Unlock()
+	activeDbStreams--
+	activeTenantDbStreams[tenantID]--
+	if activeTenantDbStreams[tenantID] == 0 {
+		delete(activeTenantDbStreams, tenantID)
+	}
+}
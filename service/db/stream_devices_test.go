@@ -0,0 +1,47 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"testing"
+)
+
+func TestAcquireDbStream(t *testing.T) {
+	savedMaxDbStreams := maxDbStreams
+	maxDbStreams = maxDbStreamsPerTenant + 1
+	defer func() { maxDbStreams = savedMaxDbStreams }()
+
+	// Each tenant can have a limited number of streams in progress.
+	for i := 0; i < maxDbStreamsPerTenant; i++ {
+		if !acquireDbStream("tenant-1") {
+			t.Errorf("TestAcquireDbStream: stream %d for tenant-1 was rejected", i)
+		}
+	}
+	if acquireDbStream("tenant-1") {
+		t.Errorf("TestAcquireDbStream: stream exceeding the tenant cap was allowed")
+	}
+
+	// Streams for other tenants are allowed, until the service cap is reached.
+	if !acquireDbStream("tenant-2") {
+		t.Errorf("TestAcquireDbStream: stream for tenant-2 was rejected")
+	}
+	if acquireDbStream("tenant-3") {
+		t.Errorf("TestAcquireDbStream: stream exceeding the service cap was allowed")
+	}
+
+	// Released streams can be reused.
+	releaseDbStream("tenant-1")
+	if !acquireDbStream("tenant-1") {
+		t.Errorf("TestAcquireDbStream: stream for tenant-1 was rejected after release")
+	}
+
+	for i := 0; i < maxDbStreamsPerTenant; i++ {
+		releaseDbStream("tenant-1")
+	}
+	releaseDbStream("tenant-2")
+	if (activeDbStreams != 0) || (len(activeTenantDbStreams) != 0) {
+		t.Errorf("TestAcquireDbStream: %d streams remain in progress", activeDbStreams)
+	}
+}
@@ -0,0 +1,68 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import "time"
+
+// Lifecycle states of a tenant.
+const (
+	// Devices in active tenants can obtain tokens. Tenants that are not
+	// recorded in the database are active.
+	TenantStateActive = "active"
+
+	// Devices in suspended tenants cannot obtain tokens, for instance after
+	// the customer churns. Suspended tenants can be re-activated or purged.
+	TenantStateSuspended = "suspended"
+
+	// Deleted tenants have their data purged and cannot be re-activated.
+	TenantStateDeleted = "deleted"
+
+	// Number of devices deleted in each transaction when purging a tenant.
+	tenantPurgeBatchSize = 1000
+)
+
+// Tenant - schema for the tenants table in the database.
+type Tenant struct {
+	// The identifier for the tenant.
+	TenantId string `json:"tenantid"`
+
+	// Lifecycle state of the tenant.
+	State string `json:"state"`
+
+	// Creation and modification timestamps for the tenant.
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Timestamps at which purging the data of a deleted tenant started and
+	// completed.
+	PurgeStartedAt *time.Time `json:"purge_started_at,omitempty"`
+	PurgedAt       *time.Time `json:"purged_at,omitempty"`
+}
+
+// TenantStats - counts of the devices in a tenant.
+type TenantStats struct {
+	// Total number of devices in the tenant.
+	TotalDevices int64
+
+	// Number of enabled devices, and devices reported lost.
+	EnabledDevices int64
+	LostDevices    int64
+
+	// Number of devices by the expiry of their device certificates: expired,
+	// expiring within 30 days, within 30 to 90 days, and after 90 days.
+	CertificatesExpired          int64
+	CertificatesExpiringIn30Days int64
+	CertificatesExpiringIn90Days int64
+	CertificatesExpiringLater    int64
+
+	// Number of devices by management service.
+	DevicesByService map[string]int64
+}
+
+// IsTokenIssuanceAllowed - check whether devices in the tenant can obtain
+// tokens.
+func (t *Tenant) IsTokenIssuanceAllowed() bool {
+	return t.State == TenantStateActive
+}
@@ -0,0 +1,31 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"crypto/rsa"
+	"time"
+)
+
+// TenantSigningKey - represents a token signing key dedicated to a tenant. It
+// is used to sign device access tokens issued to devices in the tenant.
+type TenantSigningKey struct {
+	// The ID of the tenant to which the signing key is dedicated.
+	TenantId string
+
+	// The ID of the signing key.
+	KeyId string
+
+	// The PEM encoded private key of the signing key.
+	PrivateKey []byte
+
+	// Creation timestamp for the signing key.
+	CreatedAt time.Time
+}
+
+// GetPrivateKey - PEM decode the private key of the tenant signing key.
+func (k *TenantSigningKey) GetPrivateKey() (*rsa.PrivateKey, error) {
+	return decodePrivateKey(k.PrivateKey)
+}
@@ -0,0 +1,87 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Limits on the length of token policy attributes.
+	maxTokenPolicyNameLength        = 100
+	maxTokenPolicyDescriptionLength = 500
+
+	// Maximum number of token policies that can be configured for a tenant.
+	MaxTokenPoliciesPerTenant = 50
+)
+
+// TokenPolicy - schema for the token_policies table in the database. A token
+// policy is an expression evaluated when a device access token is requested
+// by a device belonging to the tenant. The token is issued only if all enabled
+// policies for the tenant evaluate to true.
+type TokenPolicy struct {
+	// Unique identifier of the policy.
+	PolicyId string `json:"policy_id"`
+
+	// The tenant to which the policy applies.
+	TenantId string `json:"tenant_id"`
+
+	// Name of the policy, unique within the tenant. The name is reported as
+	// the denial reason when the policy blocks token issuance.
+	Name string `json:"name"`
+
+	// Description of the policy.
+	Description string `json:"description,omitempty"`
+
+	// The policy expression.
+	Expression string `json:"expression"`
+
+	// Specifies whether the policy is evaluated.
+	IsEnabled bool `json:"enabled"`
+
+	// The device group to which the policy is assigned. If specified, the
+	// policy is only evaluated for members of the group.
+	GroupId string `json:"group_id,omitempty"`
+
+	// Creation and modification timestamps for the policy.
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTokenPolicy - initialize a new token policy for the specified tenant.
+func NewTokenPolicy(tenantID string, name string, description string,
+	expression string, isEnabled bool, groupID string) (*TokenPolicy, error) {
+	policy := &TokenPolicy{
+		PolicyId:    uuid.NewString(),
+		TenantId:    tenantID,
+		Name:        name,
+		Description: description,
+		Expression:  expression,
+		IsEnabled:   isEnabled,
+		GroupId:     groupID,
+	}
+
+	err := policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Validate - check that the token policy attributes are within the limits
+// supported by the database. The policy expression is validated by the STS.
+func (p *TokenPolicy) Validate() error {
+	if (p.TenantId == "") || (p.Name == "") || (p.Expression == "") {
+		return ErrInvalidRequest
+	}
+	if (len(p.Name) > maxTokenPolicyNameLength) ||
+		(len(p.Description) > maxTokenPolicyDescriptionLength) ||
+		(len(p.GroupId) > maxDeviceGroupIdLength) {
+		return ErrInvalidRequest
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// TransferDevice - move the device from the source tenant to the tenant of the
+// device, in a single transaction. The device is re-created in the target
+// tenant using the certificate information specified for the device, and is
+// enabled with no labels or metadata. The management service and hardware
+// hash of the device are retained, unless specified.
+//
+// No tombstone is left behind in the source tenant, and any tombstone of the
+// device in the target tenant is removed. Group memberships, posture and
+// refresh tokens of the device in the source tenant are deleted along with
+// it. The transfer is recorded in the device transfer history.
+func (d *Device) TransferDevice(requestID string,
+	sourceTenantID string) (*DeviceTransfer, error) {
+	var (
+		serviceID      string
+		hardwareHash   string
+		prevThumbprint string
+	)
+
+	// Lookup the management service, if one was specified for the device in
+	// the target tenant.
+	if d.ServiceId != "" {
+		svc, err := lookupManagementService(d.ServiceId)
+		if err != nil {
+			dstsLogger.Error("Device management service for the device was not found",
+				zap.String("Request ID", requestID),
+				zap.String("Device ID", d.DeviceId),
+			)
+			return nil, ErrInvalidRequest
+		}
+		d.ServiceId = svc.ServiceId
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbTransferDevice)
+
+	tx, err := acquire(ctx)
+	if err != nil {
+		dstsLogger.Error("Failed to acquire transaction to transfer device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", d.DeviceId),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Lock the device in the source tenant until the transfer completes.
+	err = tx.QueryRow(ctx, queryLockDeviceForTransfer, d.DeviceId,
+		sourceTenantID).Scan(&serviceID, &hardwareHash, &prevThumbprint)
+	if err != nil {
+		rollback(tx, ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			dstsLogger.Error("Device to be transferred was not found in the source tenant!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", sourceTenantID),
+				zap.String("Device ID", d.DeviceId),
+			)
+			metrics.MetricDatabaseDeviceNotFoundErrors.Inc()
+			return nil, ErrNotFound
+		}
+		return nil, transferDeviceError(requestID, sourceTenantID, d, err)
+	}
+	if d.ServiceId == "" {
+		d.ServiceId = serviceID
+	}
+	if d.HardwareHash == "" {
+		d.HardwareHash = hardwareHash
+	}
+
+	// Delete the device from the source tenant, along with the tombstone
+	// added when it is deleted. Remove any tombstone of the device in the
+	// target tenant, since the device ID is in use there once again.
+	_, err = tx.Exec(ctx, queryDeleteDeviceByID, d.DeviceId, sourceTenantID)
+	if err == nil {
+		_, err = tx.Exec(ctx, queryDeleteTombstonedDevice, d.DeviceId,
+			sourceTenantID)
+	}
+	if err == nil {
+		_, err = tx.Exec(ctx, queryDeleteTombstonedDevice, d.DeviceId,
+			d.TenantId)
+	}
+	if err != nil {
+		rollback(tx, ctx)
+		return nil, transferDeviceError(requestID, sourceTenantID, d, err)
+	}
+
+	response := tx.QueryRow(ctx, queryInsertNewDevice, d.DeviceId, d.TenantId,
+		true, false, d.CertificateThumbprint, d.CertificateIssuedAt,
+		d.CertificateExpiresAt, d.ServiceId, d.HardwareHash, nil, nil)
+	err = response.Scan(&d.CreatedAt, &d.UpdatedAt, &d.ServiceId)
+	if err != nil {
+		rollback(tx, ctx)
+		if isDuplicateKeyError(err) {
+			dstsLogger.Error("Failed to transfer the device. Device already exists in the target tenant!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", d.TenantId),
+				zap.String("Device ID", d.DeviceId),
+			)
+			metrics.MetricDatabaseTransferDeviceFailures.Inc()
+			return nil, ErrDuplicateEntry
+		}
+		return nil, transferDeviceError(requestID, sourceTenantID, d, err)
+	}
+	d.IsEnabled = true
+	d.IsLost = false
+
+	transfer := &DeviceTransfer{
+		TransferId:                    uuid.NewString(),
+		DeviceId:                      d.DeviceId,
+		SourceTenantId:                sourceTenantID,
+		TargetTenantId:                d.TenantId,
+		PreviousCertificateThumbprint: prevThumbprint,
+		CertificateThumbprint:         d.CertificateThumbprint,
+	}
+	err = tx.QueryRow(ctx, queryInsertDeviceTransfer, transfer.TransferId,
+		transfer.DeviceId, transfer.SourceTenantId, transfer.TargetTenantId,
+		transfer.PreviousCertificateThumbprint,
+		transfer.CertificateThumbprint).Scan(&transfer.TransferredAt)
+	if err != nil {
+		rollback(tx, ctx)
+		return nil, transferDeviceError(requestID, sourceTenantID, d, err)
+	}
+
+	err = commit(tx, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the device from the cache, so it is no longer found in the
+	// source tenant.
+	cache.RemoveDevice(requestID, d.DeviceId)
+
+	metrics.MetricDatabaseDevicesTransferred.Inc()
+	dstsLogger.Info("Transferred the device to the target tenant!",
+		zap.String("Request ID", requestID),
+		zap.String("Device ID", d.DeviceId),
+		zap.String("Source tenant ID", sourceTenantID),
+		zap.String("Target tenant ID", d.TenantId),
+		zap.String("Transfer ID", transfer.TransferId),
+	)
+	return transfer, nil
+}
+
+// Log the failure to transfer the device and return the error to be surfaced
+// to the caller.
+func transferDeviceError(requestID string, sourceTenantID string, d *Device,
+	err error) error {
+	err = mapContextTimeoutError(err)
+	dstsLogger.Error("Failed to transfer the device!",
+		zap.String("Request ID", requestID),
+		zap.String("Device ID", d.DeviceId),
+		zap.String("Source tenant ID", sourceTenantID),
+		zap.String("Target tenant ID", d.TenantId),
+		zap.Error(err),
+	)
+	metrics.MetricDatabaseTransferDeviceFailures.Inc()
+	return err
+}
+
+// IsDeviceTransferredSince - check whether the device was transferred out of
+// the specified tenant at or after the specified time. This is used to reject
+// tokens issued to the device in the tenant before it was transferred.
+func IsDeviceTransferredSince(requestID string, deviceID string,
+	tenantID string, since time.Time) (bool, error) {
+	var transferred bool
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbGetDeviceTransfer)
+
+	err := gDbPool.QueryRow(ctx, queryIsDeviceTransferredSince, deviceID,
+		tenantID, since.UTC()).Scan(&transferred)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to check whether the device was transferred!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return false, err
+	}
+	return transferred, nil
+}
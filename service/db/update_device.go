@@ -20,6 +20,8 @@ const (
 	UpdateFieldCertificateThumbprint = "CertificateThumbprint"
 	UpdateFieldCertificateIssuedAt   = "CertificateIssuedAt"
 	UpdateFieldCertificateExpiresAt  = "CertificateExpiresAt"
+	UpdateFieldLabels                = "Labels"
+	UpdateFieldMetadata              = "Metadata"
 )
 
 // Update information about the specified device in the specified tenant.
@@ -28,72 +30,9 @@ func UpdateDevice(requestID string, deviceID string, tenantID string,
 	start := time.Now()
 	batch := &pgx.Batch{}
 
-	// Process update requests for the isEnabled field.
-	val, ok := updateMap[UpdateFieldIsEnabled]
-	if ok {
-		isEnabled, ok := val.(bool)
-		if !ok {
-			dstsLogger.Error("Invalid value specified for the IsEnabled field!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-		batch.Queue(queryUpdateDeviceIsEnabled, deviceID, tenantID, isEnabled)
-	}
-
-	// Process update requests for the isLost field.
-	val, ok = updateMap[UpdateFieldIsLost]
-	if ok {
-		isLost, ok := val.(bool)
-		if !ok {
-			dstsLogger.Error("Invalid value specified for the isLost field!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-		batch.Queue(queryUpdateDeviceIsLost, deviceID, tenantID, isLost)
-	}
-
-	// Process update requests for the certificate thumbprint and its timestamps
-	val, ok = updateMap[UpdateFieldCertificateThumbprint]
-	if ok {
-		certThumprint, ok := val.(string)
-		if !ok {
-			dstsLogger.Error("Invalid value specified for the certificate thumbprint field!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-
-		// Process the certificate issued at field. If it was not specified, reject
-		// the request.
-		val, ok = updateMap[UpdateFieldCertificateIssuedAt]
-		if !ok {
-			dstsLogger.Error("Certificate issued at field was not specified!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-		issuedAt, ok := val.(time.Time)
-		if !ok {
-			dstsLogger.Error("Invalid value specified for the certificate issued at field!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-
-		// Process the certificate expires at field. If it was not specified, reject
-		// the request.
-		val, ok = updateMap[UpdateFieldCertificateExpiresAt]
-		if !ok {
-			dstsLogger.Error("Certificate expires_at field was not specified!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-		expiresAt, ok := val.(time.Time)
-		if !ok {
-			dstsLogger.Error("Invalid value specified for the certificate expires at field!")
-			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
-			return ErrInvalidRequest
-		}
-
-		batch.Queue(queryUpdateDeviceCertificate, deviceID, tenantID, certThumprint,
-			issuedAt, expiresAt)
+	err := queueDeviceUpdates(batch, deviceID, tenantID, updateMap)
+	if err != nil {
+		return err
 	}
 
 	if batch.Len() == 0 {
@@ -174,3 +113,102 @@ func UpdateDevice(requestID string, deviceID string, tenantID string,
 	}
 	return err
 }
+
+// queueDeviceUpdates - queue the queries to apply the updates in the update
+// map to the specified device.
+func queueDeviceUpdates(batch *pgx.Batch, deviceID string, tenantID string,
+	updateMap map[string]interface{}) error {
+	// Process update requests for the isEnabled field.
+	val, ok := updateMap[UpdateFieldIsEnabled]
+	if ok {
+		isEnabled, ok := val.(bool)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the IsEnabled field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		batch.Queue(queryUpdateDeviceIsEnabled, deviceID, tenantID, isEnabled)
+	}
+
+	// Process update requests for the isLost field.
+	val, ok = updateMap[UpdateFieldIsLost]
+	if ok {
+		isLost, ok := val.(bool)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the isLost field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		batch.Queue(queryUpdateDeviceIsLost, deviceID, tenantID, isLost)
+	}
+
+	// Process update requests for the certificate thumbprint and its timestamps
+	val, ok = updateMap[UpdateFieldCertificateThumbprint]
+	if ok {
+		certThumprint, ok := val.(string)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the certificate thumbprint field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+
+		// Process the certificate issued at field. If it was not specified, reject
+		// the request.
+		val, ok = updateMap[UpdateFieldCertificateIssuedAt]
+		if !ok {
+			dstsLogger.Error("Certificate issued at field was not specified!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		issuedAt, ok := val.(time.Time)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the certificate issued at field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+
+		// Process the certificate expires at field. If it was not specified, reject
+		// the request.
+		val, ok = updateMap[UpdateFieldCertificateExpiresAt]
+		if !ok {
+			dstsLogger.Error("Certificate expires_at field was not specified!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		expiresAt, ok := val.(time.Time)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the certificate expires at field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+
+		batch.Queue(queryUpdateDeviceCertificate, deviceID, tenantID, certThumprint,
+			issuedAt, expiresAt)
+	}
+
+	// Process update requests for the labels and metadata. The specified maps
+	// replace the labels and metadata attached to the device.
+	val, ok = updateMap[UpdateFieldLabels]
+	if ok {
+		labels, ok := val.(map[string]string)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the labels field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		batch.Queue(queryUpdateDeviceLabels, deviceID, tenantID, labels)
+	}
+
+	val, ok = updateMap[UpdateFieldMetadata]
+	if ok {
+		metadata, ok := val.(map[string]string)
+		if !ok {
+			dstsLogger.Error("Invalid value specified for the metadata field!")
+			metrics.MetricDatabaseUpdateDeviceFailures.Inc()
+			return ErrInvalidRequest
+		}
+		batch.Queue(queryUpdateDeviceMetadata, deviceID, tenantID, metadata)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// UpdateDevicePosture - record the posture reported by the device in the
+// database, replacing the posture previously reported by the device.
+func (p *DevicePosture) UpdateDevicePosture(requestID string) error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbUpdateDevicePosture)
+
+	err := gDbPool.QueryRow(ctx, queryUpsertDevicePosture, p.DeviceId,
+		p.TenantId, p.OsVersion, p.PatchLevel, p.DiskEncryption,
+		p.AgentVersion).Scan(&p.ReportedAt)
+	if err != nil {
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to update the device posture in the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", p.DeviceId),
+			zap.String("Tenant ID", p.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
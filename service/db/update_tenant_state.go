@@ -0,0 +1,61 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// UpdateTenantState - activate or suspend the specified tenant. Devices in a
+// suspended tenant cannot obtain tokens until the tenant is re-activated.
+// Tenants are deleted using StartTenantPurge; ErrNotAllowed is returned if the
+// tenant has been deleted.
+func UpdateTenantState(requestID string, tenantID string,
+	state string) (*Tenant, error) {
+	var tenant Tenant
+
+	if (state != TenantStateActive) && (state != TenantStateSuspended) {
+		return nil, ErrInvalidRequest
+	}
+
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbUpdateTenantState)
+
+	err := scanTenant(gDbPool.QueryRow(ctx, queryUpdateTenantState, tenantID,
+		state), &tenant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			dstsLogger.Error("The state of a deleted tenant cannot be updated!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", tenantID),
+			)
+			return nil, ErrNotAllowed
+		}
+
+		err = mapContextTimeoutError(err)
+		dstsLogger.Error("Failed to update the state of the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	dstsLogger.Info("Updated the state of the tenant!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.String("State", tenant.State),
+	)
+	return &tenant, nil
+}
@@ -0,0 +1,51 @@
+// package github.com/HPInc/krypton-dsts/service/db
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// UpdateTokenPolicy - update the name, description, expression, enabled state
+// and device group assignment of the token policy in the database.
+func (p *TokenPolicy) UpdateTokenPolicy(requestID string) error {
+	start := time.Now()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), dbOperationTimeout)
+	defer cancelFunc()
+	defer metrics.ReportLatencyMetric(metrics.MetricDatabaseLatency, start,
+		operationDbUpdateTokenPolicy)
+
+	err := gDbPool.QueryRow(ctx, queryUpdateTokenPolicy, p.PolicyId, p.TenantId,
+		p.Name, p.Description, p.Expression, p.IsEnabled, p.GroupId).Scan(
+		&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		dstsLogger.Error("Failed to update the token policy in the database!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", p.TenantId),
+			zap.String("Policy ID", p.PolicyId),
+			zap.Error(err),
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isDuplicateKeyError(err) {
+			return ErrDuplicateEntry
+		}
+		if isForeignKeyViolationError(err) {
+			return ErrInvalidRequest
+		}
+		return mapContextTimeoutError(err)
+	}
+
+	cache.RemoveTokenPolicies(requestID, p.TenantId)
+	return nil
+}
@@ -74,7 +74,7 @@ func main() {
 	}
 
 	// Initialize the security token service.
-	err = sts.Init(dstsLogger)
+	err = sts.Init(dstsLogger, cfgMgr)
 	if err != nil {
 		dstsLogger.Error("Failed to initialize the security token service!",
 			zap.Error(err),
@@ -73,6 +73,76 @@ var (
 			Help: "Total number of failed list devices database operations",
 		})
 
+	// Total number of failed database list tombstoned devices operations.
+	MetricDatabaseListTombstonedDevicesFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_list_tombstoned_devices_failures",
+			Help: "Total number of failed list tombstoned devices database operations",
+		})
+
+	// Total number of failed database restore device operations.
+	MetricDatabaseRestoreDeviceFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_restore_device_failures",
+			Help: "Total number of failed restore device database operations",
+		})
+
+	// Total number of failed database transfer device operations.
+	MetricDatabaseTransferDeviceFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_transfer_device_failures",
+			Help: "Total number of failed transfer device database operations",
+		})
+
+	// Total number of failed database purge tombstoned devices operations.
+	MetricDatabasePurgeTombstonesFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_purge_tombstones_failures",
+			Help: "Total number of failed purge tombstoned devices database operations",
+		})
+
+	// Total number of tombstones of deleted devices purged from the database.
+	MetricDatabaseTombstonesPurged = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_tombstones_purged",
+			Help: "Total number of tombstoned devices purged from the database",
+		})
+
+	// Total number of failed database purge tenant operations.
+	MetricDatabasePurgeTenantFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_purge_tenant_failures",
+			Help: "Total number of failed purge tenant database operations",
+		})
+
+	// Total number of deleted tenants whose data was purged from the database.
+	MetricDatabaseTenantsPurged = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_tenants_purged",
+			Help: "Total number of deleted tenants purged from the database",
+		})
+
+	// Total number of failed database get tenant stats operations.
+	MetricDatabaseGetTenantStatsFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_get_tenant_stats_failures",
+			Help: "Total number of failed get tenant stats database operations",
+		})
+
+	// Total number of failed database find devices operations.
+	MetricDatabaseFindDevicesFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_find_devices_failures",
+			Help: "Total number of failed find devices database operations",
+		})
+
+	// Total number of failed database stream devices operations.
+	MetricDatabaseStreamDevicesFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_stream_devices_failures",
+			Help: "Total number of failed stream devices database operations",
+		})
+
 	// Total number of failed database update device operations.
 	MetricDatabaseUpdateDeviceFailures = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -143,6 +213,13 @@ var (
 			Help: "Total number of devices deleted from the database",
 		})
 
+	// Total number of devices transferred between tenants in the database.
+	MetricDatabaseDevicesTransferred = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_db_devices_transferred",
+			Help: "Total number of devices transferred between tenants in the database",
+		})
+
 	// Total number of enrollment tokens deleted from the database.
 	MetricDatabaseEnrollmentTokensDeleted = prometheus.NewCounter(
 		prometheus.CounterOpts{
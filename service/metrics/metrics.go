@@ -17,6 +17,9 @@ func RegisterPrometheusMetrics() {
 	prometheus.MustRegister(MetricRestLatency)
 	prometheus.MustRegister(MetricCacheLatency)
 	prometheus.MustRegister(MetricDatabaseLatency)
+	prometheus.MustRegister(MetricTokenPolicyDenials)
+	prometheus.MustRegister(MetricVerificationReportOnlyFailures)
+	prometheus.MustRegister(MetricQuarantineTokensIssued)
 }
 
 func ReportLatencyMetric(metric *prometheus.SummaryVec,
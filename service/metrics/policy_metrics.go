@@ -0,0 +1,25 @@
+// package github.com/HPInc/krypton-dsts/service/metrics
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Reasons reported for device access token requests denied by token policies.
+const (
+	TokenPolicyDenialReasonDenied          = "policy_denied"
+	TokenPolicyDenialReasonEvaluationError = "policy_evaluation_error"
+)
+
+var (
+	// Number of device access token requests denied by token policies,
+	// partitioned by the name of the denying policy and the denial reason.
+	MetricTokenPolicyDenials = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dsts_token_policy_denials",
+			Help: "Total number of device access token requests denied by token policies",
+		},
+		[]string{"policy", "reason"},
+	)
+)
@@ -0,0 +1,19 @@
+// package github.com/HPInc/krypton-dsts/service/metrics
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// Number of quarantine tokens issued to lost devices, partitioned by the
+	// management service to which the token is restricted.
+	MetricQuarantineTokensIssued = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dsts_quarantine_tokens_issued",
+			Help: "Total number of quarantine tokens issued to lost devices",
+		},
+		[]string{"management_service"},
+	)
+)
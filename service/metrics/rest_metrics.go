@@ -80,6 +80,14 @@ var (
 			Help: "Total number of device authentication requests blocked for lost/disabled devices",
 		})
 
+	// Number of redeemed device refresh tokens presented again, resulting in
+	// the revocation of the refresh token family.
+	MetricDeviceAuthRefreshTokenReuse = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rest_device_auth_refresh_token_reuse",
+			Help: "Total number of redeemed device refresh tokens presented again to the DSTS",
+		})
+
 	// Number of device authentication challenge requests resulting in internal errors.
 	MetricDeviceAuthChallengeInternalErrors = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -108,6 +116,34 @@ var (
 			Help: "Total number of internal errors processing app authentication requests to the DSTS",
 		})
 
+	// Number of discovery document requests served by the DSTS.
+	MetricDiscoveryRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rest_discovery_requests",
+			Help: "Total number of discovery document requests processed by the DSTS",
+		})
+
+	// Number of token introspection requests served by the DSTS.
+	MetricTokenIntrospectionResponses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rest_token_introspection_requests",
+			Help: "Total number of successful token introspection requests processed by the DSTS",
+		})
+
+	// Number of bad/invalid token introspection requests to the DSTS.
+	MetricTokenIntrospectionBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rest_token_introspection_bad_requests",
+			Help: "Total number of bad token introspection requests to the DSTS",
+		})
+
+	// Number of token introspection requests resulting in internal errors.
+	MetricTokenIntrospectionInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rest_token_introspection_internal_errors",
+			Help: "Total number of internal errors processing token introspection requests to the DSTS",
+		})
+
 	MetricAppAuthBlocked = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "dsts_rest_app_auth_blocked",
@@ -24,6 +24,14 @@ var (
 			Help: "Total number of failed RPC requests to the DSTS",
 		})
 
+	// Number of gRPC requests rejected because the caller was not authorized
+	// to invoke the RPC.
+	MetricRPCAuthorizationFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_authorization_failures",
+			Help: "Total number of RPC requests to the DSTS rejected due to authorization failures",
+		})
+
 	// RPC request processing latency is partitioned by the RPC method. It uses
 	// custom buckets based on the expected request duration.
 	MetricRPCLatency = prometheus.NewSummaryVec(
@@ -56,6 +64,118 @@ var (
 			Help: "Total number of device get requests processed by the DSTS",
 		})
 
+	// Number of device posture get requests served by the DSTS.
+	MetricDevicePostureGet = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_posture_get",
+			Help: "Total number of device posture get requests processed by the DSTS",
+		})
+
+	// Number of token policy objects created by the DSTS.
+	MetricTokenPolicyCreated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_token_policy_created",
+			Help: "Total number of token policies created by the DSTS",
+		})
+
+	// Number of token policy get requests served by the DSTS.
+	MetricTokenPolicyGet = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_token_policy_get",
+			Help: "Total number of token policy get requests processed by the DSTS",
+		})
+
+	// Number of list token policies requests served by the DSTS.
+	MetricTokenPoliciesListed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_token_policies_list",
+			Help: "Total number of list token policies requests processed by the DSTS",
+		})
+
+	// Number of token policy objects updated by the DSTS.
+	MetricTokenPolicyUpdated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_token_policy_updated",
+			Help: "Total number of token policies updated by the DSTS",
+		})
+
+	// Number of token policy objects deleted by the DSTS.
+	MetricTokenPolicyDeleted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_token_policy_deleted",
+			Help: "Total number of token policies deleted by the DSTS",
+		})
+
+	// Number of device group objects created by the DSTS.
+	MetricDeviceGroupCreated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_created",
+			Help: "Total number of device groups created by the DSTS",
+		})
+
+	// Number of device group get requests served by the DSTS.
+	MetricDeviceGroupGet = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_get",
+			Help: "Total number of device group get requests processed by the DSTS",
+		})
+
+	// Number of list device groups requests served by the DSTS.
+	MetricDeviceGroupsListed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_groups_list",
+			Help: "Total number of list device groups requests processed by the DSTS",
+		})
+
+	// Number of device group objects deleted by the DSTS.
+	MetricDeviceGroupDeleted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_deleted",
+			Help: "Total number of device groups deleted by the DSTS",
+		})
+
+	// Number of add device group members requests served by the DSTS.
+	MetricDeviceGroupMembersAdded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_members_added",
+			Help: "Total number of add device group members requests processed by the DSTS",
+		})
+
+	// Number of remove device group members requests served by the DSTS.
+	MetricDeviceGroupMembersRemoved = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_members_removed",
+			Help: "Total number of remove device group members requests processed by the DSTS",
+		})
+
+	// Number of device group actions applied by the DSTS.
+	MetricDeviceGroupActionApplied = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_group_action_applied",
+			Help: "Total number of device group actions applied by the DSTS",
+		})
+
+	// Number of tenant state updates processed by the DSTS.
+	MetricTenantStateUpdated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_tenant_state_updated",
+			Help: "Total number of tenant state updates processed by the DSTS",
+		})
+
+	// Number of tenant purges started by the DSTS.
+	MetricTenantPurgeStarted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_tenant_purge_started",
+			Help: "Total number of tenant purges started by the DSTS",
+		})
+
+	// Number of tenant stats requests served by the DSTS.
+	MetricTenantStatsGet = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_tenant_stats_get",
+			Help: "Total number of tenant stats requests processed by the DSTS",
+		})
+
 	// Number of enrollment token get requests served by the DSTS.
 	MetricEnrollmentTokenGet = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -70,6 +190,34 @@ var (
 			Help: "Total number of list devices requests processed by the DSTS",
 		})
 
+	// Number of batch create devices requests served by the DSTS.
+	MetricDevicesBatchCreated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_devices_batch_create",
+			Help: "Total number of batch create devices requests processed by the DSTS",
+		})
+
+	// Number of batch update devices requests served by the DSTS.
+	MetricDevicesBatchUpdated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_devices_batch_update",
+			Help: "Total number of batch update devices requests processed by the DSTS",
+		})
+
+	// Number of find devices requests served by the DSTS.
+	MetricDevicesFound = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_devices_find",
+			Help: "Total number of find devices requests processed by the DSTS",
+		})
+
+	// Number of stream devices requests served by the DSTS.
+	MetricDevicesStreamed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_devices_stream",
+			Help: "Total number of stream devices requests processed by the DSTS",
+		})
+
 	// Number of device objects deleted by the DSTS.
 	MetricDeviceDeleted = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -77,6 +225,27 @@ var (
 			Help: "Total number of devices deleted by the DSTS",
 		})
 
+	// Number of list tombstoned devices requests served by the DSTS.
+	MetricTombstonedDevicesListed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_tombstoned_devices_list",
+			Help: "Total number of list tombstoned devices requests processed by the DSTS",
+		})
+
+	// Number of deleted devices restored by the DSTS.
+	MetricDeviceRestored = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_restored",
+			Help: "Total number of deleted devices restored by the DSTS",
+		})
+
+	// Number of devices transferred between tenants by the DSTS.
+	MetricDeviceTransferred = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_device_transferred",
+			Help: "Total number of devices transferred between tenants by the DSTS",
+		})
+
 	// Number of enrollment tokens deleted by the DSTS.
 	MetricEnrollmentTokenDeleted = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -139,6 +308,34 @@ var (
 			Help: "Total number of bad list devices requests to the DSTS",
 		})
 
+	// Number of bad/invalid batch create devices requests to the DSTS.
+	MetricBatchCreateDevicesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_batch_create_devices_bad_requests",
+			Help: "Total number of bad batch create devices requests to the DSTS",
+		})
+
+	// Number of bad/invalid batch update devices requests to the DSTS.
+	MetricBatchUpdateDevicesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_batch_update_devices_bad_requests",
+			Help: "Total number of bad batch update devices requests to the DSTS",
+		})
+
+	// Number of bad/invalid find devices requests to the DSTS.
+	MetricFindDevicesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_find_devices_bad_requests",
+			Help: "Total number of bad find devices requests to the DSTS",
+		})
+
+	// Number of bad/invalid stream devices requests to the DSTS.
+	MetricStreamDevicesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_stream_devices_bad_requests",
+			Help: "Total number of bad stream devices requests to the DSTS",
+		})
+
 	// Number of bad/invalid delete device requests to the DSTS.
 	MetricDeleteDeviceBadRequests = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -146,6 +343,27 @@ var (
 			Help: "Total number of bad delete device requests to the DSTS",
 		})
 
+	// Number of bad/invalid list tombstoned devices requests to the DSTS.
+	MetricListTombstonedDevicesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_tombstoned_devices_bad_requests",
+			Help: "Total number of bad list tombstoned devices requests to the DSTS",
+		})
+
+	// Number of bad/invalid restore device requests to the DSTS.
+	MetricRestoreDeviceBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_restore_device_bad_requests",
+			Help: "Total number of bad restore device requests to the DSTS",
+		})
+
+	// Number of bad/invalid transfer device requests to the DSTS.
+	MetricTransferDeviceBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_transfer_device_bad_requests",
+			Help: "Total number of bad transfer device requests to the DSTS",
+		})
+
 	// Number of bad/invalid delete enrollment token requests to the DSTS.
 	MetricDeleteEnrollmentTokenBadRequests = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -183,6 +401,22 @@ var (
 			Help: "Total number of already exists errors processing create device requests",
 		})
 
+	// Number of restore device requests to the DSTS, resulting in already
+	// exists errors.
+	MetricRestoreDeviceAlreadyExistsErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_restore_device_already_exists_errors",
+			Help: "Total number of already exists errors processing restore device requests",
+		})
+
+	// Number of transfer device requests to the DSTS, resulting in already
+	// exists errors.
+	MetricTransferDeviceAlreadyExistsErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_transfer_device_already_exists_errors",
+			Help: "Total number of already exists errors processing transfer device requests",
+		})
+
 	// Number of create enrollment token requests to the DSTS, resulting in internal
 	// errors.
 	MetricCreateEnrollmentTokenInternalErrors = prometheus.NewCounter(
@@ -213,6 +447,27 @@ var (
 			Help: "Total number of get device requests where the device was not found",
 		})
 
+	// Number of bad/invalid get device posture requests to the DSTS.
+	MetricGetDevicePostureBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_posture_bad_requests",
+			Help: "Total number of bad get device posture requests to the DSTS",
+		})
+
+	// Number of get device posture requests to the DSTS, resulting in internal
+	// errors.
+	MetricGetDevicePostureInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_posture_internal_errors",
+			Help: "Total number of internal errors processing get device posture requests",
+		})
+
+	MetricGetDevicePostureNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_posture_not_found_errors",
+			Help: "Total number of get device posture requests where no posture was found",
+		})
+
 	// Number of get enrollment token requests to the DSTS, resulting in internal
 	// errors.
 	MetricGetEnrollmentTokenInternalErrors = prometheus.NewCounter(
@@ -237,6 +492,38 @@ var (
 			Help: "Total number of internal errors processing list device requests",
 		})
 
+	// Number of batch create devices requests to the DSTS, resulting in
+	// internal errors.
+	MetricBatchCreateDevicesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_batch_create_devices_internal_errors",
+			Help: "Total number of internal errors processing batch create devices requests",
+		})
+
+	// Number of batch update devices requests to the DSTS, resulting in
+	// internal errors.
+	MetricBatchUpdateDevicesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_batch_update_devices_internal_errors",
+			Help: "Total number of internal errors processing batch update devices requests",
+		})
+
+	// Number of find devices requests to the DSTS, resulting in internal
+	// errors.
+	MetricFindDevicesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_find_devices_internal_errors",
+			Help: "Total number of internal errors processing find devices requests",
+		})
+
+	// Number of stream devices requests to the DSTS, resulting in internal
+	// errors.
+	MetricStreamDevicesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_stream_devices_internal_errors",
+			Help: "Total number of internal errors processing stream device requests",
+		})
+
 	// Number of delete device requests to the DSTS, resulting in internal
 	// errors.
 	MetricDeleteDeviceInternalErrors = prometheus.NewCounter(
@@ -251,6 +538,42 @@ var (
 			Help: "Total number of delete device requests where the device was not found",
 		})
 
+	// Number of list tombstoned devices requests to the DSTS, resulting in
+	// internal errors.
+	MetricListTombstonedDevicesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_tombstoned_devices_internal_errors",
+			Help: "Total number of internal errors processing list tombstoned devices requests",
+		})
+
+	// Number of restore device requests to the DSTS, resulting in internal
+	// errors.
+	MetricRestoreDeviceInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_restore_device_internal_errors",
+			Help: "Total number of internal errors processing restore device requests",
+		})
+
+	MetricRestoreDeviceNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_restore_device_not_found_errors",
+			Help: "Total number of restore device requests where the tombstoned device was not found",
+		})
+
+	// Number of transfer device requests to the DSTS, resulting in internal
+	// errors.
+	MetricTransferDeviceInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_transfer_device_internal_errors",
+			Help: "Total number of internal errors processing transfer device requests",
+		})
+
+	MetricTransferDeviceNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_transfer_device_not_found_errors",
+			Help: "Total number of transfer device requests where the device was not found",
+		})
+
 	// Number of delete enrollment token requests to the DSTS, resulting in internal
 	// errors.
 	MetricDeleteEnrollmentTokenInternalErrors = prometheus.NewCounter(
@@ -348,4 +671,271 @@ var (
 			Name: "dsts_rpc_app_authn_unauthorized_errors",
 			Help: "Total number of unauthorized errors processing app authentication requests",
 		})
+
+	// Number of bad/invalid token policy requests to the DSTS.
+	MetricCreateTokenPolicyBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_token_policy_bad_requests",
+			Help: "Total number of bad create token policy requests to the DSTS",
+		})
+
+	MetricGetTokenPolicyBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_token_policy_bad_requests",
+			Help: "Total number of bad get token policy requests to the DSTS",
+		})
+
+	MetricListTokenPoliciesBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_token_policies_bad_requests",
+			Help: "Total number of bad list token policies requests to the DSTS",
+		})
+
+	MetricUpdateTokenPolicyBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_token_policy_bad_requests",
+			Help: "Total number of bad update token policy requests to the DSTS",
+		})
+
+	MetricDeleteTokenPolicyBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_token_policy_bad_requests",
+			Help: "Total number of bad delete token policy requests to the DSTS",
+		})
+
+	// Number of token policy requests to the DSTS, resulting in internal
+	// errors.
+	MetricCreateTokenPolicyInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_token_policy_internal_errors",
+			Help: "Total number of internal errors processing create token policy requests",
+		})
+
+	MetricGetTokenPolicyInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_token_policy_internal_errors",
+			Help: "Total number of internal errors processing get token policy requests",
+		})
+
+	MetricListTokenPoliciesInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_token_policies_internal_errors",
+			Help: "Total number of internal errors processing list token policies requests",
+		})
+
+	MetricUpdateTokenPolicyInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_token_policy_internal_errors",
+			Help: "Total number of internal errors processing update token policy requests",
+		})
+
+	MetricDeleteTokenPolicyInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_token_policy_internal_errors",
+			Help: "Total number of internal errors processing delete token policy requests",
+		})
+
+	// Number of token policy requests to the DSTS, where the policy was not
+	// found or already exists.
+	MetricCreateTokenPolicyAlreadyExistsErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_token_policy_already_exists_errors",
+			Help: "Total number of already exists errors processing create token policy requests",
+		})
+
+	MetricGetTokenPolicyNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_token_policy_not_found_errors",
+			Help: "Total number of get token policy requests where the policy was not found",
+		})
+
+	MetricUpdateTokenPolicyNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_token_policy_not_found_errors",
+			Help: "Total number of update token policy requests where the policy was not found",
+		})
+
+	MetricDeleteTokenPolicyNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_token_policy_not_found_errors",
+			Help: "Total number of delete token policy requests where the policy was not found",
+		})
+
+	// Number of bad/invalid device group requests to the DSTS.
+	MetricCreateDeviceGroupBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_device_group_bad_requests",
+			Help: "Total number of bad create device group requests to the DSTS",
+		})
+
+	MetricGetDeviceGroupBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_group_bad_requests",
+			Help: "Total number of bad get device group requests to the DSTS",
+		})
+
+	MetricListDeviceGroupsBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_device_groups_bad_requests",
+			Help: "Total number of bad list device groups requests to the DSTS",
+		})
+
+	MetricDeleteDeviceGroupBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_device_group_bad_requests",
+			Help: "Total number of bad delete device group requests to the DSTS",
+		})
+
+	MetricAddDeviceGroupMembersBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_add_device_group_members_bad_requests",
+			Help: "Total number of bad add device group members requests to the DSTS",
+		})
+
+	MetricRemoveDeviceGroupMembersBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_remove_device_group_members_bad_requests",
+			Help: "Total number of bad remove device group members requests to the DSTS",
+		})
+
+	MetricApplyDeviceGroupActionBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_apply_device_group_action_bad_requests",
+			Help: "Total number of bad apply device group action requests to the DSTS",
+		})
+
+	// Number of device group requests to the DSTS, resulting in internal
+	// errors.
+	MetricCreateDeviceGroupInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_device_group_internal_errors",
+			Help: "Total number of internal errors processing create device group requests",
+		})
+
+	MetricGetDeviceGroupInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_group_internal_errors",
+			Help: "Total number of internal errors processing get device group requests",
+		})
+
+	MetricListDeviceGroupsInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_list_device_groups_internal_errors",
+			Help: "Total number of internal errors processing list device groups requests",
+		})
+
+	MetricDeleteDeviceGroupInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_device_group_internal_errors",
+			Help: "Total number of internal errors processing delete device group requests",
+		})
+
+	MetricAddDeviceGroupMembersInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_add_device_group_members_internal_errors",
+			Help: "Total number of internal errors processing add device group members requests",
+		})
+
+	MetricRemoveDeviceGroupMembersInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_remove_device_group_members_internal_errors",
+			Help: "Total number of internal errors processing remove device group members requests",
+		})
+
+	MetricApplyDeviceGroupActionInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_apply_device_group_action_internal_errors",
+			Help: "Total number of internal errors processing apply device group action requests",
+		})
+
+	// Number of device group requests to the DSTS, where the group was not
+	// found or already exists.
+	MetricCreateDeviceGroupAlreadyExistsErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_create_device_group_already_exists_errors",
+			Help: "Total number of already exists errors processing create device group requests",
+		})
+
+	MetricGetDeviceGroupNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_device_group_not_found_errors",
+			Help: "Total number of get device group requests where the group was not found",
+		})
+
+	MetricDeleteDeviceGroupNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_delete_device_group_not_found_errors",
+			Help: "Total number of delete device group requests where the group was not found",
+		})
+
+	MetricAddDeviceGroupMembersNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_add_device_group_members_not_found_errors",
+			Help: "Total number of add device group members requests where the group was not found",
+		})
+
+	MetricRemoveDeviceGroupMembersNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_remove_device_group_members_not_found_errors",
+			Help: "Total number of remove device group members requests where the group was not found",
+		})
+
+	MetricApplyDeviceGroupActionNotFoundErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_apply_device_group_action_not_found_errors",
+			Help: "Total number of apply device group action requests where the group was not found",
+		})
+
+	// Number of bad/invalid tenant lifecycle requests to the DSTS.
+	MetricUpdateTenantStateBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_tenant_state_bad_requests",
+			Help: "Total number of bad update tenant state requests to the DSTS",
+		})
+
+	MetricPurgeTenantBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_purge_tenant_bad_requests",
+			Help: "Total number of bad purge tenant requests to the DSTS",
+		})
+
+	MetricGetTenantStatsBadRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_tenant_stats_bad_requests",
+			Help: "Total number of bad get tenant stats requests to the DSTS",
+		})
+
+	// Number of tenant lifecycle requests to the DSTS, resulting in internal
+	// errors.
+	MetricUpdateTenantStateInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_tenant_state_internal_errors",
+			Help: "Total number of internal errors processing update tenant state requests",
+		})
+
+	MetricPurgeTenantInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_purge_tenant_internal_errors",
+			Help: "Total number of internal errors processing purge tenant requests",
+		})
+
+	MetricGetTenantStatsInternalErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_get_tenant_stats_internal_errors",
+			Help: "Total number of internal errors processing get tenant stats requests",
+		})
+
+	// Number of tenant lifecycle requests to the DSTS, which are not allowed
+	// in the current state of the tenant.
+	MetricUpdateTenantStateNotAllowedErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_update_tenant_state_not_allowed_errors",
+			Help: "Total number of update tenant state requests for deleted tenants",
+		})
+
+	MetricPurgeTenantNotAllowedErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dsts_rpc_purge_tenant_not_allowed_errors",
+			Help: "Total number of purge tenant requests for tenants that are not suspended",
+		})
 )
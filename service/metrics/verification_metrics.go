@@ -0,0 +1,20 @@
+// package github.com/HPInc/krypton-dsts/service/metrics
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// Number of device authentication requests that failed a verification
+	// check configured in report only mode, and were allowed to proceed,
+	// partitioned by tenant and the name of the failed check.
+	MetricVerificationReportOnlyFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dsts_verification_report_only_failures",
+			Help: "Total number of device authentication requests allowed despite failing a report only verification check",
+		},
+		[]string{"tenant", "check"},
+	)
+)
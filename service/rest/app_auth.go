@@ -39,6 +39,7 @@ func AppAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 	assertionType := r.Form.Get(paramClientAssertionType)
 	assertion := r.Form.Get(paramClientAssertion)
 	appId := r.Form.Get(paramAppID)
+	scope := r.Form.Get(paramScope)
 
 	// Check if the required client_assertion_type and client_assertion request
 	// parameters were specified in the request.
@@ -74,8 +75,8 @@ func AppAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Invoke the STS to parse and validate the provided client assertion. If
 	// the assertion is valid, return an app access token.
-	accessToken, expiresAt, err := sts.GetAccessTokenFromAppAssertion(requestID,
-		appId, assertion)
+	accessToken, grantedScope, expiresAt, err := sts.GetAccessTokenFromAppAssertion(requestID,
+		appId, assertion, scope)
 	if err != nil {
 		dstsLogger.Error("Failed to generate access token from assertion!",
 			zap.String("Request ID", requestID),
@@ -94,6 +95,13 @@ func AppAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Check if none of the requested scopes were granted to the app.
+		if errors.Is(err, sts.ErrInvalidScope) {
+			sendBadRequestErrorResponse(w, requestID, reasonInvalidScope)
+			metrics.MetricAppAuthBadRequests.Inc()
+			return
+		}
+
 		// Check if app authentication was blocked (i.e. app was disabled).
 		if errors.Is(err, db.ErrAuthnBlocked) {
 			sendUnauthorizedResponse(w, requestID, reasonAuthenticationBlocked)
@@ -122,6 +130,8 @@ func AppAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 	err = sendJsonResponse(w, http.StatusOK, TokenResponse{
 		AccessToken: accessToken,
 		ExpiresAt:   expiresAt,
+		ExpiresIn:   getExpiresIn(expiresAt),
+		Scope:       grantedScope,
 	})
 	if err != nil {
 		dstsLogger.Error("Failed to encode JSON response!",
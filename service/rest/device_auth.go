@@ -7,6 +7,7 @@ package rest
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/HPInc/krypton-dsts/service/db"
 	"github.com/HPInc/krypton-dsts/service/metrics"
@@ -36,91 +37,103 @@ func DeviceAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	assertionType := r.Form.Get(paramClientAssertionType)
-	assertion := r.Form.Get(paramClientAssertion)
-
-	// Check if the required client_assertion_type and client_assertion request
-	// parameters were specified in the request.
-	if assertionType != sts.ClientAssertionType {
-		dstsLogger.Error("Invalid client assertion type specified!",
-			zap.String("Request ID", requestID),
-			zap.String("Client assertion type: ", assertionType),
-			zap.String("Client assertion: ", assertion),
-		)
-		sendBadRequestErrorResponse(w, requestID, reasonInvalidClientAssertionType)
-		metrics.MetricDeviceAuthBadRequests.Inc()
-		return
-	}
-	if assertion == "" {
-		dstsLogger.Error("Client assertion type is not specified!",
-			zap.String("Request ID", requestID),
-			zap.String("Client assertion type: ", assertionType),
-			zap.String("Client assertion: ", assertion),
-		)
-		sendBadRequestErrorResponse(w, requestID, reasonMissingClientAssertion)
-		metrics.MetricDeviceAuthBadRequests.Inc()
-		return
-	}
-
-	// Invoke the STS to parse and validate the provided client assertion. If
-	// the assertion is valid, return a device access token.
-	accessToken, expiresAt, err := sts.GetAccessTokenFromDeviceAssertion(requestID,
-		assertion)
-	if err != nil {
-		// Check if presented assertion is expired or not valid yet.
-		if errors.Is(err, sts.ErrAssertionExpired) {
-			sendUnauthorizedResponse(w, requestID, reasonAssertionExpired)
+	// If the device presented a DPoP proof, the issued access token is bound
+	// to the device key used to sign the proof (RFC 9449).
+	var dpopRequest *sts.DpopRequest
+	tokenType := sts.TokenTypeBearer
+	dpopProofs := r.Header.Values(headerDpop)
+	if len(dpopProofs) > 0 {
+		if len(dpopProofs) != 1 {
+			dstsLogger.Error("Multiple DPoP proofs were presented!",
+				zap.String("Request ID", requestID),
+			)
+			sendBadRequestErrorResponse(w, requestID, reasonInvalidDpopProof)
 			metrics.MetricDeviceAuthBadRequests.Inc()
 			return
 		}
-		if errors.Is(err, sts.ErrAssertionNotValidYet) {
-			sendUnauthorizedResponse(w, requestID, reasonAssertionNotValidYet)
+		dpopRequest = &sts.DpopRequest{
+			Proof:  dpopProofs[0],
+			Method: r.Method,
+			Url:    getRequestUrl(r),
+		}
+		tokenType = sts.TokenTypeDPoP
+	}
+
+	var (
+		accessToken  string
+		refreshToken string
+		expiresAt    time.Time
+	)
+	if r.Form.Get(paramGrantType) == grantTypeRefreshToken {
+		// The device is redeeming a refresh token previously issued to it.
+		presentedToken := r.Form.Get(paramRefreshToken)
+		if presentedToken == "" {
+			dstsLogger.Error("Refresh token is not specified!",
+				zap.String("Request ID", requestID),
+			)
+			sendBadRequestErrorResponse(w, requestID, reasonMissingRefreshToken)
 			metrics.MetricDeviceAuthBadRequests.Inc()
 			return
 		}
 
-		// Also, if the presented device certificate is not a valid
-		// certificate.
-		if errors.Is(err, sts.ErrInvalidDeviceCertificate) {
-			sendUnauthorizedResponse(w, requestID, reasonInvalidDeviceCertificate)
-			metrics.MetricDeviceAuthBadRequests.Inc()
+		accessToken, expiresAt, refreshToken, err = sts.GetAccessTokenFromRefreshToken(
+			requestID, presentedToken, dpopRequest)
+		if err != nil {
+			sendDeviceAuthenticationErrorResponse(w, requestID, err)
 			return
 		}
+	} else {
+		assertionType := r.Form.Get(paramClientAssertionType)
+		assertion := r.Form.Get(paramClientAssertion)
 
-		if errors.Is(err, db.ErrNotFound) {
-			sendNotFoundErrorResponse(w)
+		// Check if the required client_assertion_type and client_assertion request
+		// parameters were specified in the request.
+		if assertionType != sts.ClientAssertionType {
+			dstsLogger.Error("Invalid client assertion type specified!",
+				zap.String("Request ID", requestID),
+				zap.String("Client assertion type: ", assertionType),
+				zap.String("Client assertion: ", assertion),
+			)
+			sendBadRequestErrorResponse(w, requestID, reasonInvalidClientAssertionType)
 			metrics.MetricDeviceAuthBadRequests.Inc()
 			return
 		}
-		if errors.Is(err, db.ErrTombstoned) {
-			sendResourceGoneErrorResponse(w, requestID, reasonTombstonedDevice)
+		if assertion == "" {
+			dstsLogger.Error("Client assertion type is not specified!",
+				zap.String("Request ID", requestID),
+				zap.String("Client assertion type: ", assertionType),
+				zap.String("Client assertion: ", assertion),
+			)
+			sendBadRequestErrorResponse(w, requestID, reasonMissingClientAssertion)
 			metrics.MetricDeviceAuthBadRequests.Inc()
 			return
 		}
 
-		// Check if device authentication was blocked (i.e. device was either
-		// disabled or marked lost)
-		if errors.Is(err, db.ErrAuthnBlocked) {
-			sendUnauthorizedResponse(w, requestID, reasonAuthenticationBlocked)
-			metrics.MetricDeviceAuthBlocked.Inc()
+		// Invoke the STS to parse and validate the provided client assertion.
+		// If the assertion is valid, return a device access token.
+		accessToken, expiresAt, refreshToken, err = sts.GetAccessTokenFromDeviceAssertion(
+			requestID, assertion, dpopRequest)
+		if err != nil {
+			sendDeviceAuthenticationErrorResponse(w, requestID, err)
 			return
 		}
+	}
 
-		if errors.Is(err, db.ErrDatabaseBusy) {
-			sendServerBusyErrorResponse(w)
-			metrics.MetricDeviceAuthInternalErrors.Inc()
-			return
+	// Provide a fresh nonce to be used by the device in its next DPoP proof.
+	if (dpopRequest != nil) && sts.IsDpopNonceRequired() {
+		nonce, err := sts.NewDpopNonce(requestID)
+		if err == nil {
+			w.Header().Set(headerDpopNonce, nonce)
 		}
-
-		sendInternalServerErrorResponse(w)
-		metrics.MetricDeviceAuthInternalErrors.Inc()
-		return
 	}
 
 	// Return the generated access token to the caller.
 	err = sendJsonResponse(w, http.StatusOK, TokenResponse{
-		AccessToken: accessToken,
-		ExpiresAt:   expiresAt,
+		AccessToken:  accessToken,
+		ExpiresAt:    expiresAt,
+		ExpiresIn:    getExpiresIn(expiresAt),
+		TokenType:    tokenType,
+		RefreshToken: refreshToken,
 	})
 	if err != nil {
 		dstsLogger.Error("Failed to encode JSON response!",
@@ -133,3 +146,109 @@ func DeviceAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
 
 	metrics.MetricDeviceAuthResponses.Inc()
 }
+
+// Map errors encountered during device authentication to the corresponding
+// HTTP error response.
+func sendDeviceAuthenticationErrorResponse(w http.ResponseWriter,
+	requestID string, err error) {
+	// Check if presented assertion is expired or not valid yet.
+	if errors.Is(err, sts.ErrAssertionExpired) {
+		sendUnauthorizedResponse(w, requestID, reasonAssertionExpired)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+	if errors.Is(err, sts.ErrAssertionNotValidYet) {
+		sendUnauthorizedResponse(w, requestID, reasonAssertionNotValidYet)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Check if a challenge-less assertion was replayed or is not acceptable.
+	if errors.Is(err, sts.ErrAssertionReplayed) {
+		sendUnauthorizedResponse(w, requestID, reasonAssertionReplayed)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+	if errors.Is(err, sts.ErrInvalidAssertion) ||
+		errors.Is(err, sts.ErrUnsupportedSigningAlg) {
+		sendUnauthorizedResponse(w, requestID, reasonInvalidAssertion)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Check if the device posture reported in the assertion is malformed.
+	if errors.Is(err, sts.ErrInvalidDevicePosture) {
+		sendBadRequestErrorResponse(w, requestID, reasonInvalidDevicePosture)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Check if the presented DPoP proof is invalid, replayed or is missing a
+	// DSTS issued nonce.
+	if errors.Is(err, sts.ErrDpopNonceRequired) {
+		sendDpopNonceRequiredResponse(w, requestID)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+	if errors.Is(err, sts.ErrInvalidDpopProof) ||
+		errors.Is(err, sts.ErrDpopProofReplayed) {
+		sendBadRequestErrorResponse(w, requestID, reasonInvalidDpopProof)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Check if the presented refresh token is invalid or was already redeemed.
+	if errors.Is(err, sts.ErrRefreshTokenReused) {
+		sendUnauthorizedResponse(w, requestID, reasonInvalidRefreshToken)
+		metrics.MetricDeviceAuthRefreshTokenReuse.Inc()
+		return
+	}
+	if errors.Is(err, sts.ErrInvalidRefreshToken) {
+		sendUnauthorizedResponse(w, requestID, reasonInvalidRefreshToken)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Also, if the presented device certificate is not a valid
+	// certificate.
+	if errors.Is(err, sts.ErrInvalidDeviceCertificate) {
+		sendUnauthorizedResponse(w, requestID, reasonInvalidDeviceCertificate)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	if errors.Is(err, db.ErrNotFound) {
+		sendNotFoundErrorResponse(w)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+	if errors.Is(err, db.ErrTombstoned) {
+		sendResourceGoneErrorResponse(w, requestID, reasonTombstonedDevice)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Check if device authentication was blocked (i.e. device was either
+	// disabled or marked lost)
+	if errors.Is(err, db.ErrAuthnBlocked) {
+		sendUnauthorizedResponse(w, requestID, reasonAuthenticationBlocked)
+		metrics.MetricDeviceAuthBlocked.Inc()
+		return
+	}
+
+	// Check if a token policy configured for the tenant denied the request.
+	// The denying policy is logged and reported in metrics by the STS.
+	if errors.Is(err, sts.ErrTokenPolicyDenied) {
+		sendUnauthorizedResponse(w, requestID, reasonTokenPolicyDenied)
+		return
+	}
+
+	if errors.Is(err, db.ErrDatabaseBusy) {
+		sendServerBusyErrorResponse(w)
+		metrics.MetricDeviceAuthInternalErrors.Inc()
+		return
+	}
+
+	sendInternalServerErrorResponse(w)
+	metrics.MetricDeviceAuthInternalErrors.Inc()
+}
@@ -0,0 +1,64 @@
+// package github.com/HPInc/krypton-dsts/service/rest
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rest
+
+import (
+	"net/http"
+
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"go.uber.org/zap"
+)
+
+// DeviceMtlsAuthenticationHandler - issue a certificate bound device access
+// token (RFC 8705) to a device that presented its registered device certificate
+// during the TLS handshake. No client assertion is required.
+func DeviceMtlsAuthenticationHandler(w http.ResponseWriter, r *http.Request) {
+	if !mtlsTokenEndpointEnabled {
+		sendNotFoundErrorResponse(w)
+		return
+	}
+
+	// Extract the request ID if specified.
+	requestID := getRequestID(r)
+
+	// The device certificate must have been presented at the TLS layer.
+	if (r.TLS == nil) || (len(r.TLS.PeerCertificates) == 0) {
+		dstsLogger.Error("Device certificate was not presented to the mTLS token endpoint!",
+			zap.String("Request ID", requestID),
+		)
+		sendUnauthorizedResponse(w, requestID, reasonClientCertificateRequired)
+		metrics.MetricDeviceAuthBadRequests.Inc()
+		return
+	}
+
+	// Invoke the STS to validate the presented device certificate. If the
+	// certificate matches that registered for the device, return a certificate
+	// bound device access token.
+	accessToken, expiresAt, err := sts.GetAccessTokenFromDeviceCertificate(
+		requestID, r.TLS.PeerCertificates[0])
+	if err != nil {
+		sendDeviceAuthenticationErrorResponse(w, requestID, err)
+		return
+	}
+
+	// Return the generated access token to the caller.
+	err = sendJsonResponse(w, http.StatusOK, TokenResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   expiresAt,
+		ExpiresIn:   getExpiresIn(expiresAt),
+		TokenType:   sts.TokenTypeBearer,
+	})
+	if err != nil {
+		dstsLogger.Error("Failed to encode JSON response!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		metrics.MetricDeviceAuthInternalErrors.Inc()
+		return
+	}
+
+	metrics.MetricDeviceAuthResponses.Inc()
+}
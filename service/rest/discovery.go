@@ -0,0 +1,181 @@
+// package github.com/HPInc/krypton-dsts/service/rest
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	// Cache lifetimes advertised for the discovery and JWKS documents.
+	cacheControlDiscovery = "public, max-age=3600"
+	cacheControlJwks      = "public, max-age=300"
+
+	headerCacheControl = "Cache-Control"
+	headerETag         = "ETag"
+	headerIfNoneMatch  = "If-None-Match"
+
+	// Paths of endpoints advertised in the discovery document.
+	pathTenants         = "/tenants/"
+	pathJwks            = "/.well-known/jwks.json"
+	pathDeviceToken     = "/api/v1/deviceauth/token"
+	pathDeviceMtlsToken = "/api/v1/deviceauth/mtls/token"
+	pathAppToken        = "/api/v1/appauth/token"
+	pathDeviceChallenge = "/api/v1/deviceauth/challenge"
+	pathAppChallenge    = "/api/v1/appauth/challenge"
+	pathIntrospection   = "/api/v1/token/introspect"
+
+	// Client authentication methods supported at the token endpoints.
+	authMethodPrivateKey = "private_key_jwt"
+	authMethodSelfSigned = "self_signed_tls_client_auth"
+)
+
+// DiscoveryDocument - authorization server metadata (RFC 8414) published at
+// the OpenID style discovery endpoint.
+type DiscoveryDocument struct {
+	Issuer                                string            `json:"issuer"`
+	JwksUri                               string            `json:"jwks_uri"`
+	TokenEndpoint                         string            `json:"token_endpoint"`
+	AppTokenEndpoint                      string            `json:"app_token_endpoint"`
+	DeviceChallengeEndpoint               string            `json:"device_challenge_endpoint"`
+	AppChallengeEndpoint                  string            `json:"app_challenge_endpoint"`
+	IntrospectionEndpoint                 string            `json:"introspection_endpoint"`
+	GrantTypesSupported                   []string          `json:"grant_types_supported"`
+	ResponseTypesSupported                []string          `json:"response_types_supported"`
+	SubjectTypesSupported                 []string          `json:"subject_types_supported"`
+	ScopesSupported                       []string          `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported     []string          `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgsSupported []string          `json:"token_endpoint_auth_signing_alg_values_supported"`
+	DpopSigningAlgsSupported              []string          `json:"dpop_signing_alg_values_supported"`
+	TlsClientCertificateBoundAccessTokens bool              `json:"tls_client_certificate_bound_access_tokens"`
+	MtlsEndpointAliases                   map[string]string `json:"mtls_endpoint_aliases,omitempty"`
+	ClientAssertionTypesSupported         []string          `json:"client_assertion_types_supported"`
+	AccessTokenSigningAlgValuesSupported  []string          `json:"access_token_signing_alg_values_supported"`
+}
+
+// GetDiscoveryHandler - serve the discovery document describing the issuer,
+// endpoints and algorithms supported by the DSTS. If requested for a tenant,
+// the issuer and JWKS of the tenant are advertised.
+func GetDiscoveryHandler(w http.ResponseWriter, r *http.Request) {
+	baseUrl := getBaseUrl(r)
+	issuer := sts.GetTokenIssuer()
+	jwksUri := baseUrl + pathJwks
+	tenantID := mux.Vars(r)[paramTenantID]
+	if tenantID != "" {
+		issuer = sts.GetTenantTokenIssuer(tenantID)
+		jwksUri = baseUrl + pathTenants + url.PathEscape(tenantID) + pathJwks
+	}
+
+	doc := DiscoveryDocument{
+		Issuer:                                issuer,
+		JwksUri:                               jwksUri,
+		TokenEndpoint:                         baseUrl + pathDeviceToken,
+		AppTokenEndpoint:                      baseUrl + pathAppToken,
+		DeviceChallengeEndpoint:               baseUrl + pathDeviceChallenge,
+		AppChallengeEndpoint:                  baseUrl + pathAppChallenge,
+		IntrospectionEndpoint:                 baseUrl + pathIntrospection,
+		GrantTypesSupported:                   getSupportedGrantTypes(),
+		ResponseTypesSupported:                []string{"token"},
+		SubjectTypesSupported:                 []string{"public"},
+		ScopesSupported:                       db.GetSupportedAppScopes(),
+		TokenEndpointAuthMethodsSupported:     []string{authMethodPrivateKey},
+		TokenEndpointAuthSigningAlgsSupported: []string{jwt.SigningMethodRS512.Alg()},
+		AccessTokenSigningAlgValuesSupported:  []string{jwt.SigningMethodRS512.Alg()},
+		DpopSigningAlgsSupported: []string{
+			jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(),
+			jwt.SigningMethodRS512.Alg(), jwt.SigningMethodPS256.Alg(),
+			jwt.SigningMethodPS384.Alg(), jwt.SigningMethodPS512.Alg(),
+		},
+		ClientAssertionTypesSupported:         []string{sts.ClientAssertionType},
+		TlsClientCertificateBoundAccessTokens: mtlsTokenEndpointEnabled,
+	}
+	if mtlsTokenEndpointEnabled {
+		doc.TokenEndpointAuthMethodsSupported = append(
+			doc.TokenEndpointAuthMethodsSupported, authMethodSelfSigned)
+		doc.MtlsEndpointAliases = map[string]string{
+			"token_endpoint": baseUrl + pathDeviceMtlsToken,
+		}
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		dstsLogger.Error("Failed to encode the discovery document!",
+			zap.Error(err),
+		)
+		sendInternalServerErrorResponse(w)
+		return
+	}
+
+	digest := sha256.Sum256(body)
+	sendCacheableJsonResponse(w, r, body,
+		fmt.Sprintf("\"%s\"", hex.EncodeToString(digest[:16])),
+		cacheControlDiscovery)
+	metrics.MetricDiscoveryRequests.Inc()
+}
+
+// Return the grant types accepted by the token endpoints. Refresh tokens are
+// only accepted if they are issued to devices.
+func getSupportedGrantTypes() []string {
+	grantTypes := []string{grantTypeClientCredentials}
+	if sts.IsDeviceRefreshTokenEnabled() {
+		grantTypes = append(grantTypes, grantTypeRefreshToken)
+	}
+	return grantTypes
+}
+
+// GetJwksHandler - serve the standard JWKS document (RFC 7517) containing the
+// keys used to verify access tokens issued by the DSTS. If requested for a
+// tenant, the keys used to verify the tenant's device access tokens are served.
+func GetJwksHandler(w http.ResponseWriter, r *http.Request) {
+	var body []byte
+	var etag string
+
+	tenantID := mux.Vars(r)[paramTenantID]
+	if tenantID != "" {
+		body, etag = sts.GetTenantJwks(tenantID)
+	} else {
+		body, etag = sts.GetJwks()
+	}
+	sendCacheableJsonResponse(w, r, body, etag, cacheControlJwks)
+	metrics.MetricGetSigningKeyRequests.Inc()
+}
+
+// Send the specified JSON document along with caching headers. If the caller
+// already has the current version of the document, a 304 response is sent.
+func sendCacheableJsonResponse(w http.ResponseWriter, r *http.Request,
+	body []byte, etag string, cacheControl string) {
+	w.Header().Set(headerETag, etag)
+	w.Header().Set(headerCacheControl, cacheControl)
+
+	for _, tag := range strings.Split(r.Header.Get(headerIfNoneMatch), ",") {
+		tag = strings.TrimSpace(tag)
+		if (tag == etag) || (tag == "*") {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.Header().Set(headerContentType, contentTypeJson)
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(body)
+	if err != nil {
+		dstsLogger.Error("Failed to write the JSON response!",
+			zap.Error(err),
+		)
+	}
+}
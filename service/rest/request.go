@@ -4,11 +4,18 @@
 // (C) HP Development Company, LP
 package rest
 
+import (
+	"net/http"
+	"strings"
+)
+
 const (
 	// REST request headers and expected header values.
 	headerContentType   = "Content-Type"
 	headerRequestID     = "request_id"
 	headerAuthorization = "Authorization"
+	headerDpop          = "DPoP"
+	headerDpopNonce     = "DPoP-Nonce"
 
 	contentTypeFormUrlEncoded = "application/x-www-form-urlencoded"
 	contentTypeJson           = "application/json"
@@ -20,4 +27,36 @@ const (
 	paramEnrollmentToken     = "enrollment_token"
 	paramClientAssertionType = "client_assertion_type"
 	paramClientAssertion     = "client_assertion"
+	paramScope               = "scope"
+	paramGrantType           = "grant_type"
+	paramRefreshToken        = "refresh_token"
+	paramToken               = "token"
+
+	// Supported values of the grant_type parameter. Devices and apps present
+	// client assertions at the token endpoints without specifying a grant
+	// type, as in the client credentials grant.
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeRefreshToken      = "refresh_token"
 )
+
+// Return the URL of the request without query and fragment parts, as expected
+// in the 'htu' claim of DPoP proofs. The externally visible base URL of the
+// service is used if configured, since requests are typically received via a
+// load balancer.
+func getRequestUrl(r *http.Request) string {
+	return getBaseUrl(r) + r.URL.Path
+}
+
+// Return the externally visible base URL of the service. If not configured, it
+// is derived from the request.
+func getBaseUrl(r *http.Request) string {
+	if externalUrl != "" {
+		return strings.TrimSuffix(externalUrl, "/")
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/HPInc/krypton-dsts/service/sts"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -16,6 +17,12 @@ import (
 type TokenResponse struct {
 	AccessToken string    `json:"access_token"`
 	ExpiresAt   time.Time `json:"expires_at"`
+	ExpiresIn   int64     `json:"expires_in"`
+	Scope       string    `json:"scope,omitempty"`
+	TokenType   string    `json:"token_type,omitempty"`
+
+	// Refresh token issued to the device, if device refresh tokens are enabled.
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
 
 type FailedRequestError struct {
@@ -29,13 +36,57 @@ const (
 	reasonMissingClientAssertion     = "client assertion type is not specified"
 	reasonAssertionExpired           = "presented client assertion is expired"
 	reasonAssertionNotValidYet       = "presented client assertion is not yet valid"
+	reasonAssertionReplayed          = "presented client assertion has already been used"
+	reasonInvalidAssertion           = "presented client assertion is invalid"
+	reasonInvalidDevicePosture       = "device posture in client assertion is invalid"
 	reasonInvalidDeviceCertificate   = "invalid device certificate presented"
 	reasonAuthenticationBlocked      = "device authentication is blocked for this device"
+	reasonTokenPolicyDenied          = "device access token was denied by the tenant's token policy"
 	reasonAppIDNotSpecified          = "app_id parameter was not specified"
 	reasonDeviceIDNotSpecified       = "device_id parameter was not specified"
 	reasonTombstonedDevice           = "device is no longer enrolled and has been deleted"
+	reasonInvalidScope               = "requested scope is invalid or has not been granted"
+	reasonClientCertificateRequired  = "device certificate was not presented at the TLS layer"
+	reasonInvalidDpopProof           = "invalid DPoP proof presented"
+	reasonMissingRefreshToken        = "refresh_token parameter was not specified"
+	reasonInvalidRefreshToken        = "presented refresh token is invalid or has expired"
+	reasonInvalidAppAccessToken      = "app access token granting the required scope was not presented"
+	reasonMissingToken               = "token parameter was not specified"
 )
 
+// DpopErrorResponse - error response returned when a DPoP proof does not
+// include a valid nonce (RFC 9449 section 8).
+type DpopErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func sendDpopNonceRequiredResponse(w http.ResponseWriter, requestID string) {
+	nonce, err := sts.NewDpopNonce(requestID)
+	if err != nil {
+		sendInternalServerErrorResponse(w)
+		return
+	}
+
+	w.Header().Set(headerDpopNonce, nonce)
+	err = sendJsonResponse(w, http.StatusBadRequest, DpopErrorResponse{
+		Error:            "use_dpop_nonce",
+		ErrorDescription: "DPoP proof must include the nonce issued by the server",
+	})
+	if err != nil {
+		dstsLogger.Error("Failed to encode JSON response!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+	}
+}
+
+// Return the lifetime in seconds of an access token expiring at the specified
+// time, for the 'expires_in' parameter of the token response.
+func getExpiresIn(expiresAt time.Time) int64 {
+	return int64(time.Until(expiresAt).Round(time.Second) / time.Second)
+}
+
 func sendInternalServerErrorResponse(w http.ResponseWriter) {
 	http.Error(w, http.StatusText(http.StatusInternalServerError),
 		http.StatusInternalServerError)
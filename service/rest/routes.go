@@ -47,6 +47,32 @@ var registeredRoutes = routes{
 		HandlerFunc: GetSigningKeyHandler,
 	},
 
+	// Discovery document and standard JWKS methods.
+	Route{
+		Name:        "GetDiscoveryDocument",
+		Method:      http.MethodGet,
+		Path:        "/.well-known/openid-configuration",
+		HandlerFunc: GetDiscoveryHandler,
+	},
+	Route{
+		Name:        "GetJwks",
+		Method:      http.MethodGet,
+		Path:        "/.well-known/jwks.json",
+		HandlerFunc: GetJwksHandler,
+	},
+	Route{
+		Name:        "GetTenantDiscoveryDocument",
+		Method:      http.MethodGet,
+		Path:        "/tenants/{tenant_id}/.well-known/openid-configuration",
+		HandlerFunc: GetDiscoveryHandler,
+	},
+	Route{
+		Name:        "GetTenantJwks",
+		Method:      http.MethodGet,
+		Path:        "/tenants/{tenant_id}/.well-known/jwks.json",
+		HandlerFunc: GetJwksHandler,
+	},
+
 	// Device authentication methods.
 	Route{
 		Name:        "DeviceAuthChallenge",
@@ -60,6 +86,12 @@ var registeredRoutes = routes{
 		Path:        "/api/v1/deviceauth/token",
 		HandlerFunc: DeviceAuthenticationHandler,
 	},
+	Route{
+		Name:        "DeviceAuthMtlsToken",
+		Method:      http.MethodPost,
+		Path:        "/api/v1/deviceauth/mtls/token",
+		HandlerFunc: DeviceMtlsAuthenticationHandler,
+	},
 	// App authentication methods.
 	Route{
 		Name:        "AppAuthChallenge",
@@ -73,4 +105,12 @@ var registeredRoutes = routes{
 		Path:        "/api/v1/appauth/token",
 		HandlerFunc: AppAuthenticationHandler,
 	},
+
+	// Token introspection method.
+	Route{
+		Name:        "TokenIntrospection",
+		Method:      http.MethodPost,
+		Path:        "/api/v1/token/introspect",
+		HandlerFunc: TokenIntrospectionHandler,
+	},
 }
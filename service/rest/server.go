@@ -13,14 +13,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/HPInc/krypton-dsts/service/common"
 	"github.com/HPInc/krypton-dsts/service/config"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
 
 var (
-	dstsLogger           *zap.Logger
-	debugLogRestRequests bool
+	dstsLogger               *zap.Logger
+	debugLogRestRequests     bool
+	mtlsTokenEndpointEnabled bool
+	externalUrl              string
 )
 
 const (
@@ -35,8 +38,9 @@ type dstsRestService struct {
 	errChannel  chan error
 	stopChannel chan os.Signal
 
-	router *mux.Router
-	port   int
+	router       *mux.Router
+	port         int
+	serverConfig *config.ServerConfig
 }
 
 func newDstsRestService() *dstsRestService {
@@ -63,7 +67,26 @@ func (s *dstsRestService) startServing() {
 		MaxHeaderBytes: 1 << 20,
 	}
 
-	err := server.ListenAndServe()
+	var err error
+	if s.serverConfig.TlsEnabled {
+		// Serve REST requests over TLS. Renewed server certificates are
+		// reloaded from disk by the TLS configuration loader.
+		var tlsLoader *common.TlsConfigLoader
+		tlsLoader, err = common.NewTlsConfigLoader(dstsLogger,
+			s.serverConfig.TlsCertificateFile, s.serverConfig.TlsKeyFile, "")
+		if err == nil {
+			// Devices present their device certificates at the TLS layer to
+			// the mTLS token endpoint. These are verified against the device
+			// certificates registered in the database.
+			if mtlsTokenEndpointEnabled {
+				tlsLoader.RequestClientCertificates()
+			}
+			server.TLSConfig = tlsLoader.GetTlsConfig("h2", "http/1.1")
+			err = server.ListenAndServeTLS("", "")
+		}
+	} else {
+		err = server.ListenAndServe()
+	}
 	dstsLogger.Error("Received a fatal error from http.ListenAndServe",
 		zap.Error(err),
 	)
@@ -90,7 +113,13 @@ func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) {
 	debugLogRestRequests = cfgMgr.IsDebugLoggingRestRequestsEnabled()
 
 	s := newDstsRestService()
-	s.port = cfgMgr.GetServerConfig().RestPort
+	s.serverConfig = cfgMgr.GetServerConfig()
+	s.port = s.serverConfig.RestPort
+	mtlsTokenEndpointEnabled = s.serverConfig.MtlsTokenEndpointEnabled
+	externalUrl = s.serverConfig.ExternalUrl
+	if mtlsTokenEndpointEnabled && !s.serverConfig.TlsEnabled {
+		dstsLogger.Warn("The mTLS token endpoint requires TLS to be enabled!")
+	}
 
 	// Initialize the REST server and listen for REST requests on a separate
 	// goroutine. Report fatal errors via the error channel.
@@ -105,6 +134,11 @@ func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) {
 func InitTestServer(logger *zap.Logger, cfgMgr *config.ConfigMgr) {
 	dstsLogger = logger
 	debugLogRestRequests = cfgMgr.IsDebugLoggingRestRequestsEnabled()
+	externalUrl = cfgMgr.GetServerConfig().ExternalUrl
+
+	// The mTLS token endpoint is always enabled for tests, which supply the
+	// client certificate in the TLS connection state of the request.
+	mtlsTokenEndpointEnabled = true
 }
 
 func ExecuteTestRequest(r *http.Request,
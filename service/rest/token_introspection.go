@@ -0,0 +1,171 @@
+// package github.com/HPInc/krypton-dsts/service/rest
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"go.uber.org/zap"
+)
+
+const (
+	bearerTokenPrefix = "bearer "
+)
+
+// IntrospectionResponse - describes the state of a device access token
+// presented to the token introspection endpoint (RFC 7662). Only the 'active'
+// field is returned for tokens that are not active.
+type IntrospectionResponse struct {
+	Active            bool                   `json:"active"`
+	TokenType         string                 `json:"token_type,omitempty"`
+	Subject           string                 `json:"sub,omitempty"`
+	TenantID          string                 `json:"tid,omitempty"`
+	ManagementService string                 `json:"ms,omitempty"`
+	Issuer            string                 `json:"iss,omitempty"`
+	ExpiresAt         int64                  `json:"exp,omitempty"`
+	IssuedAt          int64                  `json:"iat,omitempty"`
+	Confirmation      *sts.ConfirmationClaim `json:"cnf,omitempty"`
+}
+
+// TokenIntrospectionHandler - allows resource servers to check whether a
+// device access token is still active (RFC 7662). Unlike offline verification
+// using the JWKS, tokens issued to a device before it was transferred out of
+// its tenant are reported as inactive. The caller must present an app access
+// token granting the tokens:introspect scope.
+func TokenIntrospectionHandler(w http.ResponseWriter, r *http.Request) {
+	// Check if the contents of the POST were provided using URL encoding.
+	if r.Header.Get(headerContentType) != contentTypeFormUrlEncoded {
+		sendUnsupportedMediaTypeResponse(w)
+		metrics.MetricTokenIntrospectionBadRequests.Inc()
+		return
+	}
+
+	// Extract the request ID if specified.
+	requestID := getRequestID(r)
+
+	// Authenticate the caller using the app access token presented in the
+	// authorization header.
+	appClaims, ok := authorizeTokenIntrospection(r, requestID)
+	if !ok {
+		sendUnauthorizedResponse(w, requestID, reasonInvalidAppAccessToken)
+		metrics.MetricTokenIntrospectionBadRequests.Inc()
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		dstsLogger.Error("Failed to parse the request form!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		sendBadRequestErrorResponse(w, requestID, reasonRequestParsingFailed)
+		metrics.MetricTokenIntrospectionBadRequests.Inc()
+		return
+	}
+
+	token := r.Form.Get(paramToken)
+	if token == "" {
+		dstsLogger.Error("Token parameter was not specified!",
+			zap.String("Request ID", requestID),
+		)
+		sendBadRequestErrorResponse(w, requestID, reasonMissingToken)
+		metrics.MetricTokenIntrospectionBadRequests.Inc()
+		return
+	}
+
+	// Verify the presented device access token. Tokens that are invalid,
+	// expired or revoked, or that were issued within tenants the caller is
+	// not allowed to act upon, are reported as inactive.
+	response := IntrospectionResponse{}
+	claims, err := sts.VerifyDeviceAccessToken(requestID, token)
+	switch {
+	case err == nil:
+		if appClaims.IsTenantAllowed(claims.TenantID) {
+			response = newIntrospectionResponse(claims)
+		}
+
+	case errors.Is(err, sts.ErrInvalidAccessToken),
+		errors.Is(err, sts.ErrAccessTokenRevoked):
+		dstsLogger.Info("Presented device access token is not active",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+
+	default:
+		dstsLogger.Error("Failed to verify the presented device access token!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		sendInternalServerErrorResponse(w)
+		metrics.MetricTokenIntrospectionInternalErrors.Inc()
+		return
+	}
+
+	err = sendJsonResponse(w, http.StatusOK, response)
+	if err != nil {
+		dstsLogger.Error("Failed to encode JSON response!",
+			zap.String("Request ID", requestID),
+			zap.Error(err),
+		)
+		metrics.MetricTokenIntrospectionInternalErrors.Inc()
+		return
+	}
+
+	metrics.MetricTokenIntrospectionResponses.Inc()
+}
+
+// Verify the app access token presented in the authorization header of the
+// request, and ensure it grants the tokens:introspect scope.
+func authorizeTokenIntrospection(r *http.Request,
+	requestID string) (*sts.AppTokenClaims, bool) {
+	authorization := r.Header.Get(headerAuthorization)
+	if (len(authorization) <= len(bearerTokenPrefix)) ||
+		!strings.EqualFold(authorization[:len(bearerTokenPrefix)],
+			bearerTokenPrefix) {
+		dstsLogger.Error("App access token was not presented to the token introspection endpoint!",
+			zap.String("Request ID", requestID),
+		)
+		return nil, false
+	}
+
+	claims, err := sts.VerifyAppAccessToken(requestID,
+		authorization[len(bearerTokenPrefix):])
+	if err != nil {
+		return nil, false
+	}
+	if !claims.HasScope(db.ScopeTokensIntrospect) {
+		dstsLogger.Error("App access token does not grant the required scope!",
+			zap.String("Request ID", requestID),
+			zap.String("App ID", claims.Subject),
+			zap.String("Required scope", db.ScopeTokensIntrospect),
+		)
+		return nil, false
+	}
+	return claims, true
+}
+
+func newIntrospectionResponse(claims *sts.DeviceTokenClaims) IntrospectionResponse {
+	response := IntrospectionResponse{
+		Active:            true,
+		TokenType:         claims.TokenType,
+		Subject:           claims.Subject,
+		TenantID:          claims.TenantID,
+		ManagementService: claims.ManagementService,
+		Issuer:            claims.Issuer,
+		Confirmation:      claims.Confirmation,
+	}
+	if claims.ExpiresAt != nil {
+		response.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	if claims.IssuedAt != nil {
+		response.IssuedAt = claims.IssuedAt.Unix()
+	}
+	return response
+}
@@ -51,8 +51,8 @@ func (s *DeviceSTSServer) AuthenticateApp(ctx context.Context,
 
 	// Invoke the STS to parse and validate the provided client assertion. If
 	// the assertion is valid, return an app access token.
-	accessToken, expiresAt, err := sts.GetAccessTokenFromAppAssertion(requestID,
-		request.AppId, request.Assertion)
+	accessToken, scope, expiresAt, err := sts.GetAccessTokenFromAppAssertion(requestID,
+		request.AppId, request.Assertion, request.Scope)
 	if err != nil {
 		dstsLogger.Error("Failed to generate access token from assertion!",
 			zap.String("Request ID: ", requestID),
@@ -63,10 +63,16 @@ func (s *DeviceSTSServer) AuthenticateApp(ctx context.Context,
 		if (errors.Is(err, db.ErrAuthnBlocked)) ||
 			(errors.Is(err, db.ErrNotFound)) ||
 			(errors.Is(err, sts.ErrAssertionExpired)) ||
-			(errors.Is(err, sts.ErrAssertionNotValidYet)) {
+			(errors.Is(err, sts.ErrAssertionNotValidYet)) ||
+			(errors.Is(err, sts.ErrInvalidDeviceChallenge)) {
 			return unauthorizedAuthenticateAppResponse(requestID), nil
 		}
 
+		// Check if none of the requested scopes were granted to the app.
+		if errors.Is(err, sts.ErrInvalidScope) {
+			return invalidAuthenticateAppResponse(requestID), nil
+		}
+
 		if errors.Is(err, db.ErrDatabaseBusy) {
 			return serverBusyAuthenticateAppResponse(requestID), nil
 		}
@@ -74,7 +80,8 @@ func (s *DeviceSTSServer) AuthenticateApp(ctx context.Context,
 		return internalErrorAuthenticateAppResponse(requestID), nil
 	}
 
-	response := successAuthenticateAppResponse(requestID, accessToken, expiresAt)
+	response := successAuthenticateAppResponse(requestID, accessToken, scope,
+		expiresAt)
 	return response, nil
 }
 
@@ -126,7 +133,7 @@ func unauthorizedAuthenticateAppResponse(
 }
 
 func successAuthenticateAppResponse(
-	requestID string, accessToken string,
+	requestID string, accessToken string, scope string,
 	expiresAt time.Time) *pb.AppAuthenticationResponse {
 	response := &pb.AppAuthenticationResponse{
 		Header: &pb.DstsResponseHeader{
@@ -138,6 +145,7 @@ func successAuthenticateAppResponse(
 		},
 		AccessToken: accessToken,
 		ExpiresAt:   timestamppb.New(expiresAt),
+		Scope:       scope,
 	}
 
 	metrics.MetricAppAuthenticationRequests.Inc()
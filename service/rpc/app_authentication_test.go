@@ -72,7 +72,9 @@ func TestAppAuthentication(t *testing.T) {
 	app, err := db.NewRegisteredApp(uuid.NewString(),
 		"App authentication test app",
 		true,
-		&pKey.PublicKey)
+		&pKey.PublicKey,
+		[]string{db.ScopeDevicesRead, db.ScopeDevicesWrite},
+		nil)
 	if err != nil {
 		dstsLogger.Error("Failed to initialize registered app!",
 			zap.Error(err))
@@ -135,6 +137,7 @@ func TestAppAuthentication(t *testing.T) {
 		AppId:         app.AppId,
 		AssertionType: sts.ClientAssertionType,
 		Assertion:     assertion,
+		Scope:         db.ScopeDevicesRead + " " + db.ScopeSigningKeysRead,
 	})
 	if err != nil {
 		dstsLogger.Error("AuthenticateApp RPC failed",
@@ -143,6 +146,7 @@ func TestAppAuthentication(t *testing.T) {
 		return
 	}
 	assertEqual(t, authResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, authResponse.Scope, db.ScopeDevicesRead)
 	dstsLogger.Info("Response from device STS:",
 		zap.Any("Response:", authResponse))
 }
@@ -0,0 +1,115 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Map of device group actions supported by the protocol to the actions
+// applied by the database.
+var deviceGroupActions = map[pb.DeviceGroupAction]db.DeviceGroupAction{
+	pb.DeviceGroupAction_DEVICE_GROUP_ACTION_DISABLE:    db.DeviceGroupActionDisable,
+	pb.DeviceGroupAction_DEVICE_GROUP_ACTION_ENABLE:     db.DeviceGroupActionEnable,
+	pb.DeviceGroupAction_DEVICE_GROUP_ACTION_MARK_LOST:  db.DeviceGroupActionMarkLost,
+	pb.DeviceGroupAction_DEVICE_GROUP_ACTION_MARK_FOUND: db.DeviceGroupActionMarkFound,
+}
+
+// ApplyDeviceGroupAction - apply an action, such as disabling the devices, to
+// all members of the device group in a single transaction.
+func (s *DeviceSTSServer) ApplyDeviceGroupAction(ctx context.Context,
+	request *pb.ApplyDeviceGroupActionRequest) (*pb.ApplyDeviceGroupActionResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return applyDeviceGroupActionErrorResponse(requestID,
+			codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a tenant ID, group ID and a supported
+	// action.
+	action, ok := deviceGroupActions[request.Action]
+	if (request.Tid == "") || (request.GroupId == "") || !ok {
+		dstsLogger.Error("Tenant ID, group ID or a valid action were not specified",
+			zap.String("Request ID", requestID),
+			zap.String("Action", request.Action.String()),
+		)
+		return applyDeviceGroupActionErrorResponse(requestID,
+			codes.InvalidArgument), nil
+	}
+
+	updated, err := db.ApplyDeviceGroupAction(requestID, request.Tid,
+		request.GroupId, action)
+	if err != nil {
+		dstsLogger.Error("Failed to apply the action to the device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Group ID", request.GroupId),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Action", request.Action.String()),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return applyDeviceGroupActionErrorResponse(requestID,
+				codes.NotFound), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return applyDeviceGroupActionErrorResponse(requestID,
+				codes.ResourceExhausted), nil
+		}
+		return applyDeviceGroupActionErrorResponse(requestID,
+			codes.Internal), nil
+	}
+
+	return successApplyDeviceGroupActionResponse(requestID, updated), nil
+}
+
+func successApplyDeviceGroupActionResponse(requestID string,
+	updated int64) *pb.ApplyDeviceGroupActionResponse {
+	metrics.MetricDeviceGroupActionApplied.Inc()
+	return &pb.ApplyDeviceGroupActionResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "ApplyDeviceGroupAction RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		DevicesUpdated: updated,
+		UpdateTime:     timestamppb.Now(),
+	}
+}
+
+func applyDeviceGroupActionErrorResponse(requestID string,
+	code codes.Code) *pb.ApplyDeviceGroupActionResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricApplyDeviceGroupActionBadRequests.Inc()
+	case codes.NotFound:
+		metrics.MetricApplyDeviceGroupActionNotFoundErrors.Inc()
+	default:
+		metrics.MetricApplyDeviceGroupActionInternalErrors.Inc()
+	}
+
+	return &pb.ApplyDeviceGroupActionResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "ApplyDeviceGroupAction RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,318 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// gRPC metadata key in which callers present their app access token.
+	metadataAuthorization = "authorization"
+	bearerTokenPrefix     = "bearer "
+
+	dstsServicePrefix = "/krypton.dsts.DeviceSTS/"
+)
+
+// rpcPermission - permission required to invoke an RPC.
+type rpcPermission struct {
+	// Whether the RPC can be invoked without presenting an access token.
+	anonymous bool
+
+	// The scope that must be issued in the caller's app access token.
+	scope string
+
+	// Whether the tenant is optional for the RPC. If no tenant is specified,
+	// the caller's allowed tenants are not checked.
+	tenantOptional bool
+
+	// The scope that must be granted to invoke the RPC across all tenants,
+	// by not specifying a tenant. The caller must also not be restricted to
+	// specific tenants.
+	allTenantsScope string
+
+	// Whether the tenant is only known from the response of the RPC. The
+	// response is withheld if the caller is not allowed to act upon it.
+	tenantInResponse bool
+}
+
+// Per-method permission policy for the DSTS gRPC API. RPCs not listed in the
+// policy cannot be invoked when authorization is enabled.
+var rpcPermissionPolicy = map[string]rpcPermission{
+	dstsServicePrefix + "Ping":                          {anonymous: true},
+	dstsServicePrefix + "GetAppAuthenticationChallenge": {anonymous: true},
+	dstsServicePrefix + "AuthenticateApp":               {anonymous: true},
+
+	dstsServicePrefix + "CreateDevice":  {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "GetDevice":     {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "ListDevices":   {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "StreamDevices": {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "UpdateDevice":  {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "DeleteDevice":  {scope: db.ScopeDevicesWrite},
+
+	dstsServicePrefix + "BatchCreateDevices":       {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "BatchUpdateDevices":       {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "BatchCreateDevicesStream": {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "BatchUpdateDevicesStream": {scope: db.ScopeDevicesWrite},
+
+	dstsServicePrefix + "GetDevicePosture": {scope: db.ScopeDevicesRead},
+
+	dstsServicePrefix + "ListTombstonedDevices": {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "RestoreDevice":         {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "TransferDevice":        {scope: db.ScopeDevicesWrite},
+
+	dstsServicePrefix + "FindDevices": {scope: db.ScopeDevicesRead,
+		allTenantsScope: db.ScopeDevicesReadAllTenants},
+
+	dstsServicePrefix + "CreateDeviceGroup":        {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "GetDeviceGroup":           {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "ListDeviceGroups":         {scope: db.ScopeDevicesRead},
+	dstsServicePrefix + "DeleteDeviceGroup":        {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "AddDeviceGroupMembers":    {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "RemoveDeviceGroupMembers": {scope: db.ScopeDevicesWrite},
+	dstsServicePrefix + "ApplyDeviceGroupAction":   {scope: db.ScopeDevicesWrite},
+
+	dstsServicePrefix + "GetSigningKey": {scope: db.ScopeSigningKeysRead, tenantOptional: true},
+
+	dstsServicePrefix + "CreateEnrollmentToken": {scope: db.ScopeEnrollmentTokensWrite},
+	dstsServicePrefix + "GetEnrollmentToken":    {scope: db.ScopeEnrollmentTokensRead},
+	dstsServicePrefix + "DeleteEnrollmentToken": {scope: db.ScopeEnrollmentTokensWrite},
+
+	dstsServicePrefix + "ValidateEnrollmentToken": {scope: db.ScopeEnrollmentTokensRead,
+		tenantInResponse: true},
+
+	dstsServicePrefix + "CreateTokenPolicy": {scope: db.ScopeTokenPoliciesWrite},
+	dstsServicePrefix + "GetTokenPolicy":    {scope: db.ScopeTokenPoliciesRead},
+	dstsServicePrefix + "ListTokenPolicies": {scope: db.ScopeTokenPoliciesRead},
+	dstsServicePrefix + "UpdateTokenPolicy": {scope: db.ScopeTokenPoliciesWrite},
+	dstsServicePrefix + "DeleteTokenPolicy": {scope: db.ScopeTokenPoliciesWrite},
+
+	dstsServicePrefix + "UpdateTenantState": {scope: db.ScopeTenantsWrite},
+	dstsServicePrefix + "PurgeTenant":       {scope: db.ScopeTenantsWrite},
+	dstsServicePrefix + "GetTenantStats":    {scope: db.ScopeTenantsRead},
+}
+
+// Requests that are scoped to a tenant.
+type tenantScopedRequest interface {
+	GetTid() string
+}
+
+// Responses that identify the tenant of a resource, for RPCs whose tenant is
+// not specified in the request.
+type tenantScopedResponse interface {
+	GetTid() string
+}
+
+// Requests that move resources from their tenant to a target tenant.
+type targetTenantScopedRequest interface {
+	GetTargetTid() string
+}
+
+// Requests that carry the common DSTS request header.
+type dstsRequest interface {
+	GetHeader() *pb.DstsRequestHeader
+}
+
+// authorizationInterceptor - ensures the caller has presented an app access
+// token issued by the DSTS, granting it permission to invoke the requested
+// RPC on the requested tenant.
+func authorizationInterceptor(ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := getAuthorizationRequestID(req)
+	claims, err := authorizeCaller(ctx, info.FullMethod, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if claims == nil {
+		return handler(ctx, req)
+	}
+
+	err = authorizeRequest(requestID, info.FullMethod, claims, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	// If the tenant is only known from the response, check whether the caller
+	// is allowed to act upon it before returning the response.
+	if r, ok := resp.(tenantScopedResponse); ok &&
+		rpcPermissionPolicy[info.FullMethod].tenantInResponse &&
+		(r.GetTid() != "") && !claims.IsTenantAllowed(r.GetTid()) {
+		return nil, denyRequest(requestID, info.FullMethod, claims.Subject,
+			"app is not allowed to access tenant "+r.GetTid())
+	}
+	return resp, nil
+}
+
+// streamAuthorizationInterceptor - authorizes streaming RPCs. The caller's
+// app access token and scope are verified when the stream is started. The
+// tenant is only known once the request message is received from the stream,
+// so each received request is also authorized before it is returned to the
+// handler.
+func streamAuthorizationInterceptor(srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	claims, err := authorizeCaller(ss.Context(), info.FullMethod, "")
+	if err != nil {
+		return err
+	}
+	if claims == nil {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &authorizedServerStream{
+		ServerStream: ss,
+		method:       info.FullMethod,
+		claims:       claims,
+	})
+}
+
+// authorizedServerStream - a server stream that authorizes each request
+// received from the caller.
+type authorizedServerStream struct {
+	grpc.ServerStream
+	method string
+	claims *sts.AppTokenClaims
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	return authorizeRequest(getAuthorizationRequestID(m), s.method, s.claims, m)
+}
+
+// Return the request ID specified in the header of the request, if any.
+func getAuthorizationRequestID(req interface{}) string {
+	if r, ok := req.(dstsRequest); ok {
+		return r.GetHeader().GetRequestId()
+	}
+	return ""
+}
+
+// authorizeCaller - check the permission policy for the RPC against the app
+// access token presented by the caller. The claims in the app access token
+// are returned, or nil if the RPC can be invoked anonymously.
+func authorizeCaller(ctx context.Context, method string,
+	requestID string) (*sts.AppTokenClaims, error) {
+	permission, ok := rpcPermissionPolicy[method]
+	if !ok {
+		return nil, denyRequest(requestID, method, "",
+			"no permission policy defined for the RPC")
+	}
+	if permission.anonymous {
+		return nil, nil
+	}
+
+	// Extract the bearer app access token from the request metadata.
+	accessToken := getBearerToken(ctx)
+	if accessToken == "" {
+		return nil, denyRequest(requestID, method, "",
+			"app access token was not presented")
+	}
+
+	claims, err := sts.VerifyAppAccessToken(requestID, accessToken)
+	if err != nil {
+		return nil, denyRequest(requestID, method, "",
+			"invalid app access token presented")
+	}
+
+	// Check if the caller was granted the scope required to invoke the RPC.
+	if !claims.HasScope(permission.scope) {
+		return nil, denyRequest(requestID, method, claims.Subject,
+			"required scope "+permission.scope+" was not granted")
+	}
+	return claims, nil
+}
+
+// authorizeRequest - check whether the caller, whose app access token was
+// verified by authorizeCaller, is allowed to act upon the tenant of the
+// request.
+func authorizeRequest(requestID string, method string,
+	claims *sts.AppTokenClaims, req interface{}) error {
+	permission := rpcPermissionPolicy[method]
+
+	// Check if the caller is allowed to act upon the requested tenant.
+	if r, ok := req.(tenantScopedRequest); ok {
+		if (r.GetTid() == "") && (permission.allTenantsScope != "") {
+			if !claims.HasScope(permission.allTenantsScope) ||
+				(len(claims.AllowedTenants) != 0) {
+				return denyRequest(requestID, method, claims.Subject,
+					"app is not allowed to access all tenants")
+			}
+		} else if (r.GetTid() != "" || !permission.tenantOptional) &&
+			!claims.IsTenantAllowed(r.GetTid()) {
+			return denyRequest(requestID, method, claims.Subject,
+				"app is not allowed to access tenant "+r.GetTid())
+		}
+	}
+
+	// The caller must also be allowed to act upon the target tenant of
+	// requests that move resources between tenants.
+	if r, ok := req.(targetTenantScopedRequest); ok &&
+		!claims.IsTenantAllowed(r.GetTargetTid()) {
+		return denyRequest(requestID, method, claims.Subject,
+			"app is not allowed to access tenant "+r.GetTargetTid())
+	}
+
+	dstsLogger.Info("Audit: authorized gRPC request.",
+		zap.String("Request ID:", requestID),
+		zap.String("Method:", method),
+		zap.String("App ID:", claims.Subject),
+	)
+	return nil
+}
+
+// Extract the bearer token from the authorization metadata of the request.
+func getBearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(metadataAuthorization)
+	if len(values) == 0 {
+		return ""
+	}
+
+	if !strings.HasPrefix(strings.ToLower(values[0]), bearerTokenPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(values[0][len(bearerTokenPrefix):])
+}
+
+// Record an audit entry for the unauthorized request and return the error
+// to be sent to the caller.
+func denyRequest(requestID string, method string, appID string,
+	reason string) error {
+	dstsLogger.Warn("Audit: rejected unauthorized gRPC request!",
+		zap.String("Request ID:", requestID),
+		zap.String("Method:", method),
+		zap.String("App ID:", appID),
+		zap.String("Reason:", reason),
+	)
+	metrics.MetricRPCAuthorizationFailures.Inc()
+	return status.Error(codes.PermissionDenied,
+		"caller is not authorized to invoke this RPC")
+}
@@ -0,0 +1,559 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// App access token presented by the unit tests, unless a test presents
+	// its own access token. The token is granted all scopes and is not
+	// restricted to specific tenants.
+	gAppAccessToken string
+
+	// All scopes that can be granted to registered apps.
+	testAppScopes = []string{
+		db.ScopeDevicesRead,
+		db.ScopeDevicesWrite,
+		db.ScopeDevicesReadAllTenants,
+		db.ScopeEnrollmentTokensRead,
+		db.ScopeEnrollmentTokensWrite,
+		db.ScopeSigningKeysRead,
+		db.ScopeTokenPoliciesRead,
+		db.ScopeTokenPoliciesWrite,
+		db.ScopeTenantsRead,
+		db.ScopeTenantsWrite,
+		db.ScopeTokensIntrospect,
+	}
+)
+
+// Issue an app access token granting the specified scopes to a test app,
+// optionally restricted to the specified tenants.
+func newTestAppAccessToken(scopes []string, allowedTenants []string) (string,
+	error) {
+	app := &db.RegisteredApp{
+		AppId:          uuid.NewString(),
+		Name:           "Authorization test app",
+		IsEnabled:      true,
+		Scopes:         scopes,
+		AllowedTenants: allowedTenants,
+	}
+	token, _, err := sts.NewAppAccessToken("test", app, scopes)
+	return token, err
+}
+
+// Return the scopes that can be granted to registered apps, except for the
+// specified scope.
+func testAppScopesExcept(scope string) []string {
+	scopes := []string{}
+	for _, item := range testAppScopes {
+		if item != scope {
+			scopes = append(scopes, item)
+		}
+	}
+	return scopes
+}
+
+// Present the specified app access token in the authorization metadata of
+// RPCs invoked using the returned context.
+func withAppAccessToken(ctx context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, metadataAuthorization,
+		"Bearer "+accessToken)
+}
+
+// Present the default test app access token, unless the RPC is invoked with
+// a context specifying the authorization metadata.
+func appTokenUnaryClientInterceptor(ctx context.Context, method string,
+	req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption) error {
+	return invoker(withDefaultAppAccessToken(ctx), method, req, reply, cc,
+		opts...)
+}
+
+func appTokenStreamClientInterceptor(ctx context.Context,
+	desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withDefaultAppAccessToken(ctx), desc, cc, method, opts...)
+}
+
+func withDefaultAppAccessToken(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok && (len(md.Get(metadataAuthorization)) != 0) {
+		return ctx
+	}
+	return withAppAccessToken(ctx, gAppAccessToken)
+}
+
+// An RPC subject to authorization, along with the scope required to invoke
+// it.
+type authorizationTestCase struct {
+	method string
+	scope  string
+
+	// Whether the RPC is scoped to the tenant specified in the request.
+	tenantScoped bool
+
+	// Invoke the RPC on the specified tenant and return the gRPC error.
+	invoke func(ctx context.Context, tenantID string) error
+}
+
+var authorizationTestCases = []authorizationTestCase{
+	{"CreateDevice", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.CreateDevice(ctx, &pb.CreateDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetDevice", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetDevice(ctx, &pb.GetDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ListDevices", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ListDevices(ctx, &pb.ListDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"StreamDevices", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		stream, err := gClient.StreamDevices(ctx, &pb.StreamDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		if err != nil {
+			return err
+		}
+		for {
+			_, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}},
+	{"FindDevices", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.FindDevices(ctx, &pb.FindDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"UpdateDevice", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.UpdateDevice(ctx, &pb.UpdateDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"DeleteDevice", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.DeleteDevice(ctx, &pb.DeleteDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"BatchCreateDevices", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.BatchCreateDevices(ctx, &pb.BatchCreateDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"BatchUpdateDevices", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.BatchUpdateDevices(ctx, &pb.BatchUpdateDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"BatchCreateDevicesStream", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		stream, err := gClient.BatchCreateDevicesStream(ctx)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&pb.BatchCreateDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		if (err != nil) && !errors.Is(err, io.EOF) {
+			return err
+		}
+		_, err = stream.CloseAndRecv()
+		return err
+	}},
+	{"BatchUpdateDevicesStream", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		stream, err := gClient.BatchUpdateDevicesStream(ctx)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&pb.BatchUpdateDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		if (err != nil) && !errors.Is(err, io.EOF) {
+			return err
+		}
+		_, err = stream.CloseAndRecv()
+		return err
+	}},
+	{"GetDevicePosture", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetDevicePosture(ctx, &pb.GetDevicePostureRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ListTombstonedDevices", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ListTombstonedDevices(ctx, &pb.ListTombstonedDevicesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"RestoreDevice", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.RestoreDevice(ctx, &pb.RestoreDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"TransferDevice", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.TransferDevice(ctx, &pb.TransferDeviceRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID,
+			TargetTid: tenantID})
+		return err
+	}},
+	{"CreateDeviceGroup", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.CreateDeviceGroup(ctx, &pb.CreateDeviceGroupRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetDeviceGroup", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetDeviceGroup(ctx, &pb.GetDeviceGroupRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ListDeviceGroups", db.ScopeDevicesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ListDeviceGroups(ctx, &pb.ListDeviceGroupsRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"DeleteDeviceGroup", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.DeleteDeviceGroup(ctx, &pb.DeleteDeviceGroupRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"AddDeviceGroupMembers", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.AddDeviceGroupMembers(ctx, &pb.UpdateDeviceGroupMembersRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"RemoveDeviceGroupMembers", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.RemoveDeviceGroupMembers(ctx, &pb.UpdateDeviceGroupMembersRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ApplyDeviceGroupAction", db.ScopeDevicesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ApplyDeviceGroupAction(ctx, &pb.ApplyDeviceGroupActionRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetSigningKey", db.ScopeSigningKeysRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetSigningKey(ctx, &pb.GetSigningKeyRequest{Tid: tenantID})
+		return err
+	}},
+	{"CreateEnrollmentToken", db.ScopeEnrollmentTokensWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.CreateEnrollmentToken(ctx, &pb.CreateEnrollmentTokenRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetEnrollmentToken", db.ScopeEnrollmentTokensRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetEnrollmentToken(ctx, &pb.GetEnrollmentTokenRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"DeleteEnrollmentToken", db.ScopeEnrollmentTokensWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.DeleteEnrollmentToken(ctx, &pb.DeleteEnrollmentTokenRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ValidateEnrollmentToken", db.ScopeEnrollmentTokensRead, false, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ValidateEnrollmentToken(ctx, &pb.ValidateEnrollmentTokenRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion})
+		return err
+	}},
+	{"CreateTokenPolicy", db.ScopeTokenPoliciesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.CreateTokenPolicy(ctx, &pb.CreateTokenPolicyRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetTokenPolicy", db.ScopeTokenPoliciesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetTokenPolicy(ctx, &pb.GetTokenPolicyRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"ListTokenPolicies", db.ScopeTokenPoliciesRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.ListTokenPolicies(ctx, &pb.ListTokenPoliciesRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"UpdateTokenPolicy", db.ScopeTokenPoliciesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.UpdateTokenPolicy(ctx, &pb.UpdateTokenPolicyRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"DeleteTokenPolicy", db.ScopeTokenPoliciesWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.DeleteTokenPolicy(ctx, &pb.DeleteTokenPolicyRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"UpdateTenantState", db.ScopeTenantsWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.UpdateTenantState(ctx, &pb.UpdateTenantStateRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"PurgeTenant", db.ScopeTenantsWrite, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.PurgeTenant(ctx, &pb.PurgeTenantRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+	{"GetTenantStats", db.ScopeTenantsRead, true, func(ctx context.Context, tenantID string) error {
+		_, err := gClient.GetTenantStats(ctx, &pb.GetTenantStatsRequest{
+			Header: newDstsProtocolHeader(), Version: DstsProtocolVersion, Tid: tenantID})
+		return err
+	}},
+}
+
+// Check whether the RPC was rejected by the authorization interceptor.
+func isPermissionDenied(err error) bool {
+	return status.Code(err) == codes.PermissionDenied
+}
+
+// Every RPC in the permission policy must be covered by the authorization
+// tests.
+func TestAuthorization_PolicyCoverage(t *testing.T) {
+	tested := map[string]bool{}
+	for _, test := range authorizationTestCases {
+		tested[dstsServicePrefix+test.method] = true
+	}
+	for method, permission := range rpcPermissionPolicy {
+		if !permission.anonymous && !tested[method] {
+			t.Errorf("TestAuthorization_PolicyCoverage: no authorization test for %s", method)
+		}
+	}
+}
+
+func TestAuthorization_Scopes(t *testing.T) {
+	tenantID := uuid.NewString()
+
+	for _, test := range authorizationTestCases {
+		// Callers granted the required scope are allowed to invoke the RPC.
+		token, err := newTestAppAccessToken([]string{test.scope}, nil)
+		if err != nil {
+			t.Errorf("TestAuthorization_Scopes: failed to issue app access token: %v", err)
+			return
+		}
+		err = test.invoke(withAppAccessToken(gCtx, token), tenantID)
+		if isPermissionDenied(err) {
+			t.Errorf("TestAuthorization_Scopes: %s was denied with scope %s",
+				test.method, test.scope)
+		}
+
+		// Callers granted every other scope are denied.
+		token, err = newTestAppAccessToken(testAppScopesExcept(test.scope), nil)
+		if err != nil {
+			t.Errorf("TestAuthorization_Scopes: failed to issue app access token: %v", err)
+			return
+		}
+		err = test.invoke(withAppAccessToken(gCtx, token), tenantID)
+		if !isPermissionDenied(err) {
+			t.Errorf("TestAuthorization_Scopes: %s was allowed without scope %s (error %v)",
+				test.method, test.scope, err)
+		}
+	}
+}
+
+func TestAuthorization_AllowedTenants(t *testing.T) {
+	allowedTenantID := uuid.NewString()
+	otherTenantID := uuid.NewString()
+
+	token, err := newTestAppAccessToken(testAppScopes, []string{allowedTenantID})
+	if err != nil {
+		t.Errorf("TestAuthorization_AllowedTenants: failed to issue app access token: %v", err)
+		return
+	}
+	ctx := withAppAccessToken(gCtx, token)
+
+	for _, test := range authorizationTestCases {
+		// Apps restricted to specific tenants can act upon those tenants.
+		err = test.invoke(ctx, allowedTenantID)
+		if isPermissionDenied(err) {
+			t.Errorf("TestAuthorization_AllowedTenants: %s was denied on an allowed tenant",
+				test.method)
+		}
+
+		// Other tenants cannot be acted upon.
+		err = test.invoke(ctx, otherTenantID)
+		if test.tenantScoped && !isPermissionDenied(err) {
+			t.Errorf("TestAuthorization_AllowedTenants: %s was allowed on another tenant (error %v)",
+				test.method, err)
+		}
+	}
+
+	// Devices cannot be transferred to a tenant the app is not allowed to act
+	// upon.
+	_, err = gClient.TransferDevice(ctx, &pb.TransferDeviceRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       allowedTenantID,
+		DeviceId:  uuid.NewString(),
+		TargetTid: otherTenantID,
+	})
+	assertEqual(t, isPermissionDenied(err), true)
+
+	// Apps restricted to specific tenants cannot find devices across all
+	// tenants, even if granted the scope to do so.
+	_, err = gClient.FindDevices(ctx, &pb.FindDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+	})
+	assertEqual(t, isPermissionDenied(err), true)
+
+	// The service signing key can be retrieved without specifying a tenant.
+	_, err = gClient.GetSigningKey(ctx, &pb.GetSigningKeyRequest{})
+	assertEqual(t, isPermissionDenied(err), false)
+}
+
+func TestAuthorization_FindDevicesAllTenants(t *testing.T) {
+	request := &pb.FindDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+	}
+
+	// Finding devices across all tenants requires an additional scope.
+	token, err := newTestAppAccessToken([]string{db.ScopeDevicesRead}, nil)
+	if err != nil {
+		t.Errorf("TestAuthorization_FindDevicesAllTenants: failed to issue app access token: %v", err)
+		return
+	}
+	_, err = gClient.FindDevices(withAppAccessToken(gCtx, token), request)
+	assertEqual(t, isPermissionDenied(err), true)
+
+	token, err = newTestAppAccessToken([]string{db.ScopeDevicesRead,
+		db.ScopeDevicesReadAllTenants}, nil)
+	if err != nil {
+		t.Errorf("TestAuthorization_FindDevicesAllTenants: failed to issue app access token: %v", err)
+		return
+	}
+	request.Header = newDstsProtocolHeader()
+	_, err = gClient.FindDevices(withAppAccessToken(gCtx, token), request)
+	assertEqual(t, isPermissionDenied(err), false)
+}
+
+func TestAuthorization_InvalidAccessToken(t *testing.T) {
+	request := &pb.GetDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      uuid.NewString(),
+		DeviceId: uuid.NewString(),
+	}
+
+	// No access token.
+	ctx := metadata.AppendToOutgoingContext(gCtx, metadataAuthorization, "")
+	_, err := gClient.GetDevice(ctx, request)
+	assertEqual(t, isPermissionDenied(err), true)
+
+	// Malformed access token.
+	_, err = gClient.GetDevice(withAppAccessToken(gCtx, "invalid"), request)
+	assertEqual(t, isPermissionDenied(err), true)
+
+	// Access token not presented as a bearer token.
+	token, err := newTestAppAccessToken(testAppScopes, nil)
+	if err != nil {
+		t.Errorf("TestAuthorization_InvalidAccessToken: failed to issue app access token: %v", err)
+		return
+	}
+	ctx = metadata.AppendToOutgoingContext(gCtx, metadataAuthorization, token)
+	_, err = gClient.GetDevice(ctx, request)
+	assertEqual(t, isPermissionDenied(err), true)
+
+	// Anonymous RPCs do not require an access token.
+	ctx = metadata.AppendToOutgoingContext(gCtx, metadataAuthorization, "")
+	_, err = gClient.Ping(ctx, &pb.PingRequest{Message: "ping"})
+	assertEqual(t, isPermissionDenied(err), false)
+}
+
+func TestAuthorization_ValidateEnrollmentTokenTenant(t *testing.T) {
+	tenantID := uuid.NewString()
+	createResponse, err := gClient.CreateEnrollmentToken(gCtx,
+		&pb.CreateEnrollmentTokenRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+		})
+	if err != nil {
+		t.Errorf("TestAuthorization_ValidateEnrollmentTokenTenant: CreateEnrollmentToken RPC failed %v", err)
+		return
+	}
+	assertEqual(t, createResponse.Header.Status, uint32(codes.OK))
+	if createResponse.Header.Status != uint32(codes.OK) {
+		return
+	}
+
+	// The tenant of the enrollment token is only known from the response, and
+	// is withheld from apps that are not allowed to act upon it.
+	token, err := newTestAppAccessToken([]string{db.ScopeEnrollmentTokensRead},
+		[]string{uuid.NewString()})
+	if err != nil {
+		t.Errorf("TestAuthorization_ValidateEnrollmentTokenTenant: failed to issue app access token: %v", err)
+		return
+	}
+	_, err = gClient.ValidateEnrollmentToken(withAppAccessToken(gCtx, token),
+		&pb.ValidateEnrollmentTokenRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Token:   createResponse.Token.Token,
+		})
+	assertEqual(t, isPermissionDenied(err), true)
+
+	token, err = newTestAppAccessToken([]string{db.ScopeEnrollmentTokensRead},
+		[]string{tenantID})
+	if err != nil {
+		t.Errorf("TestAuthorization_ValidateEnrollmentTokenTenant: failed to issue app access token: %v", err)
+		return
+	}
+	validateResponse, err := gClient.ValidateEnrollmentToken(
+		withAppAccessToken(gCtx, token), &pb.ValidateEnrollmentTokenRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Token:   createResponse.Token.Token,
+		})
+	if err != nil {
+		t.Errorf("TestAuthorization_ValidateEnrollmentTokenTenant: ValidateEnrollmentToken RPC failed %v", err)
+		return
+	}
+	assertEqual(t, validateResponse.IsValid, true)
+	assertEqual(t, validateResponse.Tid, tenantID)
+}
+
+func TestAuthorization_StreamWithoutRequests(t *testing.T) {
+	// Client streams are authorized when they are started, even if the caller
+	// never sends a request.
+	token, err := newTestAppAccessToken(
+		testAppScopesExcept(db.ScopeDevicesWrite), nil)
+	if err != nil {
+		t.Errorf("TestAuthorization_StreamWithoutRequests: failed to issue app access token: %v", err)
+		return
+	}
+
+	for _, ctx := range []context.Context{
+		metadata.AppendToOutgoingContext(gCtx, metadataAuthorization, ""),
+		withAppAccessToken(gCtx, "invalid"),
+		withAppAccessToken(gCtx, token),
+	} {
+		createStream, err := gClient.BatchCreateDevicesStream(ctx)
+		if err == nil {
+			_, err = createStream.CloseAndRecv()
+		}
+		assertEqual(t, isPermissionDenied(err), true)
+
+		updateStream, err := gClient.BatchUpdateDevicesStream(ctx)
+		if err == nil {
+			_, err = updateStream.CloseAndRecv()
+		}
+		assertEqual(t, isPermissionDenied(err), true)
+	}
+}
@@ -0,0 +1,243 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BatchCreateDevices - create a batch of devices in the tenant. The result for
+// each device is returned in the response; devices that could not be created
+// do not fail the rest of the batch.
+func (s *DeviceSTSServer) BatchCreateDevices(ctx context.Context,
+	request *pb.BatchCreateDevicesRequest) (*pb.BatchCreateDevicesResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return batchCreateDevicesErrorResponse(requestID, codes.InvalidArgument,
+			nil), nil
+	}
+
+	results, code := createDeviceBatch(requestID, request)
+	if code != codes.OK {
+		return batchCreateDevicesErrorResponse(requestID, code, nil), nil
+	}
+	return successBatchCreateDevicesResponse(requestID, results), nil
+}
+
+// BatchCreateDevicesStream - create devices in batches streamed by the caller,
+// so that large batches can be sent as a series of smaller messages. Each
+// batch is created as it is received. The total number of devices in the
+// stream is limited to the maximum size of a BatchCreateDevices request. If a
+// batch cannot be processed, the results of the batches already created are
+// returned along with the error.
+func (s *DeviceSTSServer) BatchCreateDevicesStream(
+	stream pb.DeviceSTS_BatchCreateDevicesStreamServer) error {
+	var (
+		requestID string
+		results   []*pb.BatchDeviceResult
+	)
+
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// Validate the request header and extract the request identifier for
+		// end-to-end request tracing.
+		batchRequestID, ok := isValidRequestHeader(request.Header)
+		if !ok {
+			dstsLogger.Error("Invalid request header specified!")
+			return stream.SendAndClose(batchCreateDevicesErrorResponse(requestID,
+				codes.InvalidArgument, results))
+		}
+		if requestID == "" {
+			requestID = batchRequestID
+		}
+
+		// Results are accumulated until the stream completes, so the number
+		// of devices in the stream is bounded.
+		if len(results)+len(request.Devices) > db.MaxDeviceBatchSize {
+			dstsLogger.Error("Too many devices were specified in the stream",
+				zap.String("Request ID", batchRequestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Int("Number of devices", len(results)+len(request.Devices)),
+			)
+			return stream.SendAndClose(batchCreateDevicesErrorResponse(requestID,
+				codes.InvalidArgument, results))
+		}
+
+		batchResults, code := createDeviceBatch(batchRequestID, request)
+		if code != codes.OK {
+			return stream.SendAndClose(batchCreateDevicesErrorResponse(requestID,
+				code, results))
+		}
+		results = append(results, batchResults...)
+	}
+
+	return stream.SendAndClose(successBatchCreateDevicesResponse(requestID,
+		results))
+}
+
+// Validate the devices in the batch and create them in the database. Returns
+// the result for each device, or the status code if the batch could not be
+// processed.
+func createDeviceBatch(requestID string,
+	request *pb.BatchCreateDevicesRequest) ([]*pb.BatchDeviceResult, codes.Code) {
+	// Ensure the request specified a tenant ID and a valid number of devices.
+	if (request.Tid == "") || (len(request.Devices) == 0) ||
+		(len(request.Devices) > db.MaxDeviceBatchSize) {
+		dstsLogger.Error("Tenant ID or devices were not specified, or too many devices were specified",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Int("Number of devices", len(request.Devices)),
+		)
+		return nil, codes.InvalidArgument
+	}
+
+	results := make([]*pb.BatchDeviceResult, len(request.Devices))
+	newDevices := []db.Device{}
+	indexes := []int{}
+	for i, entry := range request.Devices {
+		if entry.DeviceId == "" {
+			results[i] = newBatchDeviceResult(entry.DeviceId,
+				codes.InvalidArgument, "Device ID was not specified")
+			continue
+		}
+
+		// Parse and validate the provided device certificate.
+		deviceCert, err := parseDeviceCertificate(requestID, request.Tid,
+			entry.DeviceId, entry.DeviceCertificate)
+		if err != nil {
+			results[i] = newBatchDeviceResult(entry.DeviceId,
+				codes.InvalidArgument, "Invalid device certificate: "+err.Error())
+			continue
+		}
+
+		err = db.ValidateDeviceLabels(entry.Labels, entry.Metadata)
+		if err != nil {
+			results[i] = newBatchDeviceResult(entry.DeviceId,
+				codes.InvalidArgument, err.Error())
+			continue
+		}
+
+		newDevices = append(newDevices, db.Device{
+			DeviceId:              entry.DeviceId,
+			TenantId:              request.Tid,
+			IsEnabled:             true,
+			IsLost:                false,
+			CertificateIssuedAt:   deviceCert.NotBefore,
+			CertificateThumbprint: common.GetCertificateThumbprint(deviceCert),
+			CertificateExpiresAt:  deviceCert.NotAfter,
+			ServiceId:             entry.ManagementService,
+			HardwareHash:          entry.HardwareHash,
+			Labels:                entry.Labels,
+			Metadata:              entry.Metadata,
+		})
+		indexes = append(indexes, i)
+	}
+
+	// Create the valid devices in the database.
+	if len(newDevices) != 0 {
+		deviceErrors, err := db.CreateDevices(requestID, newDevices)
+		if err != nil {
+			dstsLogger.Error("Failed to add the batch of devices to the database!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Error(err),
+			)
+			if errors.Is(err, db.ErrDatabaseBusy) {
+				return nil, codes.ResourceExhausted
+			}
+			return nil, codes.Internal
+		}
+
+		for j, i := range indexes {
+			deviceID := request.Devices[i].DeviceId
+			switch {
+			case deviceErrors[j] == nil:
+				results[i] = newBatchDeviceResult(deviceID, codes.OK,
+					"Device created")
+			case errors.Is(deviceErrors[j], db.ErrDuplicateEntry):
+				results[i] = newBatchDeviceResult(deviceID, codes.AlreadyExists,
+					"Device already exists")
+			case errors.Is(deviceErrors[j], db.ErrInvalidRequest):
+				results[i] = newBatchDeviceResult(deviceID, codes.InvalidArgument,
+					"Invalid management service")
+			default:
+				results[i] = newBatchDeviceResult(deviceID, codes.Internal,
+					"Failed to create device")
+			}
+		}
+	}
+
+	return results, codes.OK
+}
+
+func newBatchDeviceResult(deviceID string, code codes.Code,
+	message string) *pb.BatchDeviceResult {
+	return &pb.BatchDeviceResult{
+		DeviceId:      deviceID,
+		Status:        uint32(code),
+		StatusMessage: message,
+	}
+}
+
+func successBatchCreateDevicesResponse(requestID string,
+	results []*pb.BatchDeviceResult) *pb.BatchCreateDevicesResponse {
+	response := &pb.BatchCreateDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "BatchCreateDevices RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Results: results,
+	}
+
+	metrics.MetricDevicesBatchCreated.Inc()
+	return response
+}
+
+// Results of batches that were already processed by a streaming request are
+// included in the error response.
+func batchCreateDevicesErrorResponse(requestID string, code codes.Code,
+	results []*pb.BatchDeviceResult) *pb.BatchCreateDevicesResponse {
+	response := &pb.BatchCreateDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "BatchCreateDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Results: results,
+	}
+
+	if code == codes.InvalidArgument {
+		metrics.MetricBatchCreateDevicesBadRequests.Inc()
+	} else {
+		metrics.MetricBatchCreateDevicesInternalErrors.Inc()
+	}
+	return response
+}
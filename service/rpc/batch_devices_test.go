@@ -0,0 +1,226 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Create test device certificates for a batch of devices in the tenant.
+func newTestBatchCreateDeviceEntries(t *testing.T, tenantID string,
+	count int) []*pb.BatchCreateDeviceEntry {
+	var entries []*pb.BatchCreateDeviceEntry
+	for i := 0; i < count; i++ {
+		deviceCert, deviceID, _, err := createTestDeviceCertificate(tenantID,
+			testTenantName, "")
+		if err != nil {
+			t.Errorf("Failed to create test device certificate: %v", err)
+			return nil
+		}
+		entries = append(entries, &pb.BatchCreateDeviceEntry{
+			DeviceId:          deviceID,
+			DeviceCertificate: deviceCert,
+		})
+	}
+	return entries
+}
+
+func TestBatchCreateDevices(t *testing.T) {
+	tenantID := uuid.NewString()
+	entries := newTestBatchCreateDeviceEntries(t, tenantID, 3)
+	if entries == nil {
+		return
+	}
+
+	// Include a duplicate of the first device and a device with an invalid
+	// certificate in the batch.
+	entries = append(entries, entries[0], &pb.BatchCreateDeviceEntry{
+		DeviceId:          uuid.NewString(),
+		DeviceCertificate: []byte("invalid"),
+	})
+
+	response, err := gClient.BatchCreateDevices(gCtx, &pb.BatchCreateDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Devices: entries,
+	})
+	if err != nil {
+		t.Errorf("TestBatchCreateDevices: BatchCreateDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(response.Results), 5)
+	if len(response.Results) != 5 {
+		return
+	}
+	for i := 0; i < 3; i++ {
+		assertEqual(t, response.Results[i].Status, uint32(codes.OK))
+	}
+	assertEqual(t, response.Results[3].Status, uint32(codes.AlreadyExists))
+	assertEqual(t, response.Results[4].Status, uint32(codes.InvalidArgument))
+}
+
+func TestBatchCreateDevicesStream(t *testing.T) {
+	tenantID := uuid.NewString()
+	stream, err := gClient.BatchCreateDevicesStream(gCtx)
+	if err != nil {
+		t.Errorf("TestBatchCreateDevicesStream: BatchCreateDevicesStream RPC failed %v", err)
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		entries := newTestBatchCreateDeviceEntries(t, tenantID, 2)
+		if entries == nil {
+			return
+		}
+		err = stream.Send(&pb.BatchCreateDevicesRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			Devices: entries,
+		})
+		if err != nil {
+			t.Errorf("TestBatchCreateDevicesStream: failed to send batch %v", err)
+			return
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Errorf("TestBatchCreateDevicesStream: failed to receive response %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(response.Results), 4)
+	for _, result := range response.Results {
+		assertEqual(t, result.Status, uint32(codes.OK))
+	}
+}
+
+func TestBatchUpdateDevices(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 2)
+	if deviceIDs == nil {
+		return
+	}
+
+	disable := func(deviceID string) *pb.BatchUpdateDeviceEntry {
+		return &pb.BatchUpdateDeviceEntry{
+			DeviceId:   deviceID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"is_enabled"}},
+			Update:     &pb.DeviceUpdates{IsEnabled: false},
+		}
+	}
+
+	response, err := gClient.BatchUpdateDevices(gCtx, &pb.BatchUpdateDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Devices: []*pb.BatchUpdateDeviceEntry{
+			disable(deviceIDs[0]),
+			disable(deviceIDs[1]),
+			disable(uuid.NewString()),
+			{DeviceId: deviceIDs[0]},
+		},
+	})
+	if err != nil {
+		t.Errorf("TestBatchUpdateDevices: BatchUpdateDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(response.Results), 4)
+	if len(response.Results) != 4 {
+		return
+	}
+	assertEqual(t, response.Results[0].Status, uint32(codes.OK))
+	assertEqual(t, response.Results[1].Status, uint32(codes.OK))
+	assertEqual(t, response.Results[2].Status, uint32(codes.NotFound))
+	assertEqual(t, response.Results[3].Status, uint32(codes.InvalidArgument))
+
+	// Verify the devices were disabled.
+	getResponse, err := gClient.GetDevice(gCtx, &pb.GetDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		DeviceId: deviceIDs[1],
+	})
+	if err != nil {
+		t.Errorf("TestBatchUpdateDevices: GetDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, getResponse.Device.IsEnabled, false)
+}
+
+func TestBatchCreateDevices_TooManyDevices(t *testing.T) {
+	entries := make([]*pb.BatchCreateDeviceEntry, 1001)
+	for i := range entries {
+		entries[i] = &pb.BatchCreateDeviceEntry{DeviceId: uuid.NewString()}
+	}
+
+	response, err := gClient.BatchCreateDevices(gCtx, &pb.BatchCreateDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     testTenantID,
+		Devices: entries,
+	})
+	if err != nil {
+		t.Errorf("TestBatchCreateDevices_TooManyDevices: BatchCreateDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+}
+
+func TestBatchCreateDevicesStream_TooManyDevices(t *testing.T) {
+	tenantID := uuid.NewString()
+	stream, err := gClient.BatchCreateDevicesStream(gCtx)
+	if err != nil {
+		t.Errorf("TestBatchCreateDevicesStream_TooManyDevices: BatchCreateDevicesStream RPC failed %v", err)
+		return
+	}
+
+	// Each batch is within the limit, but the total number of devices in the
+	// stream exceeds it.
+	entries := newTestBatchCreateDeviceEntries(t, tenantID, 2)
+	if entries == nil {
+		return
+	}
+	largeEntries := make([]*pb.BatchCreateDeviceEntry, 999)
+	for i := range largeEntries {
+		largeEntries[i] = &pb.BatchCreateDeviceEntry{DeviceId: uuid.NewString()}
+	}
+
+	for _, batch := range [][]*pb.BatchCreateDeviceEntry{entries, largeEntries} {
+		err = stream.Send(&pb.BatchCreateDevicesRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			Devices: batch,
+		})
+		if err != nil {
+			t.Errorf("TestBatchCreateDevicesStream_TooManyDevices: failed to send batch %v", err)
+			return
+		}
+	}
+
+	// The results of the batch created before the limit was exceeded are
+	// returned.
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Errorf("TestBatchCreateDevicesStream_TooManyDevices: failed to receive response %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+	assertEqual(t, len(response.Results), 2)
+	for _, result := range response.Results {
+		assertEqual(t, result.Status, uint32(codes.OK))
+	}
+}
@@ -0,0 +1,216 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BatchUpdateDevices - apply a batch of updates to devices in the tenant. The
+// result for each device is returned in the response; devices that could not
+// be updated do not fail the rest of the batch.
+func (s *DeviceSTSServer) BatchUpdateDevices(ctx context.Context,
+	request *pb.BatchUpdateDevicesRequest) (*pb.BatchUpdateDevicesResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return batchUpdateDevicesErrorResponse(requestID, codes.InvalidArgument,
+			nil), nil
+	}
+
+	results, code := updateDeviceBatch(requestID, request)
+	if code != codes.OK {
+		return batchUpdateDevicesErrorResponse(requestID, code, nil), nil
+	}
+	return successBatchUpdateDevicesResponse(requestID, results), nil
+}
+
+// BatchUpdateDevicesStream - apply device updates in batches streamed by the
+// caller, so that large batches can be sent as a series of smaller messages.
+// Each batch is applied as it is received. The total number of devices in the
+// stream is limited to the maximum size of a BatchUpdateDevices request. If a
+// batch cannot be processed, the results of the batches already applied are
+// returned along with the error.
+func (s *DeviceSTSServer) BatchUpdateDevicesStream(
+	stream pb.DeviceSTS_BatchUpdateDevicesStreamServer) error {
+	var (
+		requestID string
+		results   []*pb.BatchDeviceResult
+	)
+
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// Validate the request header and extract the request identifier for
+		// end-to-end request tracing.
+		batchRequestID, ok := isValidRequestHeader(request.Header)
+		if !ok {
+			dstsLogger.Error("Invalid request header specified!")
+			return stream.SendAndClose(batchUpdateDevicesErrorResponse(requestID,
+				codes.InvalidArgument, results))
+		}
+		if requestID == "" {
+			requestID = batchRequestID
+		}
+
+		// Results are accumulated until the stream completes, so the number
+		// of devices in the stream is bounded.
+		if len(results)+len(request.Devices) > db.MaxDeviceBatchSize {
+			dstsLogger.Error("Too many devices were specified in the stream",
+				zap.String("Request ID", batchRequestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Int("Number of devices", len(results)+len(request.Devices)),
+			)
+			return stream.SendAndClose(batchUpdateDevicesErrorResponse(requestID,
+				codes.InvalidArgument, results))
+		}
+
+		batchResults, code := updateDeviceBatch(batchRequestID, request)
+		if code != codes.OK {
+			return stream.SendAndClose(batchUpdateDevicesErrorResponse(requestID,
+				code, results))
+		}
+		results = append(results, batchResults...)
+	}
+
+	return stream.SendAndClose(successBatchUpdateDevicesResponse(requestID,
+		results))
+}
+
+// Validate the device updates in the batch and apply them in the database.
+// Returns the result for each device, or the status code if the batch could
+// not be processed.
+func updateDeviceBatch(requestID string,
+	request *pb.BatchUpdateDevicesRequest) ([]*pb.BatchDeviceResult, codes.Code) {
+	// Ensure the request specified a tenant ID and a valid number of updates.
+	if (request.Tid == "") || (len(request.Devices) == 0) ||
+		(len(request.Devices) > db.MaxDeviceBatchSize) {
+		dstsLogger.Error("Tenant ID or device updates were not specified, or too many updates were specified",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Int("Number of devices", len(request.Devices)),
+		)
+		return nil, codes.InvalidArgument
+	}
+
+	results := make([]*pb.BatchDeviceResult, len(request.Devices))
+	updates := []db.DeviceUpdate{}
+	indexes := []int{}
+	for i, entry := range request.Devices {
+		if entry.DeviceId == "" {
+			results[i] = newBatchDeviceResult(entry.DeviceId,
+				codes.InvalidArgument, "Device ID was not specified")
+			continue
+		}
+
+		updateMap, ok := newDeviceUpdateMap(requestID, request.Tid,
+			entry.DeviceId, entry.GetUpdateMask(), entry.Update)
+		if !ok {
+			results[i] = newBatchDeviceResult(entry.DeviceId,
+				codes.InvalidArgument, "Invalid device update")
+			continue
+		}
+
+		updates = append(updates, db.DeviceUpdate{
+			DeviceId:  entry.DeviceId,
+			UpdateMap: updateMap,
+		})
+		indexes = append(indexes, i)
+	}
+
+	// Apply the valid updates in the database.
+	if len(updates) != 0 {
+		deviceErrors, err := db.UpdateDevices(requestID, request.Tid, updates)
+		if err != nil {
+			dstsLogger.Error("Failed to apply the batch of device updates!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Error(err),
+			)
+			if errors.Is(err, db.ErrDatabaseBusy) {
+				return nil, codes.ResourceExhausted
+			}
+			return nil, codes.Internal
+		}
+
+		for j, i := range indexes {
+			deviceID := request.Devices[i].DeviceId
+			switch {
+			case deviceErrors[j] == nil:
+				results[i] = newBatchDeviceResult(deviceID, codes.OK,
+					"Device updated")
+			case errors.Is(deviceErrors[j], db.ErrNotFound):
+				results[i] = newBatchDeviceResult(deviceID, codes.NotFound,
+					"Device not found")
+			case errors.Is(deviceErrors[j], db.ErrInvalidRequest):
+				results[i] = newBatchDeviceResult(deviceID, codes.InvalidArgument,
+					"Invalid device update")
+			default:
+				results[i] = newBatchDeviceResult(deviceID, codes.Internal,
+					"Failed to update device")
+			}
+		}
+	}
+
+	return results, codes.OK
+}
+
+func successBatchUpdateDevicesResponse(requestID string,
+	results []*pb.BatchDeviceResult) *pb.BatchUpdateDevicesResponse {
+	response := &pb.BatchUpdateDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "BatchUpdateDevices RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Results: results,
+	}
+
+	metrics.MetricDevicesBatchUpdated.Inc()
+	return response
+}
+
+// Results of batches that were already processed by a streaming request are
+// included in the error response.
+func batchUpdateDevicesErrorResponse(requestID string, code codes.Code,
+	results []*pb.BatchDeviceResult) *pb.BatchUpdateDevicesResponse {
+	response := &pb.BatchUpdateDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "BatchUpdateDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Results: results,
+	}
+
+	if code == codes.InvalidArgument {
+		metrics.MetricBatchUpdateDevicesBadRequests.Inc()
+	} else {
+		metrics.MetricBatchUpdateDevicesInternalErrors.Inc()
+	}
+	return response
+}
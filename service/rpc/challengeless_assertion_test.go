@@ -0,0 +1,193 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// Create a client assertion that does not carry a DSTS issued challenge.
+func newChallengelessAssertion(t *testing.T, deviceID string,
+	deviceCert []byte, pKey *rsa.PrivateKey, jti string, issuedAt time.Time,
+	expiresAt time.Time) string {
+	claims := sts.AssertionClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    deviceID,
+			Subject:   deviceID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ID:        jti,
+		},
+	}
+	assertionToken := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
+	assertionToken.Header["x5c"] = []string{base64.StdEncoding.EncodeToString(deviceCert)}
+	assertion, err := assertionToken.SignedString(pKey)
+	if err != nil {
+		t.Errorf("Failed to generate signed client assertion: %v", err)
+		return ""
+	}
+	return assertion
+}
+
+// Create a device token request presenting the client assertion.
+func newClientAssertionTokenRequest(assertion string) *http.Request {
+	data := url.Values{}
+	data.Set(paramClientAssertionType, sts.ClientAssertionType)
+	data.Set(paramClientAssertion, assertion)
+
+	tokenReq, _ := http.NewRequest(http.MethodPost, gTokenURL,
+		strings.NewReader(data.Encode()))
+	tokenReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return tokenReq
+}
+
+// Present a challenge-less client assertion and return the response code.
+func doChallengelessAuthentication(t *testing.T, deviceID string,
+	deviceCert []byte, pKey *rsa.PrivateKey, jti string, issuedAt time.Time,
+	expiresAt time.Time) int {
+	assertion := newChallengelessAssertion(t, deviceID, deviceCert, pKey,
+		jti, issuedAt, expiresAt)
+	if assertion == "" {
+		return 0
+	}
+	return rest.ExecuteTestRequest(newClientAssertionTokenRequest(assertion),
+		rest.DeviceAuthenticationHandler).Code
+}
+
+func TestGetToken_Challengeless(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t,
+		testChallengelessTenantID, "")
+	if deviceCert == nil {
+		return
+	}
+
+	// The device is issued a device access token without requesting a
+	// challenge.
+	assertion := newChallengelessAssertion(t, deviceID, deviceCert, pKey,
+		uuid.NewString(), time.Now(), time.Now().Add(time.Minute*2))
+	if assertion == "" {
+		return
+	}
+	tokenResponse := rest.ExecuteTestRequest(
+		newClientAssertionTokenRequest(assertion),
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	if tokenResponse.Code != http.StatusOK {
+		return
+	}
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	claims, err := sts.VerifyDeviceAccessToken("test", token.AccessToken)
+	if err != nil {
+		t.Errorf("TestGetToken_Challengeless: failed to verify device access token: %v", err)
+		return
+	}
+	assertEqual(t, claims.Subject, deviceID)
+
+	// The challenge flow remains supported for the device.
+	tokenResponse = doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+}
+
+func TestGetToken_ChallengelessReplay(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t,
+		testChallengelessTenantID, "")
+	if deviceCert == nil {
+		return
+	}
+
+	// The assertion is accepted once.
+	assertion := newChallengelessAssertion(t, deviceID, deviceCert, pKey,
+		uuid.NewString(), time.Now(), time.Now().Add(time.Minute*2))
+	if assertion == "" {
+		return
+	}
+	tokenResponse := rest.ExecuteTestRequest(
+		newClientAssertionTokenRequest(assertion),
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	tokenResponse = rest.ExecuteTestRequest(
+		newClientAssertionTokenRequest(assertion),
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusUnauthorized, tokenResponse.Code)
+
+	// A new assertion reusing the jti of an accepted assertion is rejected.
+	jti := uuid.NewString()
+	code := doChallengelessAuthentication(t, deviceID, deviceCert, pKey, jti,
+		time.Now(), time.Now().Add(time.Minute*2))
+	checkResponseCode(t, http.StatusOK, code)
+	code = doChallengelessAuthentication(t, deviceID, deviceCert, pKey, jti,
+		time.Now(), time.Now().Add(time.Minute*3))
+	checkResponseCode(t, http.StatusUnauthorized, code)
+
+	// The jti is only required to be unique for the device.
+	otherCert, otherID, otherKey := createTestManagedDevice(t,
+		testChallengelessTenantID, "")
+	if otherCert == nil {
+		return
+	}
+	code = doChallengelessAuthentication(t, otherID, otherCert, otherKey, jti,
+		time.Now(), time.Now().Add(time.Minute*2))
+	checkResponseCode(t, http.StatusOK, code)
+}
+
+func TestGetToken_ChallengelessInvalidAssertion(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t,
+		testChallengelessTenantID, "")
+	if deviceCert == nil {
+		return
+	}
+
+	tests := []struct {
+		name      string
+		jti       string
+		issuedAt  time.Time
+		expiresAt time.Time
+	}{
+		{"missing jti", "", time.Now(), time.Now().Add(time.Minute * 2)},
+		{"lifetime too long", uuid.NewString(), time.Now(),
+			time.Now().Add(time.Minute * 10)},
+		{"issued too long ago", uuid.NewString(),
+			time.Now().Add(-time.Minute * 6), time.Now().Add(time.Minute)},
+		{"expired", uuid.NewString(), time.Now().Add(-time.Minute * 3),
+			time.Now().Add(-time.Minute)},
+	}
+
+	for _, test := range tests {
+		code := doChallengelessAuthentication(t, deviceID, deviceCert, pKey,
+			test.jti, test.issuedAt, test.expiresAt)
+		if code != http.StatusUnauthorized {
+			t.Errorf("TestGetToken_ChallengelessInvalidAssertion: %s: expected status %d, got %d",
+				test.name, http.StatusUnauthorized, code)
+		}
+	}
+}
+
+func TestGetToken_ChallengelessNotEnabled(t *testing.T) {
+	// Devices of tenants without challenge-less authentication enabled must
+	// present the challenge issued by the DSTS.
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, uuid.NewString(), "")
+	if deviceCert == nil {
+		return
+	}
+
+	code := doChallengelessAuthentication(t, deviceID, deviceCert, pKey,
+		uuid.NewString(), time.Now(), time.Now().Add(time.Minute*2))
+	if code == http.StatusOK {
+		t.Errorf("TestGetToken_ChallengelessNotEnabled: challenge-less assertion was accepted")
+	}
+}
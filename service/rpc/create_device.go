@@ -39,42 +39,23 @@ func (s *DeviceSTSServer) CreateDevice(ctx context.Context,
 		return response, nil
 	}
 
-	if request.DeviceCertificate == nil {
-		dstsLogger.Error("Device certificate was not provided",
-			zap.String("Request ID", requestID),
-		)
-		response := invalidCreateDeviceResponse(requestID)
-		return response, nil
-	}
-
-	// Parse the provided device certificate.
-	deviceCert, err := common.ParseCertificate(request.DeviceCertificate)
+	// Parse and validate the provided device certificate.
+	deviceCert, err := parseDeviceCertificate(requestID, request.Tid,
+		request.DeviceId, request.DeviceCertificate)
 	if err != nil {
-		dstsLogger.Error("Failed to parse the provided device certificate",
-			zap.String("Request ID:", requestID),
-			zap.Error(err),
-		)
 		response := invalidCreateDeviceResponse(requestID)
 		return response, nil
 	}
 
-	// Perform some validation checks on the provided certificate.
-	err = common.VerifyCertificate(deviceCert)
+	// Validate the labels and metadata to be attached to the device.
+	err = db.ValidateDeviceLabels(request.Labels, request.Metadata)
 	if err != nil {
-		dstsLogger.Error("Verification checks failed for the device certificate",
+		dstsLogger.Error("Invalid device labels or metadata were specified",
 			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
 			zap.Error(err),
 		)
-		response := invalidCreateDeviceResponse(requestID)
-		return response, nil
-	}
-	if !common.VerifyDeviceIDInCertificateCommonName(deviceCert, request.DeviceId) {
-		dstsLogger.Error("Device ID specified in the request doesn't match that in the device certificate",
-			zap.String("Request ID", requestID),
-			zap.Error(err),
-		)
-		response := invalidCreateDeviceResponse(requestID)
-		return response, nil
+		return invalidCreateDeviceResponse(requestID), nil
 	}
 
 	// Generate a SHA256 hash which serves as the certificate thumbprint. Set
@@ -92,6 +73,8 @@ func (s *DeviceSTSServer) CreateDevice(ctx context.Context,
 		UpdatedAt:             time.Now(),
 		ServiceId:             request.ManagementService,
 		HardwareHash:          request.HardwareHash,
+		Labels:                request.Labels,
+		Metadata:              request.Metadata,
 	}
 
 	// Invoke the DB APIs to create a device object and store information
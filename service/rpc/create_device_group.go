@@ -0,0 +1,149 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) CreateDeviceGroup(ctx context.Context,
+	request *pb.CreateDeviceGroupRequest) (*pb.CreateDeviceGroupResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidCreateDeviceGroupResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and the group name.
+	if (request.Tid == "") || (request.Name == "") {
+		dstsLogger.Error("Tenant ID or group name were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidCreateDeviceGroupResponse(requestID), nil
+	}
+
+	group, err := db.NewDeviceGroup(request.Tid, request.Name,
+		request.Description)
+	if err != nil {
+		dstsLogger.Error("Invalid device group specified!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		return invalidCreateDeviceGroupResponse(requestID), nil
+	}
+
+	err = group.AddDeviceGroup(requestID)
+	if err != nil {
+		dstsLogger.Error("Failed to create the device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Group name", group.Name),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrDuplicateEntry) {
+			return alreadyExistsCreateDeviceGroupResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrNotAllowed) {
+			return groupLimitCreateDeviceGroupResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyCreateDeviceGroupResponse(requestID), nil
+		}
+		return internalErrorCreateDeviceGroupResponse(requestID), nil
+	}
+
+	return successCreateDeviceGroupResponse(requestID, group), nil
+}
+
+func invalidCreateDeviceGroupResponse(requestID string) *pb.CreateDeviceGroupResponse {
+	metrics.MetricCreateDeviceGroupBadRequests.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "CreateDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successCreateDeviceGroupResponse(requestID string, group *db.DeviceGroup) *pb.CreateDeviceGroupResponse {
+	metrics.MetricDeviceGroupCreated.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "CreateDeviceGroup RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Group: newDeviceGroup(group),
+	}
+}
+
+func alreadyExistsCreateDeviceGroupResponse(requestID string) *pb.CreateDeviceGroupResponse {
+	metrics.MetricCreateDeviceGroupAlreadyExistsErrors.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.AlreadyExists),
+			StatusMessage:   "CreateDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func groupLimitCreateDeviceGroupResponse(requestID string) *pb.CreateDeviceGroupResponse {
+	metrics.MetricCreateDeviceGroupBadRequests.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.FailedPrecondition),
+			StatusMessage:   "CreateDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyCreateDeviceGroupResponse(requestID string) *pb.CreateDeviceGroupResponse {
+	metrics.MetricCreateDeviceGroupInternalErrors.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "CreateDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorCreateDeviceGroupResponse(requestID string) *pb.CreateDeviceGroupResponse {
+	metrics.MetricCreateDeviceGroupInternalErrors.Inc()
+	return &pb.CreateDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "CreateDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
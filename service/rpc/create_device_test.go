@@ -194,3 +194,32 @@ func TestCreateDevice_ValidHardwareHash(t *testing.T) {
 	dstsLogger.Info("Response from device STS:",
 		zap.Any("Response:", response))
 }
+
+func TestCreateDevice_InvalidLabels(t *testing.T) {
+	for _, labels := range []map[string]string{
+		{"-site": "bristol"},
+		{"site": "bristol city"},
+		{"Example.com/ring": "canary"},
+	} {
+		deviceCert, deviceID, _, err := createTestDeviceCertificate(testTenantID,
+			testTenantName, "")
+		if err != nil {
+			t.Errorf("TestCreateDevice_InvalidLabels: Failed to create test device certificate: %v", err)
+			return
+		}
+
+		response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+			Header:            newDstsProtocolHeader(),
+			Version:           DstsProtocolVersion,
+			Tid:               testTenantID,
+			DeviceId:          deviceID,
+			DeviceCertificate: deviceCert,
+			Labels:            labels,
+		})
+		if err != nil {
+			t.Errorf("TestCreateDevice_InvalidLabels: RPC failed %v", err)
+			return
+		}
+		assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+	}
+}
@@ -0,0 +1,167 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) CreateTokenPolicy(ctx context.Context,
+	request *pb.CreateTokenPolicyRequest) (*pb.CreateTokenPolicyResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidCreateTokenPolicyResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and the policy.
+	if (request.Tid == "") || (request.Policy == nil) {
+		dstsLogger.Error("Tenant ID or token policy were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidCreateTokenPolicyResponse(requestID), nil
+	}
+
+	policy, err := db.NewTokenPolicy(request.Tid, request.Policy.Name,
+		request.Policy.Description, request.Policy.Expression,
+		request.Policy.IsEnabled, request.Policy.GroupId)
+	if err != nil {
+		dstsLogger.Error("Invalid token policy specified!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		return invalidCreateTokenPolicyResponse(requestID), nil
+	}
+
+	// Ensure the policy expression compiles and evaluates to a bool.
+	err = sts.ValidateTokenPolicyExpression(policy.Expression)
+	if err != nil {
+		dstsLogger.Error("Invalid token policy expression specified!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Policy name", policy.Name),
+			zap.Error(err),
+		)
+		return invalidCreateTokenPolicyResponse(requestID), nil
+	}
+
+	err = policy.AddTokenPolicy(requestID)
+	if err != nil {
+		dstsLogger.Error("Failed to create the token policy!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Policy name", policy.Name),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrDuplicateEntry) {
+			return alreadyExistsCreateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrInvalidRequest) {
+			// The device group to which the policy is assigned was not found.
+			return invalidCreateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrNotAllowed) {
+			return policyLimitCreateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyCreateTokenPolicyResponse(requestID), nil
+		}
+		return internalErrorCreateTokenPolicyResponse(requestID), nil
+	}
+
+	return successCreateTokenPolicyResponse(requestID, policy), nil
+}
+
+func invalidCreateTokenPolicyResponse(requestID string) *pb.CreateTokenPolicyResponse {
+	metrics.MetricCreateTokenPolicyBadRequests.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "CreateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successCreateTokenPolicyResponse(requestID string, policy *db.TokenPolicy) *pb.CreateTokenPolicyResponse {
+	metrics.MetricTokenPolicyCreated.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "CreateTokenPolicy RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Policy: newTokenPolicy(policy),
+	}
+}
+
+func alreadyExistsCreateTokenPolicyResponse(requestID string) *pb.CreateTokenPolicyResponse {
+	metrics.MetricCreateTokenPolicyAlreadyExistsErrors.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.AlreadyExists),
+			StatusMessage:   "CreateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func policyLimitCreateTokenPolicyResponse(requestID string) *pb.CreateTokenPolicyResponse {
+	metrics.MetricCreateTokenPolicyBadRequests.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.FailedPrecondition),
+			StatusMessage:   "CreateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyCreateTokenPolicyResponse(requestID string) *pb.CreateTokenPolicyResponse {
+	metrics.MetricCreateTokenPolicyInternalErrors.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "CreateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorCreateTokenPolicyResponse(requestID string) *pb.CreateTokenPolicyResponse {
+	metrics.MetricCreateTokenPolicyInternalErrors.Inc()
+	return &pb.CreateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "CreateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,98 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) DeleteDeviceGroup(ctx context.Context,
+	request *pb.DeleteDeviceGroupRequest) (*pb.DeleteDeviceGroupResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return deleteDeviceGroupErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a tenant ID and group ID.
+	if (request.Tid == "") || (request.GroupId == "") {
+		dstsLogger.Error("Tenant ID or group ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return deleteDeviceGroupErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	err := db.DeleteDeviceGroup(requestID, request.Tid, request.GroupId)
+	if err != nil {
+		dstsLogger.Error("Failed to delete the specified device group!",
+			zap.String("Request ID", requestID),
+			zap.String("Group ID", request.GroupId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return deleteDeviceGroupErrorResponse(requestID, codes.NotFound), nil
+		}
+		if errors.Is(err, db.ErrNotAllowed) {
+			// Token policies are still assigned to the group.
+			return deleteDeviceGroupErrorResponse(requestID,
+				codes.FailedPrecondition), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return deleteDeviceGroupErrorResponse(requestID,
+				codes.ResourceExhausted), nil
+		}
+		return deleteDeviceGroupErrorResponse(requestID, codes.Internal), nil
+	}
+
+	return successDeleteDeviceGroupResponse(requestID), nil
+}
+
+func successDeleteDeviceGroupResponse(requestID string) *pb.DeleteDeviceGroupResponse {
+	metrics.MetricDeviceGroupDeleted.Inc()
+	return &pb.DeleteDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "DeleteDeviceGroup RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		DeleteTime: timestamppb.Now(),
+	}
+}
+
+func deleteDeviceGroupErrorResponse(requestID string,
+	code codes.Code) *pb.DeleteDeviceGroupResponse {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		metrics.MetricDeleteDeviceGroupBadRequests.Inc()
+	case codes.NotFound:
+		metrics.MetricDeleteDeviceGroupNotFoundErrors.Inc()
+	default:
+		metrics.MetricDeleteDeviceGroupInternalErrors.Inc()
+	}
+
+	return &pb.DeleteDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "DeleteDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,122 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) DeleteTokenPolicy(ctx context.Context,
+	request *pb.DeleteTokenPolicyRequest) (*pb.DeleteTokenPolicyResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidDeleteTokenPolicyResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and policy ID.
+	if (request.Tid == "") || (request.PolicyId == "") {
+		dstsLogger.Error("Tenant ID or policy ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidDeleteTokenPolicyResponse(requestID), nil
+	}
+
+	err := db.DeleteTokenPolicy(requestID, request.Tid, request.PolicyId)
+	if err != nil {
+		dstsLogger.Error("Failed to delete the specified token policy!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundDeleteTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyDeleteTokenPolicyResponse(requestID), nil
+		}
+		return internalErrorDeleteTokenPolicyResponse(requestID), nil
+	}
+
+	return successDeleteTokenPolicyResponse(requestID), nil
+}
+
+func invalidDeleteTokenPolicyResponse(requestID string) *pb.DeleteTokenPolicyResponse {
+	metrics.MetricDeleteTokenPolicyBadRequests.Inc()
+	return &pb.DeleteTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "DeleteTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successDeleteTokenPolicyResponse(requestID string) *pb.DeleteTokenPolicyResponse {
+	metrics.MetricTokenPolicyDeleted.Inc()
+	return &pb.DeleteTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "DeleteTokenPolicy RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		DeleteTime: timestamppb.Now(),
+	}
+}
+
+func notFoundDeleteTokenPolicyResponse(requestID string) *pb.DeleteTokenPolicyResponse {
+	metrics.MetricDeleteTokenPolicyNotFoundErrors.Inc()
+	return &pb.DeleteTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "DeleteTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyDeleteTokenPolicyResponse(requestID string) *pb.DeleteTokenPolicyResponse {
+	metrics.MetricDeleteTokenPolicyInternalErrors.Inc()
+	return &pb.DeleteTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "DeleteTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorDeleteTokenPolicyResponse(requestID string) *pb.DeleteTokenPolicyResponse {
+	metrics.MetricDeleteTokenPolicyInternalErrors.Inc()
+	return &pb.DeleteTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "DeleteTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/x509"
+	"errors"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDeviceCertificateNotProvided = errors.New("device certificate was not provided")
+	ErrDeviceIDMismatch             = errors.New("device ID doesn't match the device certificate")
+	ErrTenantIDMismatch             = errors.New("tenant ID doesn't match the device certificate")
+)
+
+// parseDeviceCertificate - parse the device certificate provided by the caller
+// and perform some validation checks on it, including checking it was issued
+// to the specified device.
+func parseDeviceCertificate(requestID string, tenantID string, deviceID string,
+	certBytes []byte) (*x509.Certificate, error) {
+	if certBytes == nil {
+		dstsLogger.Error("Device certificate was not provided",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+		)
+		return nil, ErrDeviceCertificateNotProvided
+	}
+
+	// Parse the provided device certificate.
+	deviceCert, err := common.ParseCertificate(certBytes)
+	if err != nil {
+		dstsLogger.Error("Failed to parse the provided device certificate",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Perform some validation checks on the provided certificate.
+	err = common.VerifyCertificate(deviceCert)
+	if err != nil {
+		dstsLogger.Error("Verification checks failed for the device certificate",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	if !common.VerifyDeviceIDInCertificateCommonName(deviceCert, deviceID) {
+		dstsLogger.Error("Device ID specified in the request doesn't match that in the device certificate",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
+		)
+		return nil, ErrDeviceIDMismatch
+	}
+	return deviceCert, nil
+}
@@ -0,0 +1,195 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddDeviceGroupMembers - add devices in the tenant to the device group. The
+// result for each device is returned in the response; devices that could not
+// be added do not fail the rest of the request.
+func (s *DeviceSTSServer) AddDeviceGroupMembers(ctx context.Context,
+	request *pb.UpdateDeviceGroupMembersRequest) (*pb.UpdateDeviceGroupMembersResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return addDeviceGroupMembersErrorResponse(requestID,
+			codes.InvalidArgument), nil
+	}
+
+	results, code := updateDeviceGroupMembers(requestID, request,
+		db.AddDeviceGroupMembers, "Device added to group")
+	if code != codes.OK {
+		return addDeviceGroupMembersErrorResponse(requestID, code), nil
+	}
+
+	metrics.MetricDeviceGroupMembersAdded.Inc()
+	return successUpdateDeviceGroupMembersResponse(requestID,
+		"AddDeviceGroupMembers RPC successful", results), nil
+}
+
+// RemoveDeviceGroupMembers - remove devices from the device group. The result
+// for each device is returned in the response; devices that are not members
+// of the group do not fail the rest of the request.
+func (s *DeviceSTSServer) RemoveDeviceGroupMembers(ctx context.Context,
+	request *pb.UpdateDeviceGroupMembersRequest) (*pb.UpdateDeviceGroupMembersResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return removeDeviceGroupMembersErrorResponse(requestID,
+			codes.InvalidArgument), nil
+	}
+
+	results, code := updateDeviceGroupMembers(requestID, request,
+		db.RemoveDeviceGroupMembers, "Device removed from group")
+	if code != codes.OK {
+		return removeDeviceGroupMembersErrorResponse(requestID, code), nil
+	}
+
+	metrics.MetricDeviceGroupMembersRemoved.Inc()
+	return successUpdateDeviceGroupMembersResponse(requestID,
+		"RemoveDeviceGroupMembers RPC successful", results), nil
+}
+
+// Validate the request and apply the membership update in the database.
+// Returns the result for each device, or the status code if the request could
+// not be processed.
+func updateDeviceGroupMembers(requestID string,
+	request *pb.UpdateDeviceGroupMembersRequest,
+	update func(string, string, string, []string) ([]error, error),
+	successMessage string) ([]*pb.BatchDeviceResult, codes.Code) {
+	// Ensure the request specified a tenant ID, group ID and a valid number
+	// of devices.
+	if (request.Tid == "") || (request.GroupId == "") ||
+		(len(request.DeviceIds) == 0) ||
+		(len(request.DeviceIds) > db.MaxDeviceBatchSize) {
+		dstsLogger.Error("Tenant ID, group ID or devices were not specified, or too many devices were specified",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Group ID", request.GroupId),
+			zap.Int("Number of devices", len(request.DeviceIds)),
+		)
+		return nil, codes.InvalidArgument
+	}
+
+	results := make([]*pb.BatchDeviceResult, len(request.DeviceIds))
+	deviceIDs := []string{}
+	indexes := []int{}
+	for i, deviceID := range request.DeviceIds {
+		if deviceID == "" {
+			results[i] = newBatchDeviceResult(deviceID, codes.InvalidArgument,
+				"Device ID was not specified")
+			continue
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+		indexes = append(indexes, i)
+	}
+
+	if len(deviceIDs) != 0 {
+		deviceErrors, err := update(requestID, request.Tid, request.GroupId,
+			deviceIDs)
+		if err != nil {
+			dstsLogger.Error("Failed to update the members of the device group!",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.String("Group ID", request.GroupId),
+				zap.Error(err),
+			)
+			if errors.Is(err, db.ErrNotFound) {
+				return nil, codes.NotFound
+			}
+			if errors.Is(err, db.ErrDatabaseBusy) {
+				return nil, codes.ResourceExhausted
+			}
+			return nil, codes.Internal
+		}
+
+		for j, i := range indexes {
+			switch {
+			case deviceErrors[j] == nil:
+				results[i] = newBatchDeviceResult(deviceIDs[j], codes.OK,
+					successMessage)
+			case errors.Is(deviceErrors[j], db.ErrNotFound):
+				results[i] = newBatchDeviceResult(deviceIDs[j], codes.NotFound,
+					"Device not found")
+			default:
+				results[i] = newBatchDeviceResult(deviceIDs[j], codes.Internal,
+					"Failed to update group membership")
+			}
+		}
+	}
+
+	return results, codes.OK
+}
+
+func successUpdateDeviceGroupMembersResponse(requestID string, message string,
+	results []*pb.BatchDeviceResult) *pb.UpdateDeviceGroupMembersResponse {
+	return &pb.UpdateDeviceGroupMembersResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   message,
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Results: results,
+	}
+}
+
+func addDeviceGroupMembersErrorResponse(requestID string,
+	code codes.Code) *pb.UpdateDeviceGroupMembersResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricAddDeviceGroupMembersBadRequests.Inc()
+	case codes.NotFound:
+		metrics.MetricAddDeviceGroupMembersNotFoundErrors.Inc()
+	default:
+		metrics.MetricAddDeviceGroupMembersInternalErrors.Inc()
+	}
+	return updateDeviceGroupMembersErrorResponse(requestID, code,
+		"AddDeviceGroupMembers RPC failed")
+}
+
+func removeDeviceGroupMembersErrorResponse(requestID string,
+	code codes.Code) *pb.UpdateDeviceGroupMembersResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricRemoveDeviceGroupMembersBadRequests.Inc()
+	case codes.NotFound:
+		metrics.MetricRemoveDeviceGroupMembersNotFoundErrors.Inc()
+	default:
+		metrics.MetricRemoveDeviceGroupMembersInternalErrors.Inc()
+	}
+	return updateDeviceGroupMembersErrorResponse(requestID, code,
+		"RemoveDeviceGroupMembers RPC failed")
+}
+
+func updateDeviceGroupMembersErrorResponse(requestID string, code codes.Code,
+	message string) *pb.UpdateDeviceGroupMembersResponse {
+	return &pb.UpdateDeviceGroupMembersResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   message,
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
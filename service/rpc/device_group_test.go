@@ -0,0 +1,198 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// List the devices in the tenant that are members of the device group.
+func listTestDeviceGroupMembers(t *testing.T, tenantID string,
+	groupID string) []*pb.Device {
+	response, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Filter:  fmt.Sprintf(`group_id = "%s"`, groupID),
+	})
+	if err != nil {
+		t.Errorf("ListDevices RPC failed %v", err)
+		return nil
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	return response.Devices
+}
+
+func TestDeviceGroupLifecycle(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 3)
+	if deviceIDs == nil {
+		return
+	}
+
+	createResponse, err := gClient.CreateDeviceGroup(gCtx,
+		&pb.CreateDeviceGroupRequest{
+			Header:      newDstsProtocolHeader(),
+			Version:     DstsProtocolVersion,
+			Tid:         tenantID,
+			Name:        "Kiosks",
+			Description: "Devices deployed as kiosks",
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: CreateDeviceGroup RPC failed %v", err)
+		return
+	}
+	assertEqual(t, createResponse.Header.Status, uint32(codes.OK))
+	groupID := createResponse.Group.GroupId
+
+	// A second group with the same name cannot be created in the tenant.
+	duplicateResponse, err := gClient.CreateDeviceGroup(gCtx,
+		&pb.CreateDeviceGroupRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			Name:    "Kiosks",
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: CreateDeviceGroup RPC failed %v", err)
+		return
+	}
+	assertEqual(t, duplicateResponse.Header.Status, uint32(codes.AlreadyExists))
+
+	// Add two of the devices and an unknown device to the group.
+	addResponse, err := gClient.AddDeviceGroupMembers(gCtx,
+		&pb.UpdateDeviceGroupMembersRequest{
+			Header:    newDstsProtocolHeader(),
+			Version:   DstsProtocolVersion,
+			Tid:       tenantID,
+			GroupId:   groupID,
+			DeviceIds: []string{deviceIDs[0], deviceIDs[1], uuid.NewString()},
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: AddDeviceGroupMembers RPC failed %v", err)
+		return
+	}
+	assertEqual(t, addResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(addResponse.Results), 3)
+	if len(addResponse.Results) == 3 {
+		assertEqual(t, addResponse.Results[0].Status, uint32(codes.OK))
+		assertEqual(t, addResponse.Results[1].Status, uint32(codes.OK))
+		assertEqual(t, addResponse.Results[2].Status, uint32(codes.NotFound))
+	}
+
+	getResponse, err := gClient.GetDeviceGroup(gCtx, &pb.GetDeviceGroupRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		GroupId: groupID,
+	})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: GetDeviceGroup RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, getResponse.Group.MemberCount, int64(2))
+	assertEqual(t, len(listTestDeviceGroupMembers(t, tenantID, groupID)), 2)
+
+	// Disable all members of the group.
+	actionResponse, err := gClient.ApplyDeviceGroupAction(gCtx,
+		&pb.ApplyDeviceGroupActionRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			GroupId: groupID,
+			Action:  pb.DeviceGroupAction_DEVICE_GROUP_ACTION_DISABLE,
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: ApplyDeviceGroupAction RPC failed %v", err)
+		return
+	}
+	assertEqual(t, actionResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, actionResponse.DevicesUpdated, int64(2))
+	for _, device := range listTestDeviceGroupMembers(t, tenantID, groupID) {
+		assertEqual(t, device.IsEnabled, false)
+	}
+
+	// Remove a device from the group.
+	removeResponse, err := gClient.RemoveDeviceGroupMembers(gCtx,
+		&pb.UpdateDeviceGroupMembersRequest{
+			Header:    newDstsProtocolHeader(),
+			Version:   DstsProtocolVersion,
+			Tid:       tenantID,
+			GroupId:   groupID,
+			DeviceIds: []string{deviceIDs[0], deviceIDs[2]},
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: RemoveDeviceGroupMembers RPC failed %v", err)
+		return
+	}
+	assertEqual(t, removeResponse.Header.Status, uint32(codes.OK))
+	if len(removeResponse.Results) == 2 {
+		assertEqual(t, removeResponse.Results[0].Status, uint32(codes.OK))
+		assertEqual(t, removeResponse.Results[1].Status, uint32(codes.NotFound))
+	}
+	assertEqual(t, len(listTestDeviceGroupMembers(t, tenantID, groupID)), 1)
+
+	listResponse, err := gClient.ListDeviceGroups(gCtx,
+		&pb.ListDeviceGroupsRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: ListDeviceGroups RPC failed %v", err)
+		return
+	}
+	assertEqual(t, listResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(listResponse.Groups), 1)
+
+	deleteResponse, err := gClient.DeleteDeviceGroup(gCtx,
+		&pb.DeleteDeviceGroupRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			GroupId: groupID,
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: DeleteDeviceGroup RPC failed %v", err)
+		return
+	}
+	assertEqual(t, deleteResponse.Header.Status, uint32(codes.OK))
+
+	// Actions cannot be applied to a group that no longer exists.
+	actionResponse, err = gClient.ApplyDeviceGroupAction(gCtx,
+		&pb.ApplyDeviceGroupActionRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+			GroupId: groupID,
+			Action:  pb.DeviceGroupAction_DEVICE_GROUP_ACTION_ENABLE,
+		})
+	if err != nil {
+		t.Errorf("TestDeviceGroupLifecycle: ApplyDeviceGroupAction RPC failed %v", err)
+		return
+	}
+	assertEqual(t, actionResponse.Header.Status, uint32(codes.NotFound))
+}
+
+func TestApplyDeviceGroupAction_Unspecified(t *testing.T) {
+	response, err := gClient.ApplyDeviceGroupAction(gCtx,
+		&pb.ApplyDeviceGroupActionRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     testTenantID,
+			GroupId: uuid.NewString(),
+		})
+	if err != nil {
+		t.Errorf("TestApplyDeviceGroupAction_Unspecified: ApplyDeviceGroupAction RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+}
@@ -0,0 +1,147 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/google/uuid"
+)
+
+var (
+	gMtlsTokenURL = "/api/v1/deviceauth/mtls/token"
+)
+
+// Request a device access token from the mTLS token endpoint, presenting the
+// specified client certificate at the TLS layer.
+func doDeviceMtlsAuthentication(clientCert *x509.Certificate) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, gMtlsTokenURL, nil)
+	if clientCert != nil {
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{clientCert},
+		}
+	}
+
+	return rest.ExecuteTestRequest(req, rest.DeviceMtlsAuthenticationHandler)
+}
+
+func TestGetToken_Mtls(t *testing.T) {
+	deviceCert, deviceID, _ := createTestManagedDevice(t, uuid.NewString(), "")
+	if deviceCert == nil {
+		return
+	}
+	clientCert, err := x509.ParseCertificate(deviceCert)
+	if err != nil {
+		t.Errorf("TestGetToken_Mtls: failed to parse device certificate: %v", err)
+		return
+	}
+
+	tokenResponse := doDeviceMtlsAuthentication(clientCert)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	if tokenResponse.Code != http.StatusOK {
+		return
+	}
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+
+	// The access token is bound to the presented device certificate.
+	claims, err := sts.VerifyDeviceAccessToken("test", token.AccessToken)
+	if err != nil {
+		t.Errorf("TestGetToken_Mtls: failed to verify device access token: %v", err)
+		return
+	}
+	assertEqual(t, claims.Subject, deviceID)
+	if claims.Confirmation == nil {
+		t.Errorf("TestGetToken_Mtls: access token is missing the cnf claim")
+		return
+	}
+	assertEqual(t, claims.Confirmation.X5tS256,
+		common.GetCertificateX5tS256(clientCert))
+
+	// Resource servers accept the token only from the bound certificate.
+	err = claims.VerifyCertificateBinding(clientCert, true)
+	if err != nil {
+		t.Errorf("TestGetToken_Mtls: certificate binding verification failed: %v", err)
+	}
+	otherCert, _, _, err := createTestDeviceCertificate(uuid.NewString(),
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestGetToken_Mtls: failed to create test device certificate: %v", err)
+		return
+	}
+	otherClientCert, err := x509.ParseCertificate(otherCert)
+	if err != nil {
+		t.Errorf("TestGetToken_Mtls: failed to parse device certificate: %v", err)
+		return
+	}
+	err = claims.VerifyCertificateBinding(otherClientCert, true)
+	if !errors.Is(err, common.ErrCertificateBindingMismatch) {
+		t.Errorf("TestGetToken_Mtls: expected %v, got %v",
+			common.ErrCertificateBindingMismatch, err)
+	}
+}
+
+func TestGetToken_MtlsCertificateRequired(t *testing.T) {
+	tokenResponse := doDeviceMtlsAuthentication(nil)
+	checkResponseCode(t, http.StatusUnauthorized, tokenResponse.Code)
+}
+
+func TestGetToken_MtlsUnregisteredDevice(t *testing.T) {
+	// Certificates of devices that are not registered are not issued tokens.
+	deviceCert, _, _, err := createTestDeviceCertificate(uuid.NewString(),
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestGetToken_MtlsUnregisteredDevice: failed to create test device certificate: %v", err)
+		return
+	}
+	clientCert, err := x509.ParseCertificate(deviceCert)
+	if err != nil {
+		t.Errorf("TestGetToken_MtlsUnregisteredDevice: failed to parse device certificate: %v", err)
+		return
+	}
+
+	tokenResponse := doDeviceMtlsAuthentication(clientCert)
+	checkResponseCode(t, http.StatusNotFound, tokenResponse.Code)
+}
+
+func TestGetToken_MtlsBearerTokensNotBound(t *testing.T) {
+	// Tokens issued by the assertion flow are not certificate bound, and are
+	// only rejected by resource servers that require the binding.
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, uuid.NewString(), "")
+	if deviceCert == nil {
+		return
+	}
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	claims, err := sts.VerifyDeviceAccessToken("test", token.AccessToken)
+	if err != nil {
+		t.Errorf("TestGetToken_MtlsBearerTokensNotBound: failed to verify device access token: %v", err)
+		return
+	}
+	clientCert, err := x509.ParseCertificate(deviceCert)
+	if err != nil {
+		t.Errorf("TestGetToken_MtlsBearerTokensNotBound: failed to parse device certificate: %v", err)
+		return
+	}
+	if claims.VerifyCertificateBinding(clientCert, false) != nil {
+		t.Errorf("TestGetToken_MtlsBearerTokensNotBound: unbound token was rejected")
+	}
+	err = claims.VerifyCertificateBinding(clientCert, true)
+	if !errors.Is(err, common.ErrCertificateBindingMismatch) {
+		t.Errorf("TestGetToken_MtlsBearerTokensNotBound: expected %v, got %v",
+			common.ErrCertificateBindingMismatch, err)
+	}
+}
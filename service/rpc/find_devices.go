@@ -0,0 +1,124 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FindDevices - find devices by the thumbprint of their current or previous
+// device certificate, or by their hardware hash. Devices are found within the
+// specified tenant, or across all tenants for privileged callers.
+func (s *DeviceSTSServer) FindDevices(ctx context.Context,
+	request *pb.FindDevicesRequest) (*pb.FindDevicesResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidFindDevicesResponse(requestID), nil
+	}
+
+	// Ensure the request specified either a certificate thumbprint or a
+	// hardware hash.
+	if (request.CertificateThumbprint == "") == (request.HardwareHash == "") {
+		dstsLogger.Error("Exactly one of certificate thumbprint or hardware hash must be specified",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+		)
+		return invalidFindDevicesResponse(requestID), nil
+	}
+
+	foundDevices, err := db.FindDevices(requestID, request.Tid,
+		request.CertificateThumbprint, request.HardwareHash)
+	if err != nil {
+		dstsLogger.Error("Failed to find devices!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrInvalidRequest) {
+			return invalidFindDevicesResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyFindDevicesResponse(requestID), nil
+		}
+		return internalErrorFindDevicesResponse(requestID), nil
+	}
+
+	response := &pb.FindDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "FindDevices RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+	for i := range foundDevices {
+		response.Devices = append(response.Devices, &pb.FoundDevice{
+			Device:                     newListedDevice(&foundDevices[i].Device),
+			MatchedPreviousCertificate: foundDevices[i].MatchedPreviousCertificate,
+			DuplicateHardwareHash:      foundDevices[i].DuplicateHardwareHash,
+		})
+	}
+
+	metrics.MetricDevicesFound.Inc()
+	return response, nil
+}
+
+func invalidFindDevicesResponse(requestID string) *pb.FindDevicesResponse {
+	response := &pb.FindDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "FindDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricFindDevicesBadRequests.Inc()
+	return response
+}
+
+func internalErrorFindDevicesResponse(requestID string) *pb.FindDevicesResponse {
+	response := &pb.FindDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "FindDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricFindDevicesInternalErrors.Inc()
+	return response
+}
+
+func serverBusyFindDevicesResponse(requestID string) *pb.FindDevicesResponse {
+	response := &pb.FindDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "FindDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricFindDevicesInternalErrors.Inc()
+	return response
+}
@@ -0,0 +1,96 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFindDevices(t *testing.T) {
+	tenantID := uuid.NewString()
+	hardwareHash := uuid.NewString()[:32]
+
+	// Create two devices sharing the same hardware hash.
+	var thumbprints []string
+	for i := 0; i < 2; i++ {
+		deviceCert, deviceID, _, err := createTestDeviceCertificate(tenantID,
+			testTenantName, "")
+		if err != nil {
+			t.Errorf("TestFindDevices: failed to create test device certificate: %v", err)
+			return
+		}
+		response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+			Header:            newDstsProtocolHeader(),
+			Version:           DstsProtocolVersion,
+			Tid:               tenantID,
+			DeviceId:          deviceID,
+			DeviceCertificate: deviceCert,
+			HardwareHash:      hardwareHash,
+		})
+		if err != nil {
+			t.Errorf("TestFindDevices: CreateDevice RPC failed %v", err)
+			return
+		}
+		assertEqual(t, response.Header.Status, uint32(codes.OK))
+
+		thumbprint := sha256.Sum256(deviceCert)
+		thumbprints = append(thumbprints, hex.EncodeToString(thumbprint[:]))
+	}
+
+	// Find the devices by hardware hash.
+	findResponse, err := gClient.FindDevices(gCtx, &pb.FindDevicesRequest{
+		Header:       newDstsProtocolHeader(),
+		Version:      DstsProtocolVersion,
+		Tid:          tenantID,
+		HardwareHash: hardwareHash,
+	})
+	if err != nil {
+		t.Errorf("TestFindDevices: FindDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, findResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(findResponse.Devices), 2)
+	for _, found := range findResponse.Devices {
+		assertEqual(t, found.DuplicateHardwareHash, true)
+	}
+
+	// Find a device by certificate thumbprint, across all tenants.
+	findResponse, err = gClient.FindDevices(gCtx, &pb.FindDevicesRequest{
+		Header:                newDstsProtocolHeader(),
+		Version:               DstsProtocolVersion,
+		CertificateThumbprint: thumbprints[0],
+	})
+	if err != nil {
+		t.Errorf("TestFindDevices: FindDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, findResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(findResponse.Devices), 1)
+	if len(findResponse.Devices) == 1 {
+		assertEqual(t, findResponse.Devices[0].Device.Tid, tenantID)
+		assertEqual(t, findResponse.Devices[0].MatchedPreviousCertificate, false)
+	}
+}
+
+func TestFindDevices_InvalidRequest(t *testing.T) {
+	findResponse, err := gClient.FindDevices(gCtx, &pb.FindDevicesRequest{
+		Header:                newDstsProtocolHeader(),
+		Version:               DstsProtocolVersion,
+		Tid:                   testTenantID,
+		CertificateThumbprint: "abcd",
+		HardwareHash:          "1234",
+	})
+	if err != nil {
+		t.Errorf("TestFindDevices_InvalidRequest: FindDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, findResponse.Header.Status, uint32(codes.InvalidArgument))
+}
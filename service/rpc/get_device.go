@@ -89,6 +89,8 @@ func successGetDeviceResponse(
 			ExpiryTime:            timestamppb.New(device.CertificateExpiresAt),
 			ManagementService:     device.ServiceId,
 			HardwareHash:          device.HardwareHash,
+			Labels:                device.Labels,
+			Metadata:              device.Metadata,
 		},
 	}
 }
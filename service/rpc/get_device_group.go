@@ -0,0 +1,135 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) GetDeviceGroup(ctx context.Context,
+	request *pb.GetDeviceGroupRequest) (*pb.GetDeviceGroupResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidGetDeviceGroupResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and group ID.
+	if (request.Tid == "") || (request.GroupId == "") {
+		dstsLogger.Error("Tenant ID or group ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidGetDeviceGroupResponse(requestID), nil
+	}
+
+	group, err := db.GetDeviceGroup(requestID, request.Tid, request.GroupId)
+	if err != nil {
+		dstsLogger.Error("Failed to get device group information!",
+			zap.String("Request ID", requestID),
+			zap.String("Group ID", request.GroupId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundGetDeviceGroupResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyGetDeviceGroupResponse(requestID), nil
+		}
+		return internalErrorGetDeviceGroupResponse(requestID), nil
+	}
+
+	return successGetDeviceGroupResponse(requestID, group), nil
+}
+
+// Convert the device group to its protobuf representation.
+func newDeviceGroup(group *db.DeviceGroup) *pb.DeviceGroup {
+	return &pb.DeviceGroup{
+		GroupId:     group.GroupId,
+		Tid:         group.TenantId,
+		Name:        group.Name,
+		Description: group.Description,
+		MemberCount: group.MemberCount,
+		CreateTime:  timestamppb.New(group.CreatedAt),
+		UpdateTime:  timestamppb.New(group.UpdatedAt),
+	}
+}
+
+func invalidGetDeviceGroupResponse(requestID string) *pb.GetDeviceGroupResponse {
+	metrics.MetricGetDeviceGroupBadRequests.Inc()
+	return &pb.GetDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "GetDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successGetDeviceGroupResponse(requestID string, group *db.DeviceGroup) *pb.GetDeviceGroupResponse {
+	metrics.MetricDeviceGroupGet.Inc()
+	return &pb.GetDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "GetDeviceGroup RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Group: newDeviceGroup(group),
+	}
+}
+
+func notFoundGetDeviceGroupResponse(requestID string) *pb.GetDeviceGroupResponse {
+	metrics.MetricGetDeviceGroupNotFoundErrors.Inc()
+	return &pb.GetDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "GetDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyGetDeviceGroupResponse(requestID string) *pb.GetDeviceGroupResponse {
+	metrics.MetricGetDeviceGroupInternalErrors.Inc()
+	return &pb.GetDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "GetDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorGetDeviceGroupResponse(requestID string) *pb.GetDeviceGroupResponse {
+	metrics.MetricGetDeviceGroupInternalErrors.Inc()
+	return &pb.GetDeviceGroupResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "GetDeviceGroup RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) GetDevicePosture(ctx context.Context,
+	request *pb.GetDevicePostureRequest) (*pb.GetDevicePostureResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidGetDevicePostureResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and device ID.
+	if (request.Tid == "") || (request.DeviceId == "") {
+		dstsLogger.Error("Tenant ID or device ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidGetDevicePostureResponse(requestID), nil
+	}
+
+	posture, err := db.GetDevicePosture(requestID, request.Tid, request.DeviceId)
+	if err != nil {
+		dstsLogger.Error("Failed to get device posture!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundGetDevicePostureResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyGetDevicePostureResponse(requestID), nil
+		}
+		return internalErrorGetDevicePostureResponse(requestID), nil
+	}
+
+	return successGetDevicePostureResponse(requestID, posture), nil
+}
+
+func invalidGetDevicePostureResponse(requestID string) *pb.GetDevicePostureResponse {
+	metrics.MetricGetDevicePostureBadRequests.Inc()
+	return &pb.GetDevicePostureResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "GetDevicePosture RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successGetDevicePostureResponse(requestID string,
+	posture *db.DevicePosture) *pb.GetDevicePostureResponse {
+	metrics.MetricDevicePostureGet.Inc()
+	return &pb.GetDevicePostureResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "GetDevicePosture RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Posture: &pb.DevicePosture{
+			OsVersion:      posture.OsVersion,
+			PatchLevel:     posture.PatchLevel,
+			DiskEncryption: posture.DiskEncryption,
+			AgentVersion:   posture.AgentVersion,
+			ReportTime:     timestamppb.New(posture.ReportedAt),
+		},
+	}
+}
+
+func notFoundGetDevicePostureResponse(requestID string) *pb.GetDevicePostureResponse {
+	metrics.MetricGetDevicePostureNotFoundErrors.Inc()
+	return &pb.GetDevicePostureResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "GetDevicePosture RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyGetDevicePostureResponse(requestID string) *pb.GetDevicePostureResponse {
+	metrics.MetricGetDevicePostureInternalErrors.Inc()
+	return &pb.GetDevicePostureResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "GetDevicePosture RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorGetDevicePostureResponse(requestID string) *pb.GetDevicePostureResponse {
+	metrics.MetricGetDevicePostureInternalErrors.Inc()
+	return &pb.GetDevicePostureResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "GetDevicePosture RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
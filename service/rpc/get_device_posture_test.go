@@ -0,0 +1,136 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetDevicePosture(t *testing.T) {
+	deviceID, deviceCert, pKey := createTestPostureDevice(t)
+	if deviceID == "" {
+		return
+	}
+
+	// Report the device posture in the client assertion.
+	tokenReq := newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey,
+		json.RawMessage(`{"os_version":"10.0.22631","patch_level":"2024-05",`+
+			`"disk_encryption":"encrypted","agent_version":"1.4.2"}`))
+	if tokenReq == nil {
+		return
+	}
+	tokenResponse := rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	getResponse, err := gClient.GetDevicePosture(gCtx, &pb.GetDevicePostureRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      testTenantID,
+		DeviceId: deviceID,
+	})
+	if err != nil {
+		t.Errorf("TestGetDevicePosture: GetDevicePosture RPC failed %v", err)
+		return
+	}
+
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, getResponse.Posture.OsVersion, "10.0.22631")
+	assertEqual(t, getResponse.Posture.DiskEncryption, "encrypted")
+	dstsLogger.Info("Response from device STS:",
+		zap.Any("Response:", getResponse))
+}
+
+func TestGetDevicePosture_InvalidPosture(t *testing.T) {
+	deviceID, deviceCert, pKey := createTestPostureDevice(t)
+	if deviceID == "" {
+		return
+	}
+
+	// Posture attributes not defined by the schema are rejected.
+	tokenReq := newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey,
+		json.RawMessage(`{"os_version":"10.0.22631","firewall":"enabled"}`))
+	if tokenReq == nil {
+		return
+	}
+	tokenResponse := rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusBadRequest, tokenResponse.Code)
+
+	// Unsupported disk encryption states are rejected.
+	tokenReq = newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey,
+		json.RawMessage(`{"disk_encryption":"maybe"}`))
+	if tokenReq == nil {
+		return
+	}
+	tokenResponse = rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusBadRequest, tokenResponse.Code)
+}
+
+func TestGetDevicePosture_NotReported(t *testing.T) {
+	deviceID, _, _ := createTestPostureDevice(t)
+	if deviceID == "" {
+		return
+	}
+
+	getResponse, err := gClient.GetDevicePosture(gCtx, &pb.GetDevicePostureRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      testTenantID,
+		DeviceId: deviceID,
+	})
+	if err != nil {
+		t.Errorf("TestGetDevicePosture_NotReported: GetDevicePosture RPC failed %v", err)
+		return
+	}
+
+	assertEqual(t, getResponse.Header.Status, uint32(codes.NotFound))
+}
+
+func TestGetDevicePosture_NoDeviceID(t *testing.T) {
+	getResponse, err := gClient.GetDevicePosture(gCtx, &pb.GetDevicePostureRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     testTenantID,
+	})
+	if err != nil {
+		t.Errorf("TestGetDevicePosture_NoDeviceID: GetDevicePosture RPC failed %v", err)
+		return
+	}
+
+	assertEqual(t, getResponse.Header.Status, uint32(codes.InvalidArgument))
+}
+
+func createTestPostureDevice(t *testing.T) (string, []byte, *rsa.PrivateKey) {
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(testTenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("Failed to create test device certificate: %v", err)
+		return "", nil, nil
+	}
+
+	response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               testTenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	})
+	if err != nil {
+		t.Errorf("CreateDevice RPC failed %v", err)
+		return "", nil, nil
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	return deviceID, deviceCert, pKey
+}
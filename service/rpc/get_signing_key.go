@@ -29,7 +29,13 @@ func (s *DeviceSTSServer) GetSigningKey(ctx context.Context,
 		SigningKey: nil,
 	}
 
-	response.SigningKey = sts.GetTokenSigningKey()
+	// If a tenant is specified, return the signing key used for device access
+	// tokens issued to the tenant's devices.
+	if request.Tid != "" {
+		response.SigningKey = sts.GetTenantTokenSigningKey(request.Tid)
+	} else {
+		response.SigningKey = sts.GetTokenSigningKey()
+	}
 	metrics.MetricGetSigningKeyRequests.Inc()
 	return response, nil
 }
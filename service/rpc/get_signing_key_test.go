@@ -5,9 +5,17 @@
 package rpc
 
 import (
+	"bytes"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
 
 	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 )
@@ -27,3 +35,87 @@ func TestGetSigningKey(t *testing.T) {
 	dstsLogger.Info("Response from device STS:",
 		zap.Any("Response:", response))
 }
+
+func TestGetSigningKey_TenantWithoutDedicatedKey(t *testing.T) {
+	// Tenants without a dedicated signing key use the DSTS signing key.
+	response, err := gClient.GetSigningKey(gCtx, &pb.GetSigningKeyRequest{})
+	if err != nil {
+		dstsLogger.Error("TestGetSigningKey: GetSigningKey RPC failed",
+			zap.Error(err))
+		t.Fail()
+		return
+	}
+
+	tenantResponse, err := gClient.GetSigningKey(gCtx, &pb.GetSigningKeyRequest{
+		Tid: uuid.NewString(),
+	})
+	if err != nil {
+		dstsLogger.Error("TestGetSigningKey: GetSigningKey RPC failed",
+			zap.Error(err))
+		t.Fail()
+		return
+	}
+
+	assertEqual(t, tenantResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(tenantResponse.SigningKey), 1)
+	assertEqual(t, tenantResponse.SigningKey[0].Kid, response.SigningKey[0].Kid)
+}
+
+func TestGetJwks(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	response := rest.ExecuteTestRequest(req, rest.GetJwksHandler)
+	checkResponseCode(t, http.StatusOK, response.Code)
+
+	// A conditional request with the current entity tag should not return
+	// the JWKS document again.
+	etag := response.Header().Get("ETag")
+	var jwks sts.JSONWebKeySet
+	_ = parseJSONResponse(t, response.Body, &jwks)
+	assertEqual(t, len(jwks.Keys), 1)
+
+	// The token signing key is not issued a certificate, so certificate
+	// parameters are not published.
+	for _, key := range jwks.Keys {
+		assertEqual(t, len(key.X5c), 0)
+		assertEqual(t, key.X5t, "")
+		assertEqual(t, key.X5tS256, "")
+		assertEqual(t, strings.HasSuffix(key.N, "="), false)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	req.Header.Set("If-None-Match", etag)
+	response = rest.ExecuteTestRequest(req, rest.GetJwksHandler)
+	checkResponseCode(t, http.StatusNotModified, response.Code)
+}
+
+func TestGetDiscoveryDocument(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet,
+		"/.well-known/openid-configuration", nil)
+	response := rest.ExecuteTestRequest(req, rest.GetDiscoveryHandler)
+	checkResponseCode(t, http.StatusOK, response.Code)
+
+	body := bytes.NewBuffer(response.Body.Bytes())
+	var doc rest.DiscoveryDocument
+	_ = parseJSONResponse(t, response.Body, &doc)
+	assertEqual(t, doc.Issuer != "", true)
+
+	// Every scope that can be granted to registered apps is advertised.
+	assertEqual(t, slices.Equal(doc.ScopesSupported, db.GetSupportedAppScopes()),
+		true)
+	for _, scope := range []string{db.ScopeDevicesReadAllTenants,
+		db.ScopeTenantsRead, db.ScopeTenantsWrite} {
+		if !slices.Contains(doc.ScopesSupported, scope) {
+			t.Errorf("TestGetDiscoveryDocument: scope %s is not advertised", scope)
+		}
+	}
+
+	// Refresh tokens are advertised if they are issued to devices.
+	assertEqual(t, slices.Contains(doc.GrantTypesSupported, "refresh_token"),
+		sts.IsDeviceRefreshTokenEnabled())
+
+	// ID tokens are not issued by the DSTS.
+	var fields map[string]interface{}
+	_ = parseJSONResponse(t, body, &fields)
+	_, found := fields["id_token_signing_alg_values_supported"]
+	assertEqual(t, found, false)
+}
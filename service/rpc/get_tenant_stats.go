@@ -0,0 +1,118 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTenantStats - retrieve the state of a tenant, and counts of its devices
+// by state, management service and device certificate expiry.
+func (s *DeviceSTSServer) GetTenantStats(ctx context.Context,
+	request *pb.GetTenantStatsRequest) (*pb.GetTenantStatsResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return getTenantStatsErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+		)
+		return getTenantStatsErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	tenant, err := db.GetTenant(requestID, request.Tid)
+	if err != nil {
+		return getTenantStatsFailedResponse(requestID, request.Tid, err), nil
+	}
+
+	stats, err := db.GetTenantStats(requestID, request.Tid)
+	if err != nil {
+		return getTenantStatsFailedResponse(requestID, request.Tid, err), nil
+	}
+
+	return successGetTenantStatsResponse(requestID, tenant, stats), nil
+}
+
+func getTenantStatsFailedResponse(requestID string, tenantID string,
+	err error) *pb.GetTenantStatsResponse {
+	dstsLogger.Error("Failed to get statistics for the specified tenant!",
+		zap.String("Request ID", requestID),
+		zap.String("Tenant ID", tenantID),
+		zap.Error(err),
+	)
+	if errors.Is(err, db.ErrDatabaseBusy) {
+		return getTenantStatsErrorResponse(requestID, codes.ResourceExhausted)
+	}
+	return getTenantStatsErrorResponse(requestID, codes.Internal)
+}
+
+func successGetTenantStatsResponse(requestID string, tenant *db.Tenant,
+	stats *db.TenantStats) *pb.GetTenantStatsResponse {
+	metrics.MetricTenantStatsGet.Inc()
+	response := &pb.GetTenantStatsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "GetTenantStats RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Stats: &pb.TenantStats{
+			Tid:                           tenant.TenantId,
+			State:                         newTenantState(tenant.State),
+			TotalDevices:                  stats.TotalDevices,
+			EnabledDevices:                stats.EnabledDevices,
+			DisabledDevices:               stats.TotalDevices - stats.EnabledDevices,
+			LostDevices:                   stats.LostDevices,
+			DevicesByManagementService:    stats.DevicesByService,
+			CertificatesExpired:           stats.CertificatesExpired,
+			CertificatesExpiringIn_30Days: stats.CertificatesExpiringIn30Days,
+			CertificatesExpiringIn_90Days: stats.CertificatesExpiringIn90Days,
+			CertificatesExpiringLater:     stats.CertificatesExpiringLater,
+		},
+	}
+	if tenant.PurgeStartedAt != nil {
+		response.Stats.PurgeStartTime = timestamppb.New(*tenant.PurgeStartedAt)
+	}
+	if tenant.PurgedAt != nil {
+		response.Stats.PurgeCompleteTime = timestamppb.New(*tenant.PurgedAt)
+	}
+	return response
+}
+
+func getTenantStatsErrorResponse(requestID string,
+	code codes.Code) *pb.GetTenantStatsResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricGetTenantStatsBadRequests.Inc()
+	default:
+		metrics.MetricGetTenantStatsInternalErrors.Inc()
+	}
+
+	return &pb.GetTenantStatsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "GetTenantStats RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) GetTokenPolicy(ctx context.Context,
+	request *pb.GetTokenPolicyRequest) (*pb.GetTokenPolicyResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidGetTokenPolicyResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and policy ID.
+	if (request.Tid == "") || (request.PolicyId == "") {
+		dstsLogger.Error("Tenant ID or policy ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidGetTokenPolicyResponse(requestID), nil
+	}
+
+	policy, err := db.GetTokenPolicy(requestID, request.Tid, request.PolicyId)
+	if err != nil {
+		dstsLogger.Error("Failed to get token policy information!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundGetTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyGetTokenPolicyResponse(requestID), nil
+		}
+		return internalErrorGetTokenPolicyResponse(requestID), nil
+	}
+
+	return successGetTokenPolicyResponse(requestID, policy), nil
+}
+
+// Convert the token policy to its protobuf representation.
+func newTokenPolicy(policy *db.TokenPolicy) *pb.TokenPolicy {
+	return &pb.TokenPolicy{
+		PolicyId:    policy.PolicyId,
+		Tid:         policy.TenantId,
+		Name:        policy.Name,
+		Description: policy.Description,
+		Expression:  policy.Expression,
+		IsEnabled:   policy.IsEnabled,
+		GroupId:     policy.GroupId,
+		CreateTime:  timestamppb.New(policy.CreatedAt),
+		UpdateTime:  timestamppb.New(policy.UpdatedAt),
+	}
+}
+
+func invalidGetTokenPolicyResponse(requestID string) *pb.GetTokenPolicyResponse {
+	metrics.MetricGetTokenPolicyBadRequests.Inc()
+	return &pb.GetTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "GetTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successGetTokenPolicyResponse(requestID string, policy *db.TokenPolicy) *pb.GetTokenPolicyResponse {
+	metrics.MetricTokenPolicyGet.Inc()
+	return &pb.GetTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "GetTokenPolicy RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Policy: newTokenPolicy(policy),
+	}
+}
+
+func notFoundGetTokenPolicyResponse(requestID string) *pb.GetTokenPolicyResponse {
+	metrics.MetricGetTokenPolicyNotFoundErrors.Inc()
+	return &pb.GetTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "GetTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyGetTokenPolicyResponse(requestID string) *pb.GetTokenPolicyResponse {
+	metrics.MetricGetTokenPolicyInternalErrors.Inc()
+	return &pb.GetTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "GetTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorGetTokenPolicyResponse(requestID string) *pb.GetTokenPolicyResponse {
+	metrics.MetricGetTokenPolicyInternalErrors.Inc()
+	return &pb.GetTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "GetTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -7,7 +7,9 @@ package rpc
 import (
 	"crypto/rsa"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -32,6 +34,8 @@ var (
 const (
 	paramClientAssertionType = "client_assertion_type"
 	paramClientAssertion     = "client_assertion"
+	paramGrantType           = "grant_type"
+	paramRefreshToken        = "refresh_token"
 )
 
 func TestGetToken(t *testing.T) {
@@ -66,7 +70,14 @@ func TestGetToken(t *testing.T) {
 	// Perform device authentication.
 	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
 	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
-	printJSONResponse(t, tokenResponse.Body)
+
+	// The token lifetime must be returned and must not exceed the configured
+	// device access token lifetime.
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	if (token.ExpiresIn <= 0) || (token.ExpiresIn > int64(time.Hour.Seconds())) {
+		t.Errorf("TestGetToken: unexpected expires_in value: %d", token.ExpiresIn)
+	}
 }
 
 func TestGetToken_DisabledDevice(t *testing.T) {
@@ -205,8 +216,294 @@ func TestGetToken_DeletedDevice(t *testing.T) {
 	checkResponseCode(t, http.StatusGone, tokenResponse.Code)
 }
 
+func TestGetToken_Dpop(t *testing.T) {
+	// First create a device certificate.
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(testTenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestGetToken_Dpop: Failed to create test device certificate: %v", err)
+		return
+	}
+
+	// Add the device to the DSTS database.
+	createRequest := &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               testTenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	}
+
+	response, err := gClient.CreateDevice(gCtx, createRequest)
+	if err != nil {
+		dstsLogger.Error("CreateDevice RPC failed", zap.Error(err))
+		t.Fail()
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+
+	tokenReq := newDeviceTokenRequest(t, deviceID, deviceCert, pKey)
+	if tokenReq == nil {
+		return
+	}
+
+	// Construct a DPoP proof signed using the device key.
+	proof := newTestDpopProof(t, tokenReq, pKey)
+	if proof == "" {
+		return
+	}
+	tokenReq.Header.Add("DPoP", proof)
+
+	tokenResponse := rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	assertEqual(t, token.TokenType, sts.TokenTypeDPoP)
+}
+
+func TestGetToken_RefreshToken(t *testing.T) {
+	// First create a device certificate.
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(testTenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestGetToken_RefreshToken: Failed to create test device certificate: %v", err)
+		return
+	}
+
+	// Add the device to the DSTS database.
+	createRequest := &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               testTenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	}
+
+	response, err := gClient.CreateDevice(gCtx, createRequest)
+	if err != nil {
+		dstsLogger.Error("TestGetToken_RefreshToken: RPC failed", zap.Error(err))
+		t.Fail()
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+
+	// Perform device authentication and obtain a refresh token.
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	if token.RefreshToken == "" {
+		t.Errorf("TestGetToken_RefreshToken: refresh token was not issued")
+		return
+	}
+
+	// Redeem the refresh token - a new access token and a rotated refresh
+	// token must be issued.
+	refreshResponse := doTokenRefresh(token.RefreshToken)
+	checkResponseCode(t, http.StatusOK, refreshResponse.Code)
+
+	var refreshedToken rest.TokenResponse
+	_ = parseJSONResponse(t, refreshResponse.Body, &refreshedToken)
+	if (refreshedToken.AccessToken == "") ||
+		(refreshedToken.RefreshToken == token.RefreshToken) {
+		t.Errorf("TestGetToken_RefreshToken: refresh token was not rotated")
+		return
+	}
+
+	// Redeeming the original refresh token again must fail and revoke the
+	// rotated refresh token as well.
+	refreshResponse = doTokenRefresh(token.RefreshToken)
+	checkResponseCode(t, http.StatusUnauthorized, refreshResponse.Code)
+
+	refreshResponse = doTokenRefresh(refreshedToken.RefreshToken)
+	checkResponseCode(t, http.StatusUnauthorized, refreshResponse.Code)
+}
+
+func TestGetToken_RefreshTokenFamilyPruned(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, testTenantID, "")
+	if deviceCert == nil {
+		return
+	}
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	if token.RefreshToken == "" {
+		t.Errorf("TestGetToken_RefreshTokenFamilyPruned: refresh token was not issued")
+		return
+	}
+
+	// Rotate the refresh token several times.
+	refreshTokens := []string{token.RefreshToken}
+	for i := 0; i < 3; i++ {
+		refreshResponse := doTokenRefresh(refreshTokens[len(refreshTokens)-1])
+		checkResponseCode(t, http.StatusOK, refreshResponse.Code)
+		if refreshResponse.Code != http.StatusOK {
+			return
+		}
+		var refreshedToken rest.TokenResponse
+		_ = parseJSONResponse(t, refreshResponse.Body, &refreshedToken)
+		refreshTokens = append(refreshTokens, refreshedToken.RefreshToken)
+	}
+
+	// Only the current refresh token and the one it replaced are retained.
+	// Older redeemed refresh tokens are no longer found, and presenting them
+	// does not revoke the family.
+	for _, refreshToken := range refreshTokens[:len(refreshTokens)-2] {
+		refreshResponse := doTokenRefresh(refreshToken)
+		checkResponseCode(t, http.StatusUnauthorized, refreshResponse.Code)
+	}
+	refreshResponse := doTokenRefresh(refreshTokens[len(refreshTokens)-1])
+	checkResponseCode(t, http.StatusOK, refreshResponse.Code)
+	var refreshedToken rest.TokenResponse
+	_ = parseJSONResponse(t, refreshResponse.Body, &refreshedToken)
+
+	// Presenting the refresh token replaced by the current one again revokes
+	// the family.
+	refreshResponse = doTokenRefresh(refreshTokens[len(refreshTokens)-1])
+	checkResponseCode(t, http.StatusUnauthorized, refreshResponse.Code)
+	refreshResponse = doTokenRefresh(refreshedToken.RefreshToken)
+	checkResponseCode(t, http.StatusUnauthorized, refreshResponse.Code)
+}
+
+func TestGetToken_RefreshTokenDpop(t *testing.T) {
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(testTenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestGetToken_RefreshTokenDpop: Failed to create test device certificate: %v", err)
+		return
+	}
+	response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               testTenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	})
+	if err != nil {
+		dstsLogger.Error("TestGetToken_RefreshTokenDpop: RPC failed", zap.Error(err))
+		t.Fail()
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+
+	// Obtain a DPoP bound access token and refresh token.
+	tokenReq := newDeviceTokenRequest(t, deviceID, deviceCert, pKey)
+	if tokenReq == nil {
+		return
+	}
+	proof := newTestDpopProof(t, tokenReq, pKey)
+	if proof == "" {
+		return
+	}
+	tokenReq.Header.Add("DPoP", proof)
+	tokenResponse := rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+	if token.RefreshToken == "" {
+		t.Errorf("TestGetToken_RefreshTokenDpop: refresh token was not issued")
+		return
+	}
+
+	// Presenting the refresh token without a DPoP proof fails, but does not
+	// redeem the refresh token.
+	refreshResponse := doTokenRefresh(token.RefreshToken)
+	if refreshResponse.Code == http.StatusOK {
+		t.Errorf("TestGetToken_RefreshTokenDpop: refresh token was redeemed without a DPoP proof")
+		return
+	}
+
+	// The refresh token can be redeemed when presented again with a DPoP
+	// proof signed using the bound key.
+	refreshReq := newTokenRefreshRequest(token.RefreshToken)
+	proof = newTestDpopProof(t, refreshReq, pKey)
+	if proof == "" {
+		return
+	}
+	refreshReq.Header.Add("DPoP", proof)
+	refreshResponse = rest.ExecuteTestRequest(refreshReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, refreshResponse.Code)
+
+	var refreshedToken rest.TokenResponse
+	_ = parseJSONResponse(t, refreshResponse.Body, &refreshedToken)
+	assertEqual(t, refreshedToken.TokenType, sts.TokenTypeDPoP)
+}
+
+func doTokenRefresh(refreshToken string) *httptest.ResponseRecorder {
+	return rest.ExecuteTestRequest(newTokenRefreshRequest(refreshToken),
+		rest.DeviceAuthenticationHandler)
+}
+
+func newTokenRefreshRequest(refreshToken string) *http.Request {
+	data := url.Values{}
+	data.Set(paramGrantType, paramRefreshToken)
+	data.Set(paramRefreshToken, refreshToken)
+
+	tokenReq, _ := http.NewRequest(http.MethodPost, gTokenURL,
+		strings.NewReader(data.Encode()))
+	tokenReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return tokenReq
+}
+
+// Construct a DPoP proof for the token request, signed using the device key.
+func newTestDpopProof(t *testing.T, tokenReq *http.Request,
+	pKey *rsa.PrivateKey) string {
+	proofToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"htm": http.MethodPost,
+		"htu": "http://" + tokenReq.Host + gTokenURL,
+		"iat": time.Now().Unix(),
+		"jti": uuid.NewString(),
+	})
+	proofToken.Header["typ"] = "dpop+jwt"
+	proofToken.Header["jwk"] = map[string]string{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(pKey.PublicKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pKey.PublicKey.E)).Bytes()),
+	}
+	proof, err := proofToken.SignedString(pKey)
+	if err != nil {
+		dstsLogger.Error("Failed to generate signed DPoP proof.",
+			zap.Error(err))
+		t.Fail()
+		return ""
+	}
+	return proof
+}
+
 func doDeviceAuthentication(t *testing.T, deviceID string, deviceCert []byte,
 	pKey *rsa.PrivateKey) *httptest.ResponseRecorder {
+	tokenReq := newDeviceTokenRequest(t, deviceID, deviceCert, pKey)
+	if tokenReq == nil {
+		return nil
+	}
+	return rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+}
+
+func newDeviceTokenRequest(t *testing.T, deviceID string, deviceCert []byte,
+	pKey *rsa.PrivateKey) *http.Request {
+	return newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey, nil)
+}
+
+func newDeviceTokenRequestWithPosture(t *testing.T, deviceID string,
+	deviceCert []byte, pKey *rsa.PrivateKey, posture json.RawMessage) *http.Request {
+	return newSignedDeviceTokenRequest(t, deviceID, deviceCert, pKey, posture,
+		jwt.SigningMethodRS512)
+}
+
+// Create a device token request with a client assertion signed using the
+// specified signing method.
+func newSignedDeviceTokenRequest(t *testing.T, deviceID string,
+	deviceCert []byte, pKey *rsa.PrivateKey, posture json.RawMessage,
+	signingMethod jwt.SigningMethod) *http.Request {
 	// Obtain a challenge code from the DSTS.
 	challengeURL := fmt.Sprintf(gChallengeURL, deviceID)
 	req, _ := http.NewRequest(http.MethodGet, challengeURL, nil)
@@ -235,8 +532,9 @@ func doDeviceAuthentication(t *testing.T, deviceID string, deviceCert []byte,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ID:        uuid.NewString(),
 		},
+		Posture: posture,
 	}
-	assertionToken := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
+	assertionToken := jwt.NewWithClaims(signingMethod, claims)
 	assertionToken.Header["x5c"] = []string{base64.StdEncoding.EncodeToString(deviceCert)}
 	assertion, err := assertionToken.SignedString(pKey)
 	if err != nil {
@@ -254,6 +552,5 @@ func doDeviceAuthentication(t *testing.T, deviceID string, deviceCert []byte,
 	tokenReq, _ := http.NewRequest(http.MethodPost, gTokenURL,
 		strings.NewReader(data.Encode()))
 	tokenReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	return rest.ExecuteTestRequest(tokenReq,
-		rest.DeviceAuthenticationHandler)
+	return tokenReq
 }
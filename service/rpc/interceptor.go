@@ -37,3 +37,28 @@ func unaryInterceptor(ctx context.Context,
 	)
 	return h, err
 }
+
+func streamInterceptor(srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	// Calculate and report RPC latency metric when the interceptor is done.
+	defer metrics.ReportLatencyMetric(metrics.MetricRPCLatency, start,
+		info.FullMethod)
+
+	err := handler(srv, ss)
+	if err != nil {
+		metrics.MetricRPCErrors.Inc()
+	} else {
+		metrics.MetricRPCsServed.Inc()
+	}
+
+	dstsLogger.Info("Processed gRPC streaming request.",
+		zap.String("Method:", info.FullMethod),
+		zap.String("Duration:", time.Since(start).String()),
+		zap.Error(err),
+	)
+	return err
+}
@@ -0,0 +1,109 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) ListDeviceGroups(ctx context.Context,
+	request *pb.ListDeviceGroupsRequest) (*pb.ListDeviceGroupsResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidListDeviceGroupsResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidListDeviceGroupsResponse(requestID), nil
+	}
+
+	groups, err := db.ListDeviceGroups(requestID, request.Tid)
+	if err != nil {
+		dstsLogger.Error("Failed to list device groups for the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyListDeviceGroupsResponse(requestID), nil
+		}
+		return internalErrorListDeviceGroupsResponse(requestID), nil
+	}
+
+	return successListDeviceGroupsResponse(requestID, groups), nil
+}
+
+func invalidListDeviceGroupsResponse(requestID string) *pb.ListDeviceGroupsResponse {
+	metrics.MetricListDeviceGroupsBadRequests.Inc()
+	return &pb.ListDeviceGroupsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "ListDeviceGroups RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successListDeviceGroupsResponse(requestID string, groups []db.DeviceGroup) *pb.ListDeviceGroupsResponse {
+	metrics.MetricDeviceGroupsListed.Inc()
+	response := make([]*pb.DeviceGroup, 0, len(groups))
+	for i := range groups {
+		response = append(response, newDeviceGroup(&groups[i]))
+	}
+	return &pb.ListDeviceGroupsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "ListDeviceGroups RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Groups: response,
+	}
+}
+
+func serverBusyListDeviceGroupsResponse(requestID string) *pb.ListDeviceGroupsResponse {
+	metrics.MetricListDeviceGroupsInternalErrors.Inc()
+	return &pb.ListDeviceGroupsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "ListDeviceGroups RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorListDeviceGroupsResponse(requestID string) *pb.ListDeviceGroupsResponse {
+	metrics.MetricListDeviceGroupsInternalErrors.Inc()
+	return &pb.ListDeviceGroupsResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "ListDeviceGroups RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"errors"
 
 	pb "github.com/HPInc/krypton-dsts/dstsprotos"
-	"github.com/HPInc/krypton-dsts/service/common"
 	"github.com/HPInc/krypton-dsts/service/db"
 	"github.com/HPInc/krypton-dsts/service/metrics"
 	"go.uber.org/zap"
@@ -39,19 +38,40 @@ func (s *DeviceSTSServer) ListDevices(ctx context.Context,
 		return response, nil
 	}
 
-	device := db.Device{}
+	// If a page token was specified, continue from the last device returned
+	// in the previous page.
 	pagination := &db.Paginator{
-		Limit: int(request.PageSize),
-		Page:  int(request.PageNumber),
+		Limit:             int(request.PageSize),
+		OrderBy:           request.OrderBy,
+		IncludeTotalCount: request.IncludeTotalCount,
+	}
+	if request.PageToken != "" {
+		cursor, err := parsePageToken(request.PageToken, request.Tid,
+			getDeviceSelection(request.Filter, request.LabelSelector),
+			request.OrderBy)
+		if err != nil {
+			dstsLogger.Error("Invalid page token was specified",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Error(err),
+			)
+			return invalidListDevicesResponse(requestID), nil
+		}
+		pagination.Cursor = cursor
 	}
+
+	device := db.Device{}
 	foundDevices, err := device.ListDevicesPaginated(requestID, request.Tid,
-		request.Filter, pagination)
+		request.Filter, request.LabelSelector, pagination)
 	if err != nil {
 		dstsLogger.Error("Failed to retrieve list of devices for tenant!",
 			zap.String("Request ID", requestID),
 			zap.String("Tenant ID", request.Tid),
 			zap.Error(err),
 		)
+		if errors.Is(err, db.ErrInvalidFilter) {
+			return invalidFilterListDevicesResponse(requestID, err), nil
+		}
 		if errors.Is(err, db.ErrDatabaseBusy) {
 			return serverBusyListDevicesResponse(requestID), nil
 		}
@@ -66,31 +86,44 @@ func (s *DeviceSTSServer) ListDevices(ctx context.Context,
 			RequestId:       requestID,
 			ResponseTime:    timestamppb.Now(),
 		},
-		Devices:  nil,
-		NextPage: 0,
-	}
-	response.NextPage, err = common.ToInt32(pagination.Page + 1)
-	if err != nil {
-		return internalErrorListDevicesResponse(requestID), nil
+		Devices:    nil,
+		TotalCount: foundDevices.TotalCount,
 	}
-	for _, entry := range foundDevices {
-		tmp := &pb.Device{
-			Tid:                   entry.TenantId,
-			DeviceId:              entry.DeviceId,
-			IsEnabled:             entry.IsEnabled,
-			IsLost:                entry.IsLost,
-			CertificateThumbprint: entry.CertificateThumbprint,
-			ExpiryTime:            timestamppb.New(entry.CertificateExpiresAt),
-			ManagementService:     device.ServiceId,
-			HardwareHash:          device.HardwareHash,
+	if foundDevices.NextCursor != nil {
+		response.NextPageToken, err = newPageToken(request.Tid,
+			getDeviceSelection(request.Filter, request.LabelSelector),
+			request.OrderBy, foundDevices.NextCursor)
+		if err != nil {
+			return internalErrorListDevicesResponse(requestID), nil
 		}
-		response.Devices = append(response.Devices, tmp)
+	}
+	for i := range foundDevices.Devices {
+		response.Devices = append(response.Devices,
+			newListedDevice(&foundDevices.Devices[i]))
 	}
 
 	metrics.MetricDevicesListed.Inc()
 	return response, nil
 }
 
+// Convert a device returned by a device listing query to its protobuf
+// representation.
+func newListedDevice(entry *db.Device) *pb.Device {
+	return &pb.Device{
+		Tid:                   entry.TenantId,
+		DeviceId:              entry.DeviceId,
+		IsEnabled:             entry.IsEnabled,
+		IsLost:                entry.IsLost,
+		CertificateThumbprint: entry.CertificateThumbprint,
+		IssuedTime:            timestamppb.New(entry.CertificateIssuedAt),
+		ExpiryTime:            timestamppb.New(entry.CertificateExpiresAt),
+		ManagementService:     entry.ServiceId,
+		HardwareHash:          entry.HardwareHash,
+		Labels:                entry.Labels,
+		Metadata:              entry.Metadata,
+	}
+}
+
 func invalidListDevicesResponse(requestID string) *pb.ListDevicesResponse {
 	response := &pb.ListDevicesResponse{
 		Header: &pb.DstsResponseHeader{
@@ -106,6 +139,14 @@ func invalidListDevicesResponse(requestID string) *pb.ListDevicesResponse {
 	return response
 }
 
+// The reason the filter or sort order is invalid is returned to the caller.
+func invalidFilterListDevicesResponse(requestID string,
+	err error) *pb.ListDevicesResponse {
+	response := invalidListDevicesResponse(requestID)
+	response.Header.StatusMessage = "ListDevices RPC failed: " + err.Error()
+	return response
+}
+
 func internalErrorListDevicesResponse(requestID string) *pb.ListDevicesResponse {
 	response := &pb.ListDevicesResponse{
 		Header: &pb.DstsResponseHeader{
@@ -8,8 +8,10 @@ import (
 	"testing"
 
 	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestListDevices(t *testing.T) {
@@ -31,3 +33,247 @@ func TestListDevices(t *testing.T) {
 	dstsLogger.Info("Response from device STS:",
 		zap.Any("Response:", listResponse))
 }
+
+func TestListDevices_Paginated(t *testing.T) {
+	tenantID := uuid.NewString()
+	if createTestTenantDevices(t, tenantID, 3) == nil {
+		return
+	}
+
+	listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               tenantID,
+		PageSize:          2,
+		IncludeTotalCount: true,
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_Paginated: ListDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, listResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(listResponse.Devices), 2)
+	assertEqual(t, listResponse.TotalCount, int64(3))
+	if listResponse.NextPageToken == "" {
+		t.Errorf("TestListDevices_Paginated: next page token was not returned")
+		return
+	}
+
+	nextResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       tenantID,
+		PageSize:  2,
+		PageToken: listResponse.NextPageToken,
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_Paginated: ListDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, nextResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, nextResponse.NextPageToken, "")
+	if len(nextResponse.Devices) != 1 {
+		t.Errorf("TestListDevices_Paginated: expected 1 device in the last page, got %d",
+			len(nextResponse.Devices))
+		return
+	}
+	for _, device := range listResponse.Devices {
+		if device.DeviceId == nextResponse.Devices[0].DeviceId {
+			t.Errorf("TestListDevices_Paginated: device returned in multiple pages")
+		}
+	}
+
+	// Page tokens are bound to the tenant for which they were issued.
+	otherResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       testTenantID,
+		PageToken: listResponse.NextPageToken,
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_Paginated: ListDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, otherResponse.Header.Status, uint32(codes.InvalidArgument))
+}
+
+func TestListDevices_InvalidPageToken(t *testing.T) {
+	listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       testTenantID,
+		PageToken: "eyJ0IjoieCJ9.invalid",
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_InvalidPageToken: ListDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, listResponse.Header.Status, uint32(codes.InvalidArgument))
+}
+
+func TestListDevices_Filter(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 2)
+	if deviceIDs == nil {
+		return
+	}
+
+	updateResponse, err := gClient.UpdateDevice(gCtx, &pb.UpdateDeviceRequest{
+		Header:     newDstsProtocolHeader(),
+		Version:    DstsProtocolVersion,
+		Tid:        tenantID,
+		DeviceId:   deviceIDs[0],
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"is_enabled"}},
+		Update:     &pb.DeviceUpdates{IsEnabled: false},
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_Filter: UpdateDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, updateResponse.Header.Status, uint32(codes.OK))
+
+	listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Filter:  `is_enabled = false AND (is_lost = false OR management_service = "none")`,
+		OrderBy: "updated_at desc",
+	})
+	if err != nil {
+		t.Errorf("TestListDevices_Filter: ListDevices RPC failed %v", err)
+		return
+	}
+	assertEqual(t, listResponse.Header.Status, uint32(codes.OK))
+	if len(listResponse.Devices) != 1 {
+		t.Errorf("TestListDevices_Filter: expected 1 disabled device, got %d",
+			len(listResponse.Devices))
+		return
+	}
+	assertEqual(t, listResponse.Devices[0].DeviceId, deviceIDs[0])
+}
+
+func TestListDevices_InvalidFilter(t *testing.T) {
+	for _, filter := range []string{
+		`is_enabled = maybe`,
+		`serial_number = "1234"`,
+		`created_at > yesterday`,
+		`(is_lost = true`,
+	} {
+		listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     testTenantID,
+			Filter:  filter,
+		})
+		if err != nil {
+			t.Errorf("TestListDevices_InvalidFilter: ListDevices RPC failed %v", err)
+			return
+		}
+		assertEqual(t, listResponse.Header.Status, uint32(codes.InvalidArgument))
+	}
+}
+
+func TestListDevices_LabelSelector(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 2)
+	if deviceIDs == nil {
+		return
+	}
+
+	for i, labels := range []map[string]string{
+		{"site": "bristol", "example.com/ring": "canary"},
+		{"site": "bristol"},
+	} {
+		updateResponse, err := gClient.UpdateDevice(gCtx, &pb.UpdateDeviceRequest{
+			Header:     newDstsProtocolHeader(),
+			Version:    DstsProtocolVersion,
+			Tid:        tenantID,
+			DeviceId:   deviceIDs[i],
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels", "metadata"}},
+			Update: &pb.DeviceUpdates{
+				Labels:   labels,
+				Metadata: map[string]string{"asset_tag": deviceIDs[i]},
+			},
+		})
+		if err != nil {
+			t.Errorf("TestListDevices_LabelSelector: UpdateDevice RPC failed %v", err)
+			return
+		}
+		assertEqual(t, updateResponse.Header.Status, uint32(codes.OK))
+	}
+
+	for selector, deviceID := range map[string]string{
+		"site=bristol,example.com/ring in (canary,pilot)": deviceIDs[0],
+		"site==bristol,!example.com/ring":                 deviceIDs[1],
+		"example.com/ring notin (pilot),site":             deviceIDs[0],
+	} {
+		listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+			Header:        newDstsProtocolHeader(),
+			Version:       DstsProtocolVersion,
+			Tid:           tenantID,
+			Filter:        "is_enabled = true",
+			LabelSelector: selector,
+		})
+		if err != nil {
+			t.Errorf("TestListDevices_LabelSelector: ListDevices RPC failed %v", err)
+			return
+		}
+		assertEqual(t, listResponse.Header.Status, uint32(codes.OK))
+		if len(listResponse.Devices) != 1 {
+			t.Errorf("TestListDevices_LabelSelector: expected 1 device matching %q, got %d",
+				selector, len(listResponse.Devices))
+			continue
+		}
+		assertEqual(t, listResponse.Devices[0].DeviceId, deviceID)
+		assertEqual(t, listResponse.Devices[0].Labels["site"], "bristol")
+		assertEqual(t, listResponse.Devices[0].Metadata["asset_tag"], deviceID)
+	}
+}
+
+func TestListDevices_InvalidLabelSelector(t *testing.T) {
+	for _, selector := range []string{
+		`site in ()`,
+		`-site=bristol`,
+		`site=bristol,`,
+		`site > 1`,
+	} {
+		listResponse, err := gClient.ListDevices(gCtx, &pb.ListDevicesRequest{
+			Header:        newDstsProtocolHeader(),
+			Version:       DstsProtocolVersion,
+			Tid:           testTenantID,
+			LabelSelector: selector,
+		})
+		if err != nil {
+			t.Errorf("TestListDevices_InvalidLabelSelector: ListDevices RPC failed %v", err)
+			return
+		}
+		assertEqual(t, listResponse.Header.Status, uint32(codes.InvalidArgument))
+	}
+}
+
+// Create the specified number of devices in the tenant, and return their IDs.
+func createTestTenantDevices(t *testing.T, tenantID string, count int) []string {
+	var deviceIDs []string
+	for i := 0; i < count; i++ {
+		deviceCert, deviceID, _, err := createTestDeviceCertificate(tenantID,
+			testTenantName, "")
+		if err != nil {
+			t.Errorf("Failed to create test device certificate: %v", err)
+			return nil
+		}
+		response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+			Header:            newDstsProtocolHeader(),
+			Version:           DstsProtocolVersion,
+			Tid:               tenantID,
+			DeviceId:          deviceID,
+			DeviceCertificate: deviceCert,
+		})
+		if err != nil {
+			t.Errorf("CreateDevice RPC failed %v", err)
+			return nil
+		}
+		assertEqual(t, response.Header.Status, uint32(codes.OK))
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	return deviceIDs
+}
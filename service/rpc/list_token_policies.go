@@ -0,0 +1,109 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) ListTokenPolicies(ctx context.Context,
+	request *pb.ListTokenPoliciesRequest) (*pb.ListTokenPoliciesResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidListTokenPoliciesResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidListTokenPoliciesResponse(requestID), nil
+	}
+
+	policies, err := db.ListTokenPolicies(requestID, request.Tid)
+	if err != nil {
+		dstsLogger.Error("Failed to list token policies for the tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyListTokenPoliciesResponse(requestID), nil
+		}
+		return internalErrorListTokenPoliciesResponse(requestID), nil
+	}
+
+	return successListTokenPoliciesResponse(requestID, policies), nil
+}
+
+func invalidListTokenPoliciesResponse(requestID string) *pb.ListTokenPoliciesResponse {
+	metrics.MetricListTokenPoliciesBadRequests.Inc()
+	return &pb.ListTokenPoliciesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "ListTokenPolicies RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successListTokenPoliciesResponse(requestID string, policies []db.TokenPolicy) *pb.ListTokenPoliciesResponse {
+	metrics.MetricTokenPoliciesListed.Inc()
+	response := make([]*pb.TokenPolicy, 0, len(policies))
+	for i := range policies {
+		response = append(response, newTokenPolicy(&policies[i]))
+	}
+	return &pb.ListTokenPoliciesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "ListTokenPolicies RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Policies: response,
+	}
+}
+
+func serverBusyListTokenPoliciesResponse(requestID string) *pb.ListTokenPoliciesResponse {
+	metrics.MetricListTokenPoliciesInternalErrors.Inc()
+	return &pb.ListTokenPoliciesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "ListTokenPolicies RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorListTokenPoliciesResponse(requestID string) *pb.ListTokenPoliciesResponse {
+	metrics.MetricListTokenPoliciesInternalErrors.Inc()
+	return &pb.ListTokenPoliciesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "ListTokenPolicies RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
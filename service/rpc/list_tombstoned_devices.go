@@ -0,0 +1,149 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Sort order bound to page tokens for tombstoned devices, so they cannot
+	// be used to list devices.
+	tombstonedDevicesOrderBy = "tombstoned_at"
+)
+
+func (s *DeviceSTSServer) ListTombstonedDevices(ctx context.Context,
+	request *pb.ListTombstonedDevicesRequest) (*pb.ListTombstonedDevicesResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidListTombstonedDevicesResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidListTombstonedDevicesResponse(requestID), nil
+	}
+
+	// If a page token was specified, continue from the last tombstoned device
+	// returned in the previous page.
+	pagination := &db.Paginator{Limit: int(request.PageSize)}
+	if request.PageToken != "" {
+		cursor, err := parsePageToken(request.PageToken, request.Tid, "",
+			tombstonedDevicesOrderBy)
+		if err != nil {
+			dstsLogger.Error("Invalid page token was specified",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Error(err),
+			)
+			return invalidListTombstonedDevicesResponse(requestID), nil
+		}
+		pagination.Cursor = cursor
+	}
+
+	foundDevices, err := db.ListTombstonedDevices(requestID, request.Tid,
+		pagination)
+	if err != nil {
+		dstsLogger.Error("Failed to retrieve list of tombstoned devices for tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyListTombstonedDevicesResponse(requestID), nil
+		}
+		return internalErrorListTombstonedDevicesResponse(requestID), nil
+	}
+
+	response := &pb.ListTombstonedDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "ListTombstonedDevices RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+	if foundDevices.NextCursor != nil {
+		response.NextPageToken, err = newPageToken(request.Tid, "",
+			tombstonedDevicesOrderBy, foundDevices.NextCursor)
+		if err != nil {
+			return internalErrorListTombstonedDevicesResponse(requestID), nil
+		}
+	}
+	for _, entry := range foundDevices.Devices {
+		response.Devices = append(response.Devices, &pb.TombstonedDevice{
+			Tid:           entry.TenantId,
+			DeviceId:      entry.DeviceId,
+			TombstoneTime: timestamppb.New(entry.TombstonedAt),
+		})
+	}
+
+	metrics.MetricTombstonedDevicesListed.Inc()
+	return response, nil
+}
+
+func invalidListTombstonedDevicesResponse(
+	requestID string) *pb.ListTombstonedDevicesResponse {
+	response := &pb.ListTombstonedDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "ListTombstonedDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricListTombstonedDevicesBadRequests.Inc()
+	return response
+}
+
+func internalErrorListTombstonedDevicesResponse(
+	requestID string) *pb.ListTombstonedDevicesResponse {
+	response := &pb.ListTombstonedDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "ListTombstonedDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricListTombstonedDevicesInternalErrors.Inc()
+	return response
+}
+
+func serverBusyListTombstonedDevicesResponse(
+	requestID string) *pb.ListTombstonedDevicesResponse {
+	response := &pb.ListTombstonedDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "ListTombstonedDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricListTombstonedDevicesInternalErrors.Inc()
+	return response
+}
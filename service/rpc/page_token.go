@@ -0,0 +1,127 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+)
+
+var (
+	ErrInvalidPageToken          = errors.New("invalid page token specified")
+	ErrPageTokenKeyNotConfigured = errors.New("page token key is not configured")
+
+	// Key used to sign page tokens returned by list RPCs.
+	pageTokenKey []byte
+)
+
+// pageToken - the contents of the opaque page token returned by list RPCs. The
+// token identifies the last result returned, and is bound to the tenant,
+// filter and sort order of the request.
+type pageToken struct {
+	TenantID  string `json:"t"`
+	Query     string `json:"q"`
+	SortValue int64  `json:"s"`
+	DeviceID  string `json:"d"`
+}
+
+// Initialize the key used to sign page tokens. All instances of the DSTS must
+// share the key, so that page tokens are accepted by any instance. A key must
+// be configured, unless the DSTS is running in test mode, in which case a
+// random key is generated.
+func initPageTokenKey() error {
+	if (dstsConfig != nil) && (dstsConfig.PageTokenKey != "") {
+		pageTokenKey = []byte(dstsConfig.PageTokenKey)
+		return nil
+	}
+
+	if !testModeEnabled {
+		dstsLogger.Error("Page token key is not configured! Specify the key shared by all DSTS instances using DSTS_PAGE_TOKEN_KEY.")
+		return ErrPageTokenKeyNotConfigured
+	}
+
+	dstsLogger.Warn("Page token key is not configured. Page tokens are only valid for this DSTS instance!")
+	pageTokenKey = make([]byte, sha256.Size)
+	_, err := rand.Read(pageTokenKey)
+	return err
+}
+
+// Return a signed page token for the cursor.
+func newPageToken(tenantID string, filter string, orderBy string,
+	cursor *db.DeviceCursor) (string, error) {
+	payload, err := json.Marshal(pageToken{
+		TenantID:  tenantID,
+		Query:     getQueryHash(filter, orderBy),
+		SortValue: cursor.SortValue.UnixMicro(),
+		DeviceID:  cursor.DeviceId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signPageToken(encoded), nil
+}
+
+// Verify the signature on the page token and return the cursor it identifies.
+// The tenant, filter and sort order must match those for which the token was
+// issued.
+func parsePageToken(token string, tenantID string, filter string,
+	orderBy string) (*db.DeviceCursor, error) {
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidPageToken
+	}
+	if !hmac.Equal([]byte(signature), []byte(signPageToken(encoded))) {
+		return nil, ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var parsed pageToken
+	err = json.Unmarshal(payload, &parsed)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if (parsed.TenantID != tenantID) || (parsed.Query != getQueryHash(filter, orderBy)) {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &db.DeviceCursor{
+		SortValue: time.UnixMicro(parsed.SortValue).UTC(),
+		DeviceId:  parsed.DeviceID,
+	}, nil
+}
+
+func signPageToken(encoded string) string {
+	mac := hmac.New(sha256.New, pageTokenKey)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Return the device selection to which page tokens are bound, combining the
+// filter and the label selector. Page tokens issued for requests without a
+// label selector are bound to the filter alone.
+func getDeviceSelection(filter string, labelSelector string) string {
+	if labelSelector == "" {
+		return filter
+	}
+	return filter + "\n" + labelSelector
+}
+
+func getQueryHash(filter string, orderBy string) string {
+	hash := sha256.Sum256([]byte(filter + "\n" + orderBy))
+	return base64.RawURLEncoding.EncodeToString(hash[:12])
+}
@@ -0,0 +1,47 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/HPInc/krypton-dsts/service/config"
+)
+
+func TestInitPageTokenKey(t *testing.T) {
+	savedConfig, savedTestMode, savedKey := dstsConfig, testModeEnabled,
+		pageTokenKey
+	t.Cleanup(func() {
+		dstsConfig, testModeEnabled, pageTokenKey = savedConfig, savedTestMode,
+			savedKey
+	})
+
+	// The page token key must be configured outside test mode, so that page
+	// tokens are accepted by every DSTS instance.
+	dstsConfig = &config.ServerConfig{}
+	testModeEnabled = false
+	err := initPageTokenKey()
+	if !errors.Is(err, ErrPageTokenKeyNotConfigured) {
+		t.Errorf("TestInitPageTokenKey: expected %v, got %v",
+			ErrPageTokenKeyNotConfigured, err)
+	}
+
+	dstsConfig = &config.ServerConfig{PageTokenKey: "shared-page-token-key"}
+	err = initPageTokenKey()
+	if err != nil {
+		t.Errorf("TestInitPageTokenKey: failed to initialize the configured key: %v", err)
+	}
+	assertEqual(t, string(pageTokenKey), "shared-page-token-key")
+
+	// In test mode, a random key is generated if none is configured.
+	dstsConfig = &config.ServerConfig{}
+	testModeEnabled = true
+	err = initPageTokenKey()
+	if err != nil {
+		t.Errorf("TestInitPageTokenKey: failed to generate a random key: %v", err)
+	}
+	assertEqual(t, len(pageTokenKey) != 0, true)
+}
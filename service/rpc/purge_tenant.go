@@ -0,0 +1,101 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PurgeTenant - delete a suspended tenant and start purging all of its data.
+// The data is purged asynchronously; the purge completion time is reported by
+// the GetTenantStats RPC.
+func (s *DeviceSTSServer) PurgeTenant(ctx context.Context,
+	request *pb.PurgeTenantRequest) (*pb.PurgeTenantResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return purgeTenantErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+		)
+		return purgeTenantErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	tenant, err := db.PurgeTenant(requestID, request.Tid)
+	if err != nil {
+		dstsLogger.Error("Failed to purge the specified tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotAllowed) {
+			// Only suspended tenants can be purged.
+			return purgeTenantErrorResponse(requestID,
+				codes.FailedPrecondition), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return purgeTenantErrorResponse(requestID,
+				codes.ResourceExhausted), nil
+		}
+		return purgeTenantErrorResponse(requestID, codes.Internal), nil
+	}
+
+	return successPurgeTenantResponse(requestID, tenant), nil
+}
+
+func successPurgeTenantResponse(requestID string,
+	tenant *db.Tenant) *pb.PurgeTenantResponse {
+	metrics.MetricTenantPurgeStarted.Inc()
+	response := &pb.PurgeTenantResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "PurgeTenant RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+	if tenant.PurgeStartedAt != nil {
+		response.PurgeStartTime = timestamppb.New(*tenant.PurgeStartedAt)
+	}
+	return response
+}
+
+func purgeTenantErrorResponse(requestID string,
+	code codes.Code) *pb.PurgeTenantResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricPurgeTenantBadRequests.Inc()
+	case codes.FailedPrecondition:
+		metrics.MetricPurgeTenantNotAllowedErrors.Inc()
+	default:
+		metrics.MetricPurgeTenantInternalErrors.Inc()
+	}
+
+	return &pb.PurgeTenantResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "PurgeTenant RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
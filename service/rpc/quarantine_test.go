@@ -0,0 +1,124 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"crypto/rsa"
+	"net/http"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"google.golang.org/grpc/codes"
+)
+
+// Create a device managed by the specified management service in the tenant,
+// and return its device certificate, device ID and key.
+func createTestManagedDevice(t *testing.T, tenantID string,
+	serviceID string) ([]byte, string, *rsa.PrivateKey) {
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(tenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("Failed to create test device certificate: %v", err)
+		return nil, "", nil
+	}
+	response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               tenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+		ManagementService: serviceID,
+	})
+	if err != nil {
+		t.Errorf("CreateDevice RPC failed %v", err)
+		return nil, "", nil
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	return deviceCert, deviceID, pKey
+}
+
+func TestGetToken_QuarantineToken(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t,
+		testQuarantineTenantID, "hpcem")
+	if deviceCert == nil {
+		return
+	}
+	updateResponse, err := markDeviceLost(t, testQuarantineTenantID, deviceID)
+	if err != nil {
+		return
+	}
+	assertEqual(t, updateResponse.Header.Status, uint32(codes.OK))
+
+	// Lost devices in the tenant are issued a quarantine token.
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+
+	// The quarantine token is not accepted as a device access token.
+	_, err = sts.VerifyDeviceAccessToken("test", token.AccessToken)
+	assertEqual(t, err, sts.ErrInvalidAccessToken)
+
+	// The quarantine token is only accepted by the device's management
+	// service.
+	claims, err := sts.VerifyDeviceQuarantineToken("test", token.AccessToken,
+		"hpcem")
+	if err != nil {
+		t.Errorf("TestGetToken_QuarantineToken: failed to verify quarantine token: %v", err)
+		return
+	}
+	assertEqual(t, claims.IsQuarantineToken(), true)
+	assertEqual(t, claims.TokenType, sts.TokenTypeQuarantineToken)
+	assertEqual(t, claims.Subject, deviceID)
+
+	_, err = sts.VerifyDeviceQuarantineToken("test", token.AccessToken,
+		"hpconnect")
+	assertEqual(t, err, sts.ErrInvalidAccessToken)
+}
+
+func TestGetToken_QuarantineNotEnabled(t *testing.T) {
+	// Lost devices in tenants without quarantine enabled are blocked.
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, testTenantID,
+		"hpcem")
+	if deviceCert == nil {
+		return
+	}
+	updateResponse, err := markDeviceLost(t, testTenantID, deviceID)
+	if err != nil {
+		return
+	}
+	assertEqual(t, updateResponse.Header.Status, uint32(codes.OK))
+
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusUnauthorized, tokenResponse.Code)
+}
+
+func TestVerifyDeviceAccessToken(t *testing.T) {
+	deviceCert, deviceID, pKey := createTestManagedDevice(t,
+		testQuarantineTenantID, "hpcem")
+	if deviceCert == nil {
+		return
+	}
+
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+
+	// Device access tokens are verified, and are not quarantine tokens.
+	claims, err := sts.VerifyDeviceAccessToken("test", token.AccessToken)
+	if err != nil {
+		t.Errorf("TestVerifyDeviceAccessToken: failed to verify device access token: %v", err)
+		return
+	}
+	assertEqual(t, claims.TokenType, sts.TokenTypeDeviceAccessToken)
+	assertEqual(t, claims.IsQuarantineToken(), false)
+	assertEqual(t, claims.Subject, deviceID)
+
+	_, err = sts.VerifyDeviceQuarantineToken("test", token.AccessToken, "hpcem")
+	assertEqual(t, err, sts.ErrInvalidAccessToken)
+}
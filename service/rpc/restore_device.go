@@ -0,0 +1,167 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RestoreDevice - restore a deleted device, whose tombstone has not yet been
+// purged. The device is re-created with the new device certificate specified
+// in the request.
+func (s *DeviceSTSServer) RestoreDevice(ctx context.Context,
+	request *pb.RestoreDeviceRequest) (*pb.RestoreDeviceResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidRestoreDeviceResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID and device ID.
+	if (request.Tid == "") || (request.DeviceId == "") {
+		dstsLogger.Error("Tenant ID or device ID were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidRestoreDeviceResponse(requestID), nil
+	}
+
+	// Parse and validate the provided device certificate.
+	deviceCert, err := parseDeviceCertificate(requestID, request.Tid,
+		request.DeviceId, request.DeviceCertificate)
+	if err != nil {
+		return invalidRestoreDeviceResponse(requestID), nil
+	}
+
+	restoredDevice := db.Device{
+		DeviceId:              request.DeviceId,
+		TenantId:              request.Tid,
+		IsEnabled:             true,
+		IsLost:                false,
+		CertificateIssuedAt:   deviceCert.NotBefore,
+		CertificateThumbprint: common.GetCertificateThumbprint(deviceCert),
+		CertificateExpiresAt:  deviceCert.NotAfter,
+		ServiceId:             request.ManagementService,
+		HardwareHash:          request.HardwareHash,
+	}
+	err = restoredDevice.RestoreDevice(requestID)
+	if err != nil {
+		dstsLogger.Error("Failed to restore the specified device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrInvalidRequest) {
+			// The specified management service was not found or is
+			// invalid.
+			return invalidRestoreDeviceResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrNotFound) {
+			// The device was not deleted, or its tombstone has been purged.
+			return notFoundRestoreDeviceResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDuplicateEntry) {
+			// A device with the specified device ID already exists.
+			return duplicateRestoreDeviceResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyRestoreDeviceResponse(requestID), nil
+		}
+		return internalErrorRestoreDeviceResponse(requestID), nil
+	}
+
+	return successRestoreDeviceResponse(requestID), nil
+}
+
+func invalidRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricRestoreDeviceBadRequests.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "RestoreDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricDeviceRestored.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "RestoreDevice RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		RestoreTime: timestamppb.Now(),
+	}
+}
+
+func notFoundRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricRestoreDeviceNotFoundErrors.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "RestoreDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func duplicateRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricRestoreDeviceAlreadyExistsErrors.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.AlreadyExists),
+			StatusMessage:   "RestoreDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricRestoreDeviceInternalErrors.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "RestoreDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyRestoreDeviceResponse(requestID string) *pb.RestoreDeviceResponse {
+	metrics.MetricRestoreDeviceInternalErrors.Inc()
+	return &pb.RestoreDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "RestoreDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
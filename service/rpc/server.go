@@ -12,23 +12,22 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/HPInc/krypton-dsts/service/common"
 	"github.com/HPInc/krypton-dsts/service/config"
 	"go.uber.org/zap"
 
 	pb "github.com/HPInc/krypton-dsts/dstsprotos"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 )
 
 var (
 	dstsLogger *zap.Logger
 	dstsConfig *config.ServerConfig
-)
 
-const (
-// TODO: re-enable TLS after certificate generation is in place.
-// caCertPath    = "config/ca.pem"
-// caKeyFilePath = "config/ca.key"
+	// Whether the DSTS is running in test mode.
+	testModeEnabled bool
 )
 
 // DeviceSTSServer - Connection and other state information for the Device STS.
@@ -47,6 +46,7 @@ type DeviceSTSServer struct {
 func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) error {
 	dstsLogger = logger
 	dstsConfig = cfgMgr.GetServerConfig()
+	testModeEnabled = cfgMgr.IsTestModeEnabled()
 
 	s := &DeviceSTSServer{}
 	err := s.NewServer()
@@ -84,23 +84,53 @@ func (s *DeviceSTSServer) NewServer() error {
 		Timeout: 5 * time.Second,
 	}
 
-	// TODO: re-enable TLS after certificate generation is in place.
-	/*
-		creds, err := credentials.NewServerTLSFromFile(caCertPath, caKeyFilePath)
+	// Initialize the key used to sign page tokens returned by list RPCs.
+	err := initPageTokenKey()
+	if err != nil {
+		dstsLogger.Error("Failed to initialize the page token key!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Callers are required to present an app access token authorizing them
+	// to invoke the requested RPC, if RPC authorization is enabled.
+	interceptors := []grpc.UnaryServerInterceptor{unaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{streamInterceptor}
+	if (dstsConfig != nil) && dstsConfig.RpcAuthorizationEnabled {
+		interceptors = append(interceptors, authorizationInterceptor)
+		streamInterceptors = append(streamInterceptors,
+			streamAuthorizationInterceptor)
+	} else {
+		dstsLogger.Warn("RPC authorization is disabled. All callers will be allowed to invoke RPCs!")
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.KeepaliveParams(defaultKeepAliveParams),
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	// Serve gRPC requests over TLS, if configured. If a client CA file is
+	// configured, callers must also present a valid client certificate.
+	if (dstsConfig != nil) && dstsConfig.TlsEnabled {
+		tlsLoader, err := common.NewTlsConfigLoader(dstsLogger,
+			dstsConfig.TlsCertificateFile, dstsConfig.TlsKeyFile,
+			dstsConfig.RpcClientCAFile)
 		if err != nil {
-			dstsLogger.Error("Failed to generate credentials for TLS!",
+			dstsLogger.Error("Failed to load TLS certificates for the gRPC server!",
+				zap.String("Certificate file:", dstsConfig.TlsCertificateFile),
+				zap.String("Client CA file:", dstsConfig.RpcClientCAFile),
 				zap.Error(err),
 			)
 			return err
 		}
-	*/
+		serverOptions = append(serverOptions,
+			grpc.Creds(credentials.NewTLS(tlsLoader.GetTlsConfig("h2"))))
+	}
 
 	// Initialize and register the gRPC server.
-	s.dstsgRPCServer = grpc.NewServer(
-		//	grpc.Creds(creds),
-		grpc.KeepaliveParams(defaultKeepAliveParams),
-		grpc.UnaryInterceptor(unaryInterceptor),
-	)
+	s.dstsgRPCServer = grpc.NewServer(serverOptions...)
 
 	pb.RegisterDeviceSTSServer(s.dstsgRPCServer, s)
 	return nil
@@ -39,6 +39,44 @@ var (
 	grpcTestServer *grpc.Server
 )
 
+var (
+	// Tenant for which lost devices are issued quarantine tokens.
+	testQuarantineTenantID = uuid.NewString()
+
+	// Tenants for which the client assertion signing algorithm check is in
+	// report only mode, or is off.
+	testReportOnlyTenantID      = uuid.NewString()
+	testVerificationOffTenantID = uuid.NewString()
+
+	// Tenant whose devices are allowed to authenticate using challenge-less
+	// client assertions.
+	testChallengelessTenantID = uuid.NewString()
+
+	// Configuration settings for tenants used by the unit tests.
+	testTenantConfigs = []config.TenantConfig{
+		{
+			Id:                   testQuarantineTenantID,
+			LostDeviceQuarantine: true,
+		},
+		{
+			Id: testReportOnlyTenantID,
+			VerificationModes: map[string]string{
+				sts.VerificationCheckAssertionSigningAlgorithm: sts.VerificationModeReportOnly,
+			},
+		},
+		{
+			Id: testVerificationOffTenantID,
+			VerificationModes: map[string]string{
+				sts.VerificationCheckAssertionSigningAlgorithm: sts.VerificationModeOff,
+			},
+		},
+		{
+			Id:                          testChallengelessTenantID,
+			ChallengelessAuthentication: true,
+		},
+	}
+)
+
 func newDstsProtocolHeader() *pb.DstsRequestHeader {
 	return &pb.DstsRequestHeader{
 		ProtocolVersion: "v1",
@@ -60,7 +98,9 @@ func initConnection() bool {
 	gCtx = context.Background()
 	gConnection, err = grpc.DialContext(gCtx, "bufnet",
 		grpc.WithContextDialer(bufDialer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(appTokenUnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(appTokenStreamClientInterceptor))
 	if err != nil {
 		fmt.Printf("Failed to init bufnet connection: %v\n", err)
 		return false
@@ -71,9 +111,16 @@ func initConnection() bool {
 
 func initTestRpcServer(logger *zap.Logger) {
 	dstsLogger = logger
+	testModeEnabled = true
 
 	gListener = bufconn.Listen(bufSize)
-	grpcTestServer = grpc.NewServer()
+
+	// Callers are required to present an app access token authorizing them
+	// to invoke the requested RPC, as in production deployments.
+	grpcTestServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptor, authorizationInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptor,
+			streamAuthorizationInterceptor))
 
 	s := &DeviceSTSServer{}
 	err := s.NewServer()
@@ -121,6 +168,9 @@ func TestMain(m *testing.M) {
 		os.Exit(2)
 	}
 
+	// Add configuration settings for the tenants used by the unit tests.
+	cfgMgr.AddTestTenants(testTenantConfigs)
+
 	// Initialize the device database and perform any required schema
 	// migrations. This also initializes the device cache.
 	err := db.InitTest(dstsLogger, cfgMgr)
@@ -133,7 +183,7 @@ func TestMain(m *testing.M) {
 	}
 
 	// Initialize the security token service.
-	err = sts.Init(dstsLogger)
+	err = sts.Init(dstsLogger, cfgMgr)
 	if err != nil {
 		dstsLogger.Error("Failed to initialize the security token service!",
 			zap.Error(err),
@@ -143,6 +193,17 @@ func TestMain(m *testing.M) {
 		os.Exit(2)
 	}
 
+	// Issue the app access token presented by the unit tests.
+	gAppAccessToken, err = newTestAppAccessToken(testAppScopes, nil)
+	if err != nil {
+		dstsLogger.Error("Failed to issue the app access token for the unit tests!",
+			zap.Error(err),
+		)
+		db.Shutdown()
+		shutdownLogger()
+		os.Exit(2)
+	}
+
 	// Initialize the DSTS test REST server.
 	rest.InitTestServer(dstsLogger, cfgMgr)
 
@@ -0,0 +1,178 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errStreamSendFailed - wraps errors sending a response on the stream, so they
+// can be distinguished from database errors.
+var errStreamSendFailed = errors.New("failed to send response on stream")
+
+// StreamDevices - stream the devices in the tenant matching the filter, for
+// bulk export. Devices are read from the database in chunks, and the next
+// chunk is read only once the previous response has been sent. Each response
+// carries a checkpoint that can be used to resume the stream if the caller is
+// disconnected. The number of concurrent streams is capped for the service and
+// for each tenant; ResourceExhausted is returned once the cap is reached.
+func (s *DeviceSTSServer) StreamDevices(request *pb.StreamDevicesRequest,
+	stream pb.DeviceSTS_StreamDevicesServer) error {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return stream.Send(invalidStreamDevicesResponse(requestID))
+	}
+
+	// Ensure the request specified a tenant ID.
+	if request.Tid == "" {
+		dstsLogger.Error("Tenant ID was not specified",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+		)
+		return stream.Send(invalidStreamDevicesResponse(requestID))
+	}
+
+	// If a checkpoint was specified, resume after the last device sent to
+	// the caller.
+	var cursor *db.DeviceCursor
+	if request.Checkpoint != "" {
+		var err error
+		cursor, err = parsePageToken(request.Checkpoint, request.Tid,
+			getDeviceSelection(request.Filter, request.LabelSelector),
+			request.OrderBy)
+		if err != nil {
+			dstsLogger.Error("Invalid checkpoint was specified",
+				zap.String("Request ID", requestID),
+				zap.String("Tenant ID", request.Tid),
+				zap.Error(err),
+			)
+			return stream.Send(invalidStreamDevicesResponse(requestID))
+		}
+	}
+
+	err := db.StreamDevices(stream.Context(), requestID, request.Tid,
+		request.Filter, request.LabelSelector, request.OrderBy, cursor,
+		int(request.ChunkSize),
+		func(devices []db.Device, next *db.DeviceCursor) error {
+			checkpoint, err := newPageToken(request.Tid,
+				getDeviceSelection(request.Filter, request.LabelSelector),
+				request.OrderBy, next)
+			if err != nil {
+				return err
+			}
+
+			response := &pb.StreamDevicesResponse{
+				Header: &pb.DstsResponseHeader{
+					ProtocolVersion: DstsProtocolVersion,
+					Status:          uint32(codes.OK),
+					StatusMessage:   "StreamDevices RPC successful",
+					RequestId:       requestID,
+					ResponseTime:    timestamppb.Now(),
+				},
+				Checkpoint: checkpoint,
+			}
+			for i := range devices {
+				response.Devices = append(response.Devices,
+					newListedDevice(&devices[i]))
+			}
+
+			// Send blocks until the caller has capacity to receive the
+			// response, which applies backpressure to the database cursor.
+			err = stream.Send(response)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errStreamSendFailed, err)
+			}
+			return nil
+		})
+	if err != nil {
+		dstsLogger.Error("Failed to stream devices for tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		// The caller can no longer be reached, so no response is sent.
+		if errors.Is(err, errStreamSendFailed) ||
+			(stream.Context().Err() != nil) {
+			metrics.MetricStreamDevicesInternalErrors.Inc()
+			return err
+		}
+		if errors.Is(err, db.ErrInvalidFilter) {
+			return stream.Send(invalidFilterStreamDevicesResponse(requestID, err))
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) ||
+			errors.Is(err, db.ErrTooManyStreams) {
+			return stream.Send(serverBusyStreamDevicesResponse(requestID))
+		}
+		return stream.Send(internalErrorStreamDevicesResponse(requestID))
+	}
+
+	metrics.MetricDevicesStreamed.Inc()
+	return nil
+}
+
+func invalidStreamDevicesResponse(requestID string) *pb.StreamDevicesResponse {
+	response := &pb.StreamDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "StreamDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricStreamDevicesBadRequests.Inc()
+	return response
+}
+
+// The reason the filter or sort order is invalid is returned to the caller.
+func invalidFilterStreamDevicesResponse(requestID string,
+	err error) *pb.StreamDevicesResponse {
+	response := invalidStreamDevicesResponse(requestID)
+	response.Header.StatusMessage = "StreamDevices RPC failed: " + err.Error()
+	return response
+}
+
+func internalErrorStreamDevicesResponse(requestID string) *pb.StreamDevicesResponse {
+	response := &pb.StreamDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "StreamDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricStreamDevicesInternalErrors.Inc()
+	return response
+}
+
+func serverBusyStreamDevicesResponse(requestID string) *pb.StreamDevicesResponse {
+	response := &pb.StreamDevicesResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "StreamDevices RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+
+	metrics.MetricStreamDevicesInternalErrors.Inc()
+	return response
+}
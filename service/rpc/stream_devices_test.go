@@ -0,0 +1,135 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// Receive all responses on the device stream, and return the IDs of the
+// streamed devices.
+func receiveStreamedDevices(t *testing.T,
+	request *pb.StreamDevicesRequest) ([]string, bool) {
+	stream, err := gClient.StreamDevices(gCtx, request)
+	if err != nil {
+		t.Errorf("StreamDevices RPC failed %v", err)
+		return nil, false
+	}
+
+	var deviceIDs []string
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return deviceIDs, true
+		}
+		if err != nil {
+			t.Errorf("Failed to receive from device stream %v", err)
+			return nil, false
+		}
+		assertEqual(t, response.Header.Status, uint32(codes.OK))
+		if response.Header.Status != uint32(codes.OK) {
+			return nil, false
+		}
+		for _, device := range response.Devices {
+			deviceIDs = append(deviceIDs, device.DeviceId)
+		}
+	}
+}
+
+func TestStreamDevices(t *testing.T) {
+	tenantID := uuid.NewString()
+	if createTestTenantDevices(t, tenantID, 5) == nil {
+		return
+	}
+
+	deviceIDs, ok := receiveStreamedDevices(t, &pb.StreamDevicesRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       tenantID,
+		ChunkSize: 2,
+	})
+	if !ok {
+		return
+	}
+	assertEqual(t, len(deviceIDs), 5)
+}
+
+func TestStreamDevices_ResumeFromCheckpoint(t *testing.T) {
+	tenantID := uuid.NewString()
+	if createTestTenantDevices(t, tenantID, 5) == nil {
+		return
+	}
+
+	// Receive the first chunk and then disconnect from the stream.
+	ctx, cancelFunc := context.WithCancel(gCtx)
+	stream, err := gClient.StreamDevices(ctx, &pb.StreamDevicesRequest{
+		Header:    newDstsProtocolHeader(),
+		Version:   DstsProtocolVersion,
+		Tid:       tenantID,
+		OrderBy:   "created_at desc",
+		ChunkSize: 2,
+	})
+	if err != nil {
+		cancelFunc()
+		t.Errorf("TestStreamDevices_ResumeFromCheckpoint: StreamDevices RPC failed %v", err)
+		return
+	}
+	response, err := stream.Recv()
+	cancelFunc()
+	if err != nil {
+		t.Errorf("TestStreamDevices_ResumeFromCheckpoint: failed to receive from device stream %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(response.Devices), 2)
+
+	// Resume the stream from the checkpoint in the first chunk.
+	deviceIDs, ok := receiveStreamedDevices(t, &pb.StreamDevicesRequest{
+		Header:     newDstsProtocolHeader(),
+		Version:    DstsProtocolVersion,
+		Tid:        tenantID,
+		OrderBy:    "created_at desc",
+		ChunkSize:  2,
+		Checkpoint: response.Checkpoint,
+	})
+	if !ok {
+		return
+	}
+	assertEqual(t, len(deviceIDs), 3)
+	for _, device := range response.Devices {
+		for _, deviceID := range deviceIDs {
+			if device.DeviceId == deviceID {
+				t.Errorf("TestStreamDevices_ResumeFromCheckpoint: device %s was streamed twice",
+					deviceID)
+			}
+		}
+	}
+}
+
+func TestStreamDevices_InvalidCheckpoint(t *testing.T) {
+	stream, err := gClient.StreamDevices(gCtx, &pb.StreamDevicesRequest{
+		Header:     newDstsProtocolHeader(),
+		Version:    DstsProtocolVersion,
+		Tid:        testTenantID,
+		Checkpoint: "eyJ0IjoieCJ9.invalid",
+	})
+	if err != nil {
+		t.Errorf("TestStreamDevices_InvalidCheckpoint: StreamDevices RPC failed %v", err)
+		return
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		t.Errorf("TestStreamDevices_InvalidCheckpoint: failed to receive from device stream %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+}
@@ -0,0 +1,105 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// Update the state of the tenant and return the status of the request.
+func updateTestTenantState(t *testing.T, tenantID string,
+	state pb.TenantState) uint32 {
+	response, err := gClient.UpdateTenantState(gCtx, &pb.UpdateTenantStateRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		State:   state,
+	})
+	if err != nil {
+		t.Errorf("UpdateTenantState RPC failed %v", err)
+		return uint32(codes.Unknown)
+	}
+	if response.Header.Status == uint32(codes.OK) {
+		assertEqual(t, response.State, state)
+	}
+	return response.Header.Status
+}
+
+// Purge the tenant and return the status of the request.
+func purgeTestTenant(t *testing.T, tenantID string) uint32 {
+	response, err := gClient.PurgeTenant(gCtx, &pb.PurgeTenantRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+	})
+	if err != nil {
+		t.Errorf("PurgeTenant RPC failed %v", err)
+		return uint32(codes.Unknown)
+	}
+	return response.Header.Status
+}
+
+func TestGetTenantStats(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 3)
+	if deviceIDs == nil {
+		return
+	}
+
+	response, err := gClient.GetTenantStats(gCtx, &pb.GetTenantStatsRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+	})
+	if err != nil {
+		t.Errorf("TestGetTenantStats: GetTenantStats RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	assertEqual(t, response.Stats.State, pb.TenantState_TENANT_STATE_ACTIVE)
+	assertEqual(t, response.Stats.TotalDevices, int64(3))
+	assertEqual(t, response.Stats.EnabledDevices, int64(3))
+	assertEqual(t, response.Stats.DisabledDevices, int64(0))
+	assertEqual(t, response.Stats.LostDevices, int64(0))
+	assertEqual(t, response.Stats.CertificatesExpired+
+		response.Stats.CertificatesExpiringIn_30Days+
+		response.Stats.CertificatesExpiringIn_90Days+
+		response.Stats.CertificatesExpiringLater, int64(3))
+}
+
+func TestTenantLifecycle(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 1)
+	if deviceIDs == nil {
+		return
+	}
+
+	// Active tenants cannot be purged.
+	assertEqual(t, purgeTestTenant(t, tenantID),
+		uint32(codes.FailedPrecondition))
+
+	// Suspend and re-activate the tenant.
+	assertEqual(t, updateTestTenantState(t, tenantID,
+		pb.TenantState_TENANT_STATE_SUSPENDED), uint32(codes.OK))
+	assertEqual(t, updateTestTenantState(t, tenantID,
+		pb.TenantState_TENANT_STATE_ACTIVE), uint32(codes.OK))
+
+	// Tenants can only be deleted by purging them.
+	assertEqual(t, updateTestTenantState(t, tenantID,
+		pb.TenantState_TENANT_STATE_DELETED), uint32(codes.InvalidArgument))
+
+	// Suspend and purge the tenant.
+	assertEqual(t, updateTestTenantState(t, tenantID,
+		pb.TenantState_TENANT_STATE_SUSPENDED), uint32(codes.OK))
+	assertEqual(t, purgeTestTenant(t, tenantID), uint32(codes.OK))
+
+	// Deleted tenants cannot be re-activated.
+	assertEqual(t, updateTestTenantState(t, tenantID,
+		pb.TenantState_TENANT_STATE_ACTIVE), uint32(codes.FailedPrecondition))
+}
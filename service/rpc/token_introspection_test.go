@@ -0,0 +1,99 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/google/uuid"
+)
+
+var (
+	gIntrospectionURL = "/api/v1/token/introspect"
+)
+
+// Present the specified token to the token introspection endpoint using the
+// specified app access token.
+func newTokenIntrospectionRequest(appToken string, token string) *httptest.ResponseRecorder {
+	data := url.Values{}
+	data.Set("token", token)
+
+	req, _ := http.NewRequest(http.MethodPost, gIntrospectionURL,
+		strings.NewReader(data.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	if appToken != "" {
+		req.Header.Add("Authorization", "Bearer "+appToken)
+	}
+	return rest.ExecuteTestRequest(req, rest.TokenIntrospectionHandler)
+}
+
+// Introspect the specified token and return the parsed response.
+func doTokenIntrospection(t *testing.T, appToken string,
+	token string) rest.IntrospectionResponse {
+	var introspection rest.IntrospectionResponse
+	response := newTokenIntrospectionRequest(appToken, token)
+	checkResponseCode(t, http.StatusOK, response.Code)
+	if response.Code == http.StatusOK {
+		_ = parseJSONResponse(t, response.Body, &introspection)
+	}
+	return introspection
+}
+
+func TestTokenIntrospection(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, tenantID, "")
+	if deviceCert == nil {
+		return
+	}
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	var token rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &token)
+
+	introspection := doTokenIntrospection(t, gAppAccessToken, token.AccessToken)
+	assertEqual(t, introspection.Active, true)
+	assertEqual(t, introspection.Subject, deviceID)
+	assertEqual(t, introspection.TenantID, tenantID)
+
+	// Malformed tokens are reported as inactive.
+	introspection = doTokenIntrospection(t, gAppAccessToken, "invalid")
+	assertEqual(t, introspection.Active, false)
+
+	// Tokens issued within tenants the caller is not allowed to act upon are
+	// reported as inactive.
+	restrictedToken, err := newTestAppAccessToken(
+		[]string{db.ScopeTokensIntrospect}, []string{uuid.NewString()})
+	if err != nil {
+		t.Errorf("TestTokenIntrospection: failed to issue test app access token: %v", err)
+		return
+	}
+	introspection = doTokenIntrospection(t, restrictedToken, token.AccessToken)
+	assertEqual(t, introspection.Active, false)
+}
+
+func TestTokenIntrospection_Unauthorized(t *testing.T) {
+	// Callers must present an app access token granting the tokens:introspect
+	// scope.
+	response := newTokenIntrospectionRequest("", "token")
+	checkResponseCode(t, http.StatusUnauthorized, response.Code)
+
+	response = newTokenIntrospectionRequest("invalid", "token")
+	checkResponseCode(t, http.StatusUnauthorized, response.Code)
+
+	appToken, err := newTestAppAccessToken(
+		testAppScopesExcept(db.ScopeTokensIntrospect), nil)
+	if err != nil {
+		t.Errorf("TestTokenIntrospection_Unauthorized: failed to issue test app access token: %v", err)
+		return
+	}
+	response = newTokenIntrospectionRequest(appToken, "token")
+	checkResponseCode(t, http.StatusUnauthorized, response.Code)
+}
@@ -0,0 +1,209 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestTokenPolicyLifecycle(t *testing.T) {
+	tenantID := uuid.NewString()
+
+	createResponse, err := gClient.CreateTokenPolicy(gCtx, &pb.CreateTokenPolicyRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Policy: &pb.TokenPolicy{
+			Name:        "require-encryption",
+			Description: "Require disk encryption",
+			Expression:  `posture.disk_encryption == "encrypted"`,
+			IsEnabled:   true,
+		},
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: CreateTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, createResponse.Header.Status, uint32(codes.OK))
+	if createResponse.Policy == nil {
+		t.Errorf("TestTokenPolicyLifecycle: CreateTokenPolicy did not return the policy")
+		return
+	}
+	policyID := createResponse.Policy.PolicyId
+
+	// Policy names are unique within the tenant.
+	duplicateResponse, err := gClient.CreateTokenPolicy(gCtx, &pb.CreateTokenPolicyRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Policy: &pb.TokenPolicy{
+			Name:       "require-encryption",
+			Expression: "true",
+		},
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: CreateTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, duplicateResponse.Header.Status, uint32(codes.AlreadyExists))
+
+	getResponse, err := gClient.GetTokenPolicy(gCtx, &pb.GetTokenPolicyRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		PolicyId: policyID,
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: GetTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, getResponse.Policy.Expression,
+		`posture.disk_encryption == "encrypted"`)
+
+	updateResponse, err := gClient.UpdateTokenPolicy(gCtx, &pb.UpdateTokenPolicyRequest{
+		Header:     newDstsProtocolHeader(),
+		Version:    DstsProtocolVersion,
+		Tid:        tenantID,
+		PolicyId:   policyID,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"is_enabled"}},
+		Update:     &pb.TokenPolicy{IsEnabled: false},
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: UpdateTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, updateResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, updateResponse.Policy.IsEnabled, false)
+
+	listResponse, err := gClient.ListTokenPolicies(gCtx, &pb.ListTokenPoliciesRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: ListTokenPolicies RPC failed %v", err)
+		return
+	}
+	assertEqual(t, listResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(listResponse.Policies), 1)
+	dstsLogger.Info("Response from device STS:",
+		zap.Any("Response:", listResponse))
+
+	deleteResponse, err := gClient.DeleteTokenPolicy(gCtx, &pb.DeleteTokenPolicyRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		PolicyId: policyID,
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: DeleteTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, deleteResponse.Header.Status, uint32(codes.OK))
+
+	getResponse, err = gClient.GetTokenPolicy(gCtx, &pb.GetTokenPolicyRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		PolicyId: policyID,
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyLifecycle: GetTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.NotFound))
+}
+
+func TestCreateTokenPolicy_InvalidExpression(t *testing.T) {
+	for _, expression := range []string{
+		`device.id ==`,
+		`unknown.attribute == "x"`,
+		`device.id`,
+	} {
+		createResponse, err := gClient.CreateTokenPolicy(gCtx, &pb.CreateTokenPolicyRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     uuid.NewString(),
+			Policy: &pb.TokenPolicy{
+				Name:       "invalid",
+				Expression: expression,
+				IsEnabled:  true,
+			},
+		})
+		if err != nil {
+			t.Errorf("TestCreateTokenPolicy_InvalidExpression: CreateTokenPolicy RPC failed %v", err)
+			return
+		}
+		assertEqual(t, createResponse.Header.Status, uint32(codes.InvalidArgument))
+	}
+}
+
+func TestTokenPolicyEnforcement(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceCert, deviceID, pKey, err := createTestDeviceCertificate(tenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("Failed to create test device certificate: %v", err)
+		return
+	}
+
+	response, err := gClient.CreateDevice(gCtx, &pb.CreateDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               tenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	})
+	if err != nil {
+		t.Errorf("CreateDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+
+	createResponse, err := gClient.CreateTokenPolicy(gCtx, &pb.CreateTokenPolicyRequest{
+		Header:  newDstsProtocolHeader(),
+		Version: DstsProtocolVersion,
+		Tid:     tenantID,
+		Policy: &pb.TokenPolicy{
+			Name:       "require-encryption",
+			Expression: `posture.disk_encryption == "encrypted"`,
+			IsEnabled:  true,
+		},
+	})
+	if err != nil {
+		t.Errorf("TestTokenPolicyEnforcement: CreateTokenPolicy RPC failed %v", err)
+		return
+	}
+	assertEqual(t, createResponse.Header.Status, uint32(codes.OK))
+
+	// Devices that report unencrypted disks are denied a token.
+	tokenReq := newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey,
+		json.RawMessage(`{"disk_encryption":"not_encrypted"}`))
+	if tokenReq == nil {
+		return
+	}
+	tokenResponse := rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusUnauthorized, tokenResponse.Code)
+
+	tokenReq = newDeviceTokenRequestWithPosture(t, deviceID, deviceCert, pKey,
+		json.RawMessage(`{"disk_encryption":"encrypted"}`))
+	if tokenReq == nil {
+		return
+	}
+	tokenResponse = rest.ExecuteTestRequest(tokenReq,
+		rest.DeviceAuthenticationHandler)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+}
@@ -0,0 +1,121 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// List the tombstoned devices in the tenant.
+func listTestTombstonedDevices(t *testing.T,
+	tenantID string) []*pb.TombstonedDevice {
+	response, err := gClient.ListTombstonedDevices(gCtx,
+		&pb.ListTombstonedDevicesRequest{
+			Header:  newDstsProtocolHeader(),
+			Version: DstsProtocolVersion,
+			Tid:     tenantID,
+		})
+	if err != nil {
+		t.Errorf("ListTombstonedDevices RPC failed %v", err)
+		return nil
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.OK))
+	return response.Devices
+}
+
+func TestRestoreDevice(t *testing.T) {
+	tenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, tenantID, 2)
+	if deviceIDs == nil {
+		return
+	}
+
+	deleteResponse, err := gClient.DeleteDevice(gCtx, &pb.DeleteDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		DeviceId: deviceIDs[0],
+	})
+	if err != nil {
+		t.Errorf("TestRestoreDevice: DeleteDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, deleteResponse.Header.Status, uint32(codes.OK))
+
+	// The deleted device is listed as tombstoned.
+	tombstoned := listTestTombstonedDevices(t, tenantID)
+	assertEqual(t, len(tombstoned), 1)
+	if len(tombstoned) == 1 {
+		assertEqual(t, tombstoned[0].DeviceId, deviceIDs[0])
+	}
+
+	// Restore the deleted device with a fresh certificate.
+	deviceCert, _, _, err := createTestDeviceCertificate(tenantID,
+		testTenantName, deviceIDs[0])
+	if err != nil {
+		t.Errorf("TestRestoreDevice: failed to create test device certificate: %v", err)
+		return
+	}
+	restoreRequest := &pb.RestoreDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               tenantID,
+		DeviceId:          deviceIDs[0],
+		DeviceCertificate: deviceCert,
+	}
+	restoreResponse, err := gClient.RestoreDevice(gCtx, restoreRequest)
+	if err != nil {
+		t.Errorf("TestRestoreDevice: RestoreDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, restoreResponse.Header.Status, uint32(codes.OK))
+
+	getResponse, err := gClient.GetDevice(gCtx, &pb.GetDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      tenantID,
+		DeviceId: deviceIDs[0],
+	})
+	if err != nil {
+		t.Errorf("TestRestoreDevice: GetDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, len(listTestTombstonedDevices(t, tenantID)), 0)
+
+	// The device is no longer tombstoned, so it cannot be restored again.
+	restoreRequest.Header = newDstsProtocolHeader()
+	restoreResponse, err = gClient.RestoreDevice(gCtx, restoreRequest)
+	if err != nil {
+		t.Errorf("TestRestoreDevice: RestoreDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, restoreResponse.Header.Status, uint32(codes.NotFound))
+}
+
+func TestRestoreDevice_NotTombstoned(t *testing.T) {
+	deviceCert, deviceID, _, err := createTestDeviceCertificate(testTenantID,
+		testTenantName, "")
+	if err != nil {
+		t.Errorf("TestRestoreDevice_NotTombstoned: failed to create test device certificate: %v", err)
+		return
+	}
+	restoreResponse, err := gClient.RestoreDevice(gCtx, &pb.RestoreDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               testTenantID,
+		DeviceId:          deviceID,
+		DeviceCertificate: deviceCert,
+	})
+	if err != nil {
+		t.Errorf("TestRestoreDevice_NotTombstoned: RestoreDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, restoreResponse.Header.Status, uint32(codes.NotFound))
+}
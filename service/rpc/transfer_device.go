@@ -0,0 +1,152 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransferDevice - move a device to another tenant, for instance when it is
+// resold. The device is re-created in the target tenant with the new device
+// certificate specified in the request, which must be issued within the
+// target tenant. The device can no longer authenticate to the source tenant
+// and refresh tokens issued to it are revoked. Access tokens issued to it in
+// the source tenant are reported as inactive by the token introspection
+// endpoint, but remain valid until they expire for resource servers that
+// verify them offline using the JWKS.
+func (s *DeviceSTSServer) TransferDevice(ctx context.Context,
+	request *pb.TransferDeviceRequest) (*pb.TransferDeviceResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return transferDeviceErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a device ID, and distinct source and
+	// target tenant IDs.
+	if (request.Tid == "") || (request.TargetTid == "") ||
+		(request.DeviceId == "") || (request.Tid == request.TargetTid) {
+		dstsLogger.Error("Tenant IDs or device ID were not specified, or the tenants are the same",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Target tenant ID", request.TargetTid),
+		)
+		return transferDeviceErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Parse and validate the provided device certificate, and ensure it was
+	// issued within the target tenant.
+	deviceCert, err := parseDeviceCertificate(requestID, request.TargetTid,
+		request.DeviceId, request.DeviceCertificate)
+	if err != nil {
+		return transferDeviceErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+	if !common.VerifyTenantIDInCertificateOrganization(deviceCert,
+		request.TargetTid) {
+		dstsLogger.Error("Target tenant ID doesn't match that in the device certificate",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
+			zap.String("Target tenant ID", request.TargetTid),
+			zap.Error(ErrTenantIDMismatch),
+		)
+		return transferDeviceErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	transferredDevice := db.Device{
+		DeviceId:              request.DeviceId,
+		TenantId:              request.TargetTid,
+		CertificateIssuedAt:   deviceCert.NotBefore,
+		CertificateThumbprint: common.GetCertificateThumbprint(deviceCert),
+		CertificateExpiresAt:  deviceCert.NotAfter,
+		ServiceId:             request.ManagementService,
+		HardwareHash:          request.HardwareHash,
+	}
+	transfer, err := transferredDevice.TransferDevice(requestID, request.Tid)
+	if err != nil {
+		dstsLogger.Error("Failed to transfer the specified device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("Target tenant ID", request.TargetTid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrInvalidRequest) {
+			// The specified management service was not found or is
+			// invalid.
+			return transferDeviceErrorResponse(requestID,
+				codes.InvalidArgument), nil
+		}
+		if errors.Is(err, db.ErrNotFound) {
+			return transferDeviceErrorResponse(requestID, codes.NotFound), nil
+		}
+		if errors.Is(err, db.ErrDuplicateEntry) {
+			// A device with the specified device ID already exists in the
+			// target tenant.
+			return transferDeviceErrorResponse(requestID,
+				codes.AlreadyExists), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return transferDeviceErrorResponse(requestID,
+				codes.ResourceExhausted), nil
+		}
+		return transferDeviceErrorResponse(requestID, codes.Internal), nil
+	}
+
+	return successTransferDeviceResponse(requestID, &transferredDevice,
+		transfer), nil
+}
+
+func successTransferDeviceResponse(requestID string, device *db.Device,
+	transfer *db.DeviceTransfer) *pb.TransferDeviceResponse {
+	metrics.MetricDeviceTransferred.Inc()
+	return &pb.TransferDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "TransferDevice RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		TransferId:   transfer.TransferId,
+		Device:       newListedDevice(device),
+		TransferTime: timestamppb.New(transfer.TransferredAt),
+	}
+}
+
+func transferDeviceErrorResponse(requestID string,
+	code codes.Code) *pb.TransferDeviceResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricTransferDeviceBadRequests.Inc()
+	case codes.NotFound:
+		metrics.MetricTransferDeviceNotFoundErrors.Inc()
+	case codes.AlreadyExists:
+		metrics.MetricTransferDeviceAlreadyExistsErrors.Inc()
+	default:
+		metrics.MetricTransferDeviceInternalErrors.Inc()
+	}
+
+	return &pb.TransferDeviceResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "TransferDevice RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
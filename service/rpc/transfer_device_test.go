@@ -0,0 +1,184 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"net/http"
+	"testing"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTransferDevice(t *testing.T) {
+	sourceTenantID := uuid.NewString()
+	targetTenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, sourceTenantID, 1)
+	if deviceIDs == nil {
+		return
+	}
+
+	// Issue a certificate to the device within the target tenant.
+	deviceCert, _, _, err := createTestDeviceCertificate(targetTenantID,
+		testTenantName, deviceIDs[0])
+	if err != nil {
+		t.Errorf("TestTransferDevice: failed to create test device certificate: %v", err)
+		return
+	}
+	transferRequest := &pb.TransferDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               sourceTenantID,
+		DeviceId:          deviceIDs[0],
+		TargetTid:         targetTenantID,
+		DeviceCertificate: deviceCert,
+	}
+	transferResponse, err := gClient.TransferDevice(gCtx, transferRequest)
+	if err != nil {
+		t.Errorf("TestTransferDevice: TransferDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, transferResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, transferResponse.Device.Tid, targetTenantID)
+	if transferResponse.TransferId == "" {
+		t.Errorf("TestTransferDevice: transfer ID was not returned")
+	}
+
+	// The device is found in the target tenant.
+	getResponse, err := gClient.GetDevice(gCtx, &pb.GetDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      targetTenantID,
+		DeviceId: deviceIDs[0],
+	})
+	if err != nil {
+		t.Errorf("TestTransferDevice: GetDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.OK))
+	assertEqual(t, getResponse.Device.Tid, targetTenantID)
+
+	// The device is no longer found in the source tenant, and no tombstone
+	// was left behind.
+	getResponse, err = gClient.GetDevice(gCtx, &pb.GetDeviceRequest{
+		Header:   newDstsProtocolHeader(),
+		Version:  DstsProtocolVersion,
+		Tid:      sourceTenantID,
+		DeviceId: deviceIDs[0],
+	})
+	if err != nil {
+		t.Errorf("TestTransferDevice: GetDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, getResponse.Header.Status, uint32(codes.NotFound))
+	assertEqual(t, len(listTestTombstonedDevices(t, sourceTenantID)), 0)
+
+	// The device has already been transferred out of the source tenant.
+	transferRequest.Header = newDstsProtocolHeader()
+	transferResponse, err = gClient.TransferDevice(gCtx, transferRequest)
+	if err != nil {
+		t.Errorf("TestTransferDevice: TransferDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, transferResponse.Header.Status, uint32(codes.NotFound))
+}
+
+func TestTransferDevice_CertificateTenantMismatch(t *testing.T) {
+	sourceTenantID := uuid.NewString()
+	deviceIDs := createTestTenantDevices(t, sourceTenantID, 1)
+	if deviceIDs == nil {
+		return
+	}
+
+	// The certificate was issued within the source tenant, rather than the
+	// target tenant.
+	deviceCert, _, _, err := createTestDeviceCertificate(sourceTenantID,
+		testTenantName, deviceIDs[0])
+	if err != nil {
+		t.Errorf("TestTransferDevice_CertificateTenantMismatch: failed to create test device certificate: %v", err)
+		return
+	}
+	response, err := gClient.TransferDevice(gCtx, &pb.TransferDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               sourceTenantID,
+		DeviceId:          deviceIDs[0],
+		TargetTid:         uuid.NewString(),
+		DeviceCertificate: deviceCert,
+	})
+	if err != nil {
+		t.Errorf("TestTransferDevice_CertificateTenantMismatch: TransferDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, response.Header.Status, uint32(codes.InvalidArgument))
+}
+
+func TestTransferDevice_RevokesAccessTokens(t *testing.T) {
+	sourceTenantID := uuid.NewString()
+	targetTenantID := uuid.NewString()
+	deviceCert, deviceID, pKey := createTestManagedDevice(t, sourceTenantID, "")
+	if deviceCert == nil {
+		return
+	}
+
+	// Obtain an access token for the device in the source tenant.
+	tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	var sourceToken rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &sourceToken)
+	_, err := sts.VerifyDeviceAccessToken("test", sourceToken.AccessToken)
+	if err != nil {
+		t.Errorf("TestTransferDevice_RevokesAccessTokens: failed to verify device access token: %v", err)
+		return
+	}
+
+	// Transfer the device to the target tenant.
+	targetCert, _, targetKey, err := createTestDeviceCertificate(targetTenantID,
+		testTenantName, deviceID)
+	if err != nil {
+		t.Errorf("TestTransferDevice_RevokesAccessTokens: failed to create test device certificate: %v", err)
+		return
+	}
+	transferResponse, err := gClient.TransferDevice(gCtx, &pb.TransferDeviceRequest{
+		Header:            newDstsProtocolHeader(),
+		Version:           DstsProtocolVersion,
+		Tid:               sourceTenantID,
+		DeviceId:          deviceID,
+		TargetTid:         targetTenantID,
+		DeviceCertificate: targetCert,
+	})
+	if err != nil {
+		t.Errorf("TestTransferDevice_RevokesAccessTokens: TransferDevice RPC failed %v", err)
+		return
+	}
+	assertEqual(t, transferResponse.Header.Status, uint32(codes.OK))
+
+	// The access token issued in the source tenant is no longer accepted, and
+	// is reported as inactive to resource servers.
+	_, err = sts.VerifyDeviceAccessToken("test", sourceToken.AccessToken)
+	assertEqual(t, err, sts.ErrAccessTokenRevoked)
+	introspection := doTokenIntrospection(t, gAppAccessToken,
+		sourceToken.AccessToken)
+	assertEqual(t, introspection.Active, false)
+
+	// Access tokens issued to the device in the target tenant are accepted.
+	tokenResponse = doDeviceAuthentication(t, deviceID, targetCert, targetKey)
+	checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+	var targetToken rest.TokenResponse
+	_ = parseJSONResponse(t, tokenResponse.Body, &targetToken)
+	claims, err := sts.VerifyDeviceAccessToken("test", targetToken.AccessToken)
+	if err != nil {
+		t.Errorf("TestTransferDevice_RevokesAccessTokens: failed to verify device access token: %v", err)
+		return
+	}
+	assertEqual(t, claims.TenantID, targetTenantID)
+	introspection = doTokenIntrospection(t, gAppAccessToken,
+		targetToken.AccessToken)
+	assertEqual(t, introspection.Active, true)
+	assertEqual(t, introspection.TenantID, targetTenantID)
+}
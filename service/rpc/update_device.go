@@ -16,6 +16,7 @@ import (
 	"github.com/HPInc/krypton-dsts/service/metrics"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -40,75 +41,92 @@ func (s *DeviceSTSServer) UpdateDevice(ctx context.Context,
 		return response, nil
 	}
 
-	mask := request.GetUpdateMask()
+	updateMap, ok := newDeviceUpdateMap(requestID, request.Tid,
+		request.DeviceId, request.GetUpdateMask(), request.Update)
+	if !ok {
+		return invalidUpdateDeviceResponse(requestID), nil
+	}
+
+	err := db.UpdateDevice(requestID, request.DeviceId, request.Tid,
+		updateMap)
+	if err != nil {
+		dstsLogger.Error("Failed to update the specified device!",
+			zap.String("Request ID", requestID),
+			zap.String("Device ID", request.DeviceId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundUpdateDeviceResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyUpdateDeviceResponse(requestID), nil
+		}
+		return internalErrorUpdateDeviceResponse(requestID), nil
+	}
+
+	return successUpdateDeviceResponse(requestID), nil
+}
+
+// Build the map of updates to be applied to the device from the update mask
+// and the updates specified in the request.
+func newDeviceUpdateMap(requestID string, tenantID string, deviceID string,
+	mask *fieldmaskpb.FieldMask,
+	update *pb.DeviceUpdates) (map[string]interface{}, bool) {
 	var updateMap = make(map[string]interface{})
 	for _, field := range mask.GetPaths() {
 		switch strings.ToLower(field) {
 
 		case "enabled", "is_enabled":
-			updateMap[db.UpdateFieldIsEnabled] = request.Update.IsEnabled
+			updateMap[db.UpdateFieldIsEnabled] = update.GetIsEnabled()
 
 		case "lost", "is_lost":
-			updateMap[db.UpdateFieldIsLost] = request.Update.IsLost
+			updateMap[db.UpdateFieldIsLost] = update.GetIsLost()
 
 		case "cert", "certificate", "device_certificate":
-			if request.Update.DeviceCertificate == nil {
-				dstsLogger.Error("Received invalid request to update device certificate",
-					zap.String("Request ID", requestID),
-					zap.String("Device ID", request.DeviceId),
-					zap.String("Tenant ID", request.Tid),
-				)
-				response := invalidUpdateDeviceResponse(requestID)
-				return response, nil
+			// Parse and validate the provided device certificate.
+			deviceCert, err := parseDeviceCertificate(requestID, tenantID,
+				deviceID, update.GetDeviceCertificate())
+			if err != nil {
+				return nil, false
 			}
 
-			// Parse the provided device certificate.
-			deviceCert, err := common.ParseCertificate(
-				request.Update.DeviceCertificate)
+			// Generate a SHA256 hash which serves as the certificate thumbprint.
+			updateMap[db.UpdateFieldCertificateThumbprint] = common.GetCertificateThumbprint(deviceCert)
+			updateMap[db.UpdateFieldCertificateIssuedAt] = deviceCert.NotBefore
+			updateMap[db.UpdateFieldCertificateExpiresAt] = deviceCert.NotAfter
+
+		case "labels":
+			err := db.ValidateDeviceLabels(update.GetLabels(), nil)
 			if err != nil {
-				dstsLogger.Error("Failed to parse the provided device certificate",
+				dstsLogger.Error("Invalid device labels were specified",
 					zap.String("Request ID", requestID),
-					zap.String("Device ID", request.DeviceId),
-					zap.String("Tenant ID", request.Tid),
+					zap.String("Device ID", deviceID),
+					zap.String("Tenant ID", tenantID),
 					zap.Error(err),
 				)
-				response := invalidUpdateDeviceResponse(requestID)
-				return response, nil
+				return nil, false
 			}
+			updateMap[db.UpdateFieldLabels] = update.GetLabels()
 
-			// Perform some validation checks on the provided certificate.
-			err = common.VerifyCertificate(deviceCert)
+		case "metadata":
+			err := db.ValidateDeviceLabels(nil, update.GetMetadata())
 			if err != nil {
-				dstsLogger.Error("Verification checks failed for the device certificate",
-					zap.String("Request ID", requestID),
-					zap.String("Device ID", request.DeviceId),
-					zap.String("Tenant ID", request.Tid),
-					zap.Error(err),
-				)
-				response := invalidUpdateDeviceResponse(requestID)
-				return response, nil
-			}
-			if !common.VerifyDeviceIDInCertificateCommonName(deviceCert, request.DeviceId) {
-				dstsLogger.Error("Device ID specified in the request doesn't match that in the device certificate",
+				dstsLogger.Error("Invalid device metadata was specified",
 					zap.String("Request ID", requestID),
-					zap.String("Device ID", request.DeviceId),
-					zap.String("Tenant ID", request.Tid),
+					zap.String("Device ID", deviceID),
+					zap.String("Tenant ID", tenantID),
 					zap.Error(err),
 				)
-				response := invalidUpdateDeviceResponse(requestID)
-				return response, nil
+				return nil, false
 			}
-
-			// Generate a SHA256 hash which serves as the certificate thumbprint.
-			updateMap[db.UpdateFieldCertificateThumbprint] = common.GetCertificateThumbprint(deviceCert)
-			updateMap[db.UpdateFieldCertificateIssuedAt] = deviceCert.NotBefore
-			updateMap[db.UpdateFieldCertificateExpiresAt] = deviceCert.NotAfter
+			updateMap[db.UpdateFieldMetadata] = update.GetMetadata()
 
 		default:
 			dstsLogger.Error("Received invalid update request mask",
 				zap.String("Request ID", requestID),
-				zap.String("Device ID", request.DeviceId),
-				zap.String("Tenant ID", request.Tid),
+				zap.String("Device ID", deviceID),
+				zap.String("Tenant ID", tenantID),
 				zap.String("Update mask", field),
 			)
 		}
@@ -118,32 +136,14 @@ func (s *DeviceSTSServer) UpdateDevice(ctx context.Context,
 	if len(updateMap) == 0 {
 		dstsLogger.Error("No valid updates found in the request!",
 			zap.String("Request ID", requestID),
-			zap.String("Device ID", request.DeviceId),
-			zap.String("Tenant ID", request.Tid),
+			zap.String("Device ID", deviceID),
+			zap.String("Tenant ID", tenantID),
 		)
-		return invalidUpdateDeviceResponse(requestID), nil
+		return nil, false
 	}
 
 	updateMap["UpdatedAt"] = time.Now()
-	err := db.UpdateDevice(requestID, request.DeviceId, request.Tid,
-		updateMap)
-	if err != nil {
-		dstsLogger.Error("Failed to update the specified device!",
-			zap.String("Request ID", requestID),
-			zap.String("Device ID", request.DeviceId),
-			zap.String("Tenant ID", request.Tid),
-			zap.Error(err),
-		)
-		if errors.Is(err, db.ErrNotFound) {
-			return notFoundUpdateDeviceResponse(requestID), nil
-		}
-		if errors.Is(err, db.ErrDatabaseBusy) {
-			return serverBusyUpdateDeviceResponse(requestID), nil
-		}
-		return internalErrorUpdateDeviceResponse(requestID), nil
-	}
-
-	return successUpdateDeviceResponse(requestID), nil
+	return updateMap, true
 }
 
 func invalidUpdateDeviceResponse(requestID string) *pb.UpdateDeviceResponse {
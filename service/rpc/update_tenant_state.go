@@ -0,0 +1,122 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Mapping between tenant states in the protocol and in the database.
+var tenantStates = map[pb.TenantState]string{
+	pb.TenantState_TENANT_STATE_ACTIVE:    db.TenantStateActive,
+	pb.TenantState_TENANT_STATE_SUSPENDED: db.TenantStateSuspended,
+	pb.TenantState_TENANT_STATE_DELETED:   db.TenantStateDeleted,
+}
+
+// UpdateTenantState - suspend or re-activate a tenant. Suspending a tenant
+// blocks token issuance for all devices in the tenant at once, for instance
+// when the customer churns.
+func (s *DeviceSTSServer) UpdateTenantState(ctx context.Context,
+	request *pb.UpdateTenantStateRequest) (*pb.UpdateTenantStateResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return updateTenantStateErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	// Ensure the request specified a tenant ID, and the tenant is either
+	// being activated or suspended.
+	if (request.Tid == "") ||
+		((request.State != pb.TenantState_TENANT_STATE_ACTIVE) &&
+			(request.State != pb.TenantState_TENANT_STATE_SUSPENDED)) {
+		dstsLogger.Error("Tenant ID was not specified or the state is invalid",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.String("State", request.State.String()),
+		)
+		return updateTenantStateErrorResponse(requestID, codes.InvalidArgument), nil
+	}
+
+	tenant, err := db.UpdateTenantState(requestID, request.Tid,
+		tenantStates[request.State])
+	if err != nil {
+		dstsLogger.Error("Failed to update the state of the specified tenant!",
+			zap.String("Request ID", requestID),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotAllowed) {
+			// The tenant has been deleted.
+			return updateTenantStateErrorResponse(requestID,
+				codes.FailedPrecondition), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return updateTenantStateErrorResponse(requestID,
+				codes.ResourceExhausted), nil
+		}
+		return updateTenantStateErrorResponse(requestID, codes.Internal), nil
+	}
+
+	return successUpdateTenantStateResponse(requestID, tenant), nil
+}
+
+// Convert a tenant state in the database to the protocol representation.
+func newTenantState(state string) pb.TenantState {
+	for pbState, dbState := range tenantStates {
+		if dbState == state {
+			return pbState
+		}
+	}
+	return pb.TenantState_TENANT_STATE_UNSPECIFIED
+}
+
+func successUpdateTenantStateResponse(requestID string,
+	tenant *db.Tenant) *pb.UpdateTenantStateResponse {
+	metrics.MetricTenantStateUpdated.Inc()
+	return &pb.UpdateTenantStateResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "UpdateTenantState RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		State:      newTenantState(tenant.State),
+		UpdateTime: timestamppb.New(tenant.UpdatedAt),
+	}
+}
+
+func updateTenantStateErrorResponse(requestID string,
+	code codes.Code) *pb.UpdateTenantStateResponse {
+	switch code {
+	case codes.InvalidArgument:
+		metrics.MetricUpdateTenantStateBadRequests.Inc()
+	case codes.FailedPrecondition:
+		metrics.MetricUpdateTenantStateNotAllowedErrors.Inc()
+	default:
+		metrics.MetricUpdateTenantStateInternalErrors.Inc()
+	}
+
+	return &pb.UpdateTenantStateResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(code),
+			StatusMessage:   "UpdateTenantState RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
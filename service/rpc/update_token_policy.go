@@ -0,0 +1,219 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *DeviceSTSServer) UpdateTokenPolicy(ctx context.Context,
+	request *pb.UpdateTokenPolicyRequest) (*pb.UpdateTokenPolicyResponse, error) {
+
+	// Validate the request header and extract the request identifier for
+	// end-to-end request tracing.
+	requestID, ok := isValidRequestHeader(request.Header)
+	if !ok {
+		dstsLogger.Error("Invalid request header specified!")
+		return invalidUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	// Ensure the request specified a tenant ID, policy ID and the update.
+	if (request.Tid == "") || (request.PolicyId == "") ||
+		(request.Update == nil) {
+		dstsLogger.Error("Tenant ID, policy ID or update were not specified",
+			zap.String("Request ID", requestID),
+		)
+		return invalidUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	policy, err := db.GetTokenPolicy(requestID, request.Tid, request.PolicyId)
+	if err != nil {
+		dstsLogger.Error("Failed to get the token policy to be updated!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundUpdateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyUpdateTokenPolicyResponse(requestID), nil
+		}
+		return internalErrorUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	updated := false
+	for _, field := range request.GetUpdateMask().GetPaths() {
+		switch strings.ToLower(field) {
+		case "name":
+			policy.Name = request.Update.Name
+			updated = true
+
+		case "description":
+			policy.Description = request.Update.Description
+			updated = true
+
+		case "expression":
+			policy.Expression = request.Update.Expression
+			updated = true
+
+		case "enabled", "is_enabled":
+			policy.IsEnabled = request.Update.IsEnabled
+			updated = true
+
+		case "group_id":
+			policy.GroupId = request.Update.GroupId
+			updated = true
+
+		default:
+			dstsLogger.Error("Received invalid update request mask",
+				zap.String("Request ID", requestID),
+				zap.String("Policy ID", request.PolicyId),
+				zap.String("Tenant ID", request.Tid),
+				zap.String("Update mask", field),
+			)
+		}
+	}
+
+	// Check if the update has no valid updates specified.
+	if !updated {
+		dstsLogger.Error("No valid updates found in the request!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+		)
+		return invalidUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	err = policy.Validate()
+	if err == nil {
+		err = sts.ValidateTokenPolicyExpression(policy.Expression)
+	}
+	if err != nil {
+		dstsLogger.Error("Invalid token policy update specified!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		return invalidUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	err = policy.UpdateTokenPolicy(requestID)
+	if err != nil {
+		dstsLogger.Error("Failed to update the specified token policy!",
+			zap.String("Request ID", requestID),
+			zap.String("Policy ID", request.PolicyId),
+			zap.String("Tenant ID", request.Tid),
+			zap.Error(err),
+		)
+		if errors.Is(err, db.ErrNotFound) {
+			return notFoundUpdateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDuplicateEntry) {
+			return alreadyExistsUpdateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrInvalidRequest) {
+			// The device group to which the policy is assigned was not found.
+			return invalidUpdateTokenPolicyResponse(requestID), nil
+		}
+		if errors.Is(err, db.ErrDatabaseBusy) {
+			return serverBusyUpdateTokenPolicyResponse(requestID), nil
+		}
+		return internalErrorUpdateTokenPolicyResponse(requestID), nil
+	}
+
+	return successUpdateTokenPolicyResponse(requestID, policy), nil
+}
+
+func invalidUpdateTokenPolicyResponse(requestID string) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricUpdateTokenPolicyBadRequests.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.InvalidArgument),
+			StatusMessage:   "UpdateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func successUpdateTokenPolicyResponse(requestID string, policy *db.TokenPolicy) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricTokenPolicyUpdated.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.OK),
+			StatusMessage:   "UpdateTokenPolicy RPC successful",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+		Policy: newTokenPolicy(policy),
+	}
+}
+
+func notFoundUpdateTokenPolicyResponse(requestID string) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricUpdateTokenPolicyNotFoundErrors.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.NotFound),
+			StatusMessage:   "UpdateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func alreadyExistsUpdateTokenPolicyResponse(requestID string) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricUpdateTokenPolicyBadRequests.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.AlreadyExists),
+			StatusMessage:   "UpdateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func serverBusyUpdateTokenPolicyResponse(requestID string) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricUpdateTokenPolicyInternalErrors.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.ResourceExhausted),
+			StatusMessage:   "UpdateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
+
+func internalErrorUpdateTokenPolicyResponse(requestID string) *pb.UpdateTokenPolicyResponse {
+	metrics.MetricUpdateTokenPolicyInternalErrors.Inc()
+	return &pb.UpdateTokenPolicyResponse{
+		Header: &pb.DstsResponseHeader{
+			ProtocolVersion: DstsProtocolVersion,
+			Status:          uint32(codes.Internal),
+			StatusMessage:   "UpdateTokenPolicy RPC failed",
+			RequestId:       requestID,
+			ResponseTime:    timestamppb.Now(),
+		},
+	}
+}
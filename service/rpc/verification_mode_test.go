@@ -0,0 +1,68 @@
+// package github.com/HPInc/krypton-dsts/service/rpc
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package rpc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/HPInc/krypton-dsts/service/rest"
+	"github.com/HPInc/krypton-dsts/service/sts"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// Client assertions must be signed using RS512. Assertions signed using
+// another algorithm are rejected, unless the check is in report only mode or
+// off for the tenant of the device.
+func TestGetToken_VerificationModes(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		expected int
+	}{
+		{"enforce", uuid.NewString(), http.StatusUnauthorized},
+		{"report_only", testReportOnlyTenantID, http.StatusOK},
+		{"off", testVerificationOffTenantID, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		deviceCert, deviceID, pKey := createTestManagedDevice(t, test.tenantID, "")
+		if deviceCert == nil {
+			return
+		}
+
+		// Assertions signed using RS512 are accepted in every mode.
+		tokenResponse := doDeviceAuthentication(t, deviceID, deviceCert, pKey)
+		checkResponseCode(t, http.StatusOK, tokenResponse.Code)
+
+		tokenReq := newSignedDeviceTokenRequest(t, deviceID, deviceCert, pKey,
+			nil, jwt.SigningMethodRS256)
+		if tokenReq == nil {
+			return
+		}
+		tokenResponse = rest.ExecuteTestRequest(tokenReq,
+			rest.DeviceAuthenticationHandler)
+		if tokenResponse.Code != test.expected {
+			t.Errorf("TestGetToken_VerificationModes: %s: expected status %d, got %d",
+				test.name, test.expected, tokenResponse.Code)
+			continue
+		}
+		if test.expected != http.StatusOK {
+			continue
+		}
+
+		// The device is issued a device access token.
+		var token rest.TokenResponse
+		_ = parseJSONResponse(t, tokenResponse.Body, &token)
+		claims, err := sts.VerifyDeviceAccessToken("test", token.AccessToken)
+		if err != nil {
+			t.Errorf("TestGetToken_VerificationModes: %s: failed to verify device access token: %v",
+				test.name, err)
+			continue
+		}
+		assertEqual(t, claims.Subject, deviceID)
+	}
+}
@@ -5,6 +5,7 @@
 package sts
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/HPInc/krypton-dsts/service/db"
@@ -23,23 +24,32 @@ type AppTokenClaims struct {
 	//  - device: device access tokens
 	//  - app: app access token
 	TokenType string `json:"typ"`
+
+	// Space delimited list of scopes issued to the app.
+	Scope string `json:"scope,omitempty"`
+
+	// Tenants the app is allowed to act upon. If not present, the app is not
+	// restricted to specific tenants.
+	AllowedTenants []string `json:"tids,omitempty"`
 }
 
 // Create a new app access token and sign it using the token signing key.
-func NewAppAccessToken(requestID string, app *db.RegisteredApp) (string,
-	time.Time, error) {
+func NewAppAccessToken(requestID string, app *db.RegisteredApp,
+	scopes []string) (string, time.Time, error) {
 	// Initialize the list of claims returned in the access token.
 	issuedTime := time.Now()
 	claims := AppTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        uuid.NewString(),
-			Issuer:    dstsIssuerName,
+			Issuer:    tokenIssuer,
 			IssuedAt:  jwt.NewNumericDate(issuedTime),
 			NotBefore: jwt.NewNumericDate(issuedTime),
 			ExpiresAt: jwt.NewNumericDate(issuedTime.Add(appAccessTokenLifetime)),
 			Subject:   app.AppId,
 		},
-		TokenType: TokenTypeAppAccessToken,
+		TokenType:      TokenTypeAppAccessToken,
+		Scope:          formatScope(scopes),
+		AllowedTenants: app.AllowedTenants,
 	}
 
 	// Construct a new JWT with the claims within it.
@@ -59,3 +69,78 @@ func NewAppAccessToken(requestID string, app *db.RegisteredApp) (string,
 
 	return tokenString, claims.RegisteredClaims.ExpiresAt.Time, nil
 }
+
+// VerifyAppAccessToken - parse and verify an app access token issued by the
+// DSTS. The signature on the token is verified using the token signing key.
+func VerifyAppAccessToken(requestID string, accessToken string) (*AppTokenClaims,
+	error) {
+	parsedToken, err := jwt.ParseWithClaims(accessToken, &AppTokenClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			// Check if the signing method used to sign the token is
+			// acceptable.
+			if token.Method != jwt.SigningMethodRS512 {
+				return nil, fmt.Errorf("unexpected access token signing method: %v",
+					token.Header["alg"])
+			}
+
+			// Ensure the token was signed using the token signing key.
+			if token.Header["kid"] != tokenSigningKeyID {
+				return nil, fmt.Errorf("unknown access token signing key: %v",
+					token.Header["kid"])
+			}
+			return tokenVerificationKey, nil
+		})
+	if err != nil {
+		dstsLogger.Error("Failed to parse and validate the presented app access token",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	claims, ok := parsedToken.Claims.(*AppTokenClaims)
+	if !ok || !parsedToken.Valid {
+		dstsLogger.Error("Failed to validate the presented app access token",
+			zap.String("Request ID: ", requestID),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	// Only app access tokens issued by the DSTS are accepted.
+	if (claims.TokenType != TokenTypeAppAccessToken) ||
+		(claims.Issuer != tokenIssuer) {
+		dstsLogger.Error("Presented access token is not an app access token!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Token type: ", claims.TokenType),
+			zap.String("Issuer: ", claims.Issuer),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	return claims, nil
+}
+
+// HasScope - check whether the specified scope was issued in the app access
+// token.
+func (c *AppTokenClaims) HasScope(scope string) bool {
+	for _, item := range parseScope(c.Scope) {
+		if item == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTenantAllowed - check whether the app is allowed to act upon the
+// specified tenant.
+func (c *AppTokenClaims) IsTenantAllowed(tenantID string) bool {
+	if len(c.AllowedTenants) == 0 {
+		return true
+	}
+	for _, item := range c.AllowedTenants {
+		if item == tenantID {
+			return true
+		}
+	}
+	return false
+}
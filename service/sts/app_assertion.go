@@ -16,7 +16,7 @@ import (
 )
 
 func GetAccessTokenFromAppAssertion(requestID string, appID string,
-	assertion string) (string, time.Time, error) {
+	assertion string, requestedScope string) (string, string, time.Time, error) {
 	var foundApp *db.RegisteredApp
 
 	// Parse the provided client assertion.
@@ -73,7 +73,7 @@ func GetAccessTokenFromAppAssertion(requestID string, appID string,
 				zap.String("App ID: ", appID),
 				zap.Error(err),
 			)
-			return "", time.Now(), ErrAssertionExpired
+			return "", "", time.Now(), ErrAssertionExpired
 		}
 		if errors.Is(err, jwt.ErrTokenNotValidYet) {
 			dstsLogger.Error("Presented client assertion is not yet valid",
@@ -81,14 +81,14 @@ func GetAccessTokenFromAppAssertion(requestID string, appID string,
 				zap.String("App ID: ", appID),
 				zap.Error(err),
 			)
-			return "", time.Now(), ErrAssertionNotValidYet
+			return "", "", time.Now(), ErrAssertionNotValidYet
 		}
 		dstsLogger.Error("Failed to parse and validate the presented client assertion",
 			zap.String("Request ID: ", requestID),
 			zap.String("App ID: ", appID),
 			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", "", time.Now(), err
 	}
 
 	if !parsedAssertion.Valid {
@@ -96,14 +96,14 @@ func GetAccessTokenFromAppAssertion(requestID string, appID string,
 			zap.String("Request ID: ", requestID),
 			zap.String("App ID: ", appID),
 		)
-		return "", time.Now(), fmt.Errorf("assertion is not valid")
+		return "", "", time.Now(), fmt.Errorf("assertion is not valid")
 	}
 
 	// Extract claims from the parsed assertion.
 	claims, ok := parsedAssertion.Claims.(*AssertionClaims)
 	if !ok {
 		dstsLogger.Error("Failed to retrieve nonce claim from client assertion")
-		return "", time.Now(), fmt.Errorf("failed to get nonce claim from client assertion")
+		return "", "", time.Now(), fmt.Errorf("failed to get nonce claim from client assertion")
 	}
 
 	// Compare the nonce claim in the client assertion with the challenge that
@@ -115,27 +115,33 @@ func GetAccessTokenFromAppAssertion(requestID string, appID string,
 			zap.String("App ID: ", appID),
 			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", "", time.Now(), err
 	}
 	if appChallenge != claims.Nonce {
 		dstsLogger.Error("Invalid nonce value in presented client assertion!",
 			zap.String("Request ID: ", requestID),
 			zap.String("App ID: ", appID),
-			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", "", time.Now(), ErrInvalidDeviceChallenge
+	}
+
+	// Determine the scopes to be issued in the access token. Only scopes that
+	// have been granted to the app are issued.
+	scopes, err := getGrantedScopes(requestID, foundApp, requestedScope)
+	if err != nil {
+		return "", "", time.Now(), err
 	}
 
 	// Generate a new app access token.
-	accessToken, expiresAt, err := NewAppAccessToken(requestID, foundApp)
+	accessToken, expiresAt, err := NewAppAccessToken(requestID, foundApp, scopes)
 	if err != nil {
 		dstsLogger.Error("Failed to generate a new app access token!",
 			zap.String("Request ID: ", requestID),
 			zap.String("App ID: ", appID),
 			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", "", time.Now(), err
 	}
 
-	return accessToken, expiresAt, nil
+	return accessToken, formatScope(scopes), expiresAt, nil
 }
@@ -0,0 +1,93 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+const (
+	// Maximum validity period (exp - iat) of a challenge-less client assertion.
+	challengelessAssertionMaxLifetime = (time.Minute * 5)
+
+	// Maximum clock skew tolerated between the device and the DSTS.
+	challengelessAssertionMaxClockSkew = (time.Minute * 1)
+)
+
+var (
+	// Tenants whose devices are allowed to authenticate using challenge-less
+	// client assertions.
+	challengelessTenants = map[string]bool{}
+)
+
+// Initialize the list of tenants for which challenge-less device
+// authentication is enabled.
+func initChallengelessTenants(tenants []config.TenantConfig) {
+	for _, tenant := range tenants {
+		if tenant.ChallengelessAuthentication {
+			challengelessTenants[tenant.Id] = true
+		}
+	}
+}
+
+// Check whether devices belonging to the specified tenant are allowed to
+// authenticate using challenge-less client assertions.
+func isChallengelessAuthenticationEnabled(tenantID string) bool {
+	return challengelessTenants[tenantID]
+}
+
+// Verify a client assertion presented by a device without a DSTS issued
+// challenge. In place of the challenge, the assertion must carry a unique
+// 'jti' claim and must be valid for a short period of time. Single use of the
+// assertion is enforced by recording its 'jti' in the replay cache.
+func verifyChallengelessAssertion(requestID string, device *db.Device,
+	claims *AssertionClaims) error {
+	if (claims.ID == "") || (claims.IssuedAt == nil) || (claims.ExpiresAt == nil) {
+		dstsLogger.Error("Challenge-less assertion is missing the jti, iat or exp claims!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+		)
+		return ErrInvalidAssertion
+	}
+
+	// The assertion must have been issued recently and must be short-lived.
+	// Expired assertions have already been rejected during parsing.
+	issuedAt := claims.IssuedAt.Time
+	expiresAt := claims.ExpiresAt.Time
+	if issuedAt.After(time.Now().Add(challengelessAssertionMaxClockSkew)) ||
+		!expiresAt.After(issuedAt) ||
+		(expiresAt.Sub(issuedAt) > challengelessAssertionMaxLifetime) {
+		dstsLogger.Error("Challenge-less assertion validity period is not acceptable!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+			zap.Time("Issued at: ", issuedAt),
+			zap.Time("Expires at: ", expiresAt),
+		)
+		return ErrInvalidAssertion
+	}
+
+	// Protect against replay of the assertion. The entry is retained until
+	// the assertion expires.
+	added, err := cache.AddAssertionID(requestID, device.TenantId,
+		device.DeviceId, claims.ID,
+		time.Until(expiresAt)+challengelessAssertionMaxClockSkew)
+	if err != nil {
+		return err
+	}
+	if !added {
+		dstsLogger.Error("Replayed challenge-less assertion was presented!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+			zap.String("jti: ", claims.ID),
+		)
+		return ErrAssertionReplayed
+	}
+	return nil
+}
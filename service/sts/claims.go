@@ -0,0 +1,315 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Device attributes that can be asserted as claims in device access tokens.
+// The name of the attribute is also the name of the claim.
+const (
+	DeviceClaimHardwareHash   = "hardware_hash"
+	DeviceClaimCertThumbprint = "cert_thumbprint"
+	DeviceClaimCertIssuedAt   = "cert_issued_at"
+	DeviceClaimCertExpiresAt  = "cert_expires_at"
+	DeviceClaimEnrolledAt     = "enrolled_at"
+	DeviceClaimLabels         = "labels"
+
+	// Defaults for invoking claims enrichers.
+	defaultClaimsEnricherTimeout  = (time.Millisecond * 500)
+	defaultClaimsEnricherCacheTtl = (time.Minute * 5)
+
+	// Duration for which failures of claims enrichers are cached, so that an
+	// unavailable management service is not invoked for every access token.
+	claimsEnricherFailureCacheTtl = (time.Second * 30)
+)
+
+// Functions returning the value of device attributes that can be asserted as
+// claims. Timestamps are asserted as seconds since the epoch, and labels as a
+// JSON object.
+var deviceClaimValues = map[string]func(*db.Device) interface{}{
+	DeviceClaimHardwareHash: func(d *db.Device) interface{} {
+		return d.HardwareHash
+	},
+	DeviceClaimCertThumbprint: func(d *db.Device) interface{} {
+		return d.CertificateThumbprint
+	},
+	DeviceClaimCertIssuedAt: func(d *db.Device) interface{} {
+		return d.CertificateIssuedAt.Unix()
+	},
+	DeviceClaimCertExpiresAt: func(d *db.Device) interface{} {
+		return d.CertificateExpiresAt.Unix()
+	},
+	DeviceClaimEnrolledAt: func(d *db.Device) interface{} {
+		return d.CreatedAt.Unix()
+	},
+	DeviceClaimLabels: func(d *db.Device) interface{} {
+		if d.Labels == nil {
+			return map[string]string{}
+		}
+		return d.Labels
+	},
+}
+
+// Claims asserted by the DSTS which cannot be overridden by the claims
+// pipeline.
+var reservedClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "exp": true, "nbf": true,
+	"iat": true, "jti": true, "typ": true, "tid": true, "ms": true,
+	"cnf": true, "scope": true,
+}
+
+// ClaimsEnricher - supplies additional claims for device access tokens, for
+// example by querying the management service responsible for the device.
+// Enrichers must be registered using RegisterClaimsEnricher before the STS is
+// initialized, and are enabled per tenant in the configuration file.
+type ClaimsEnricher interface {
+	// Unique name of the enricher, used to reference it in the configuration.
+	Name() string
+
+	// Return the claims to be asserted in the access token issued to the
+	// specified device. Implementations must honor cancellation of the
+	// context.
+	GetClaims(ctx context.Context, device *db.Device) (map[string]interface{}, error)
+}
+
+// tenantClaimsPolicy - the claims asserted in device access tokens issued to
+// devices belonging to a tenant.
+type tenantClaimsPolicy struct {
+	deviceClaims  []string
+	enrichers     []ClaimsEnricher
+	postureClaims []string
+}
+
+var (
+	// Registered claims enrichers, indexed by name.
+	claimsEnrichers     = map[string]ClaimsEnricher{}
+	claimsEnrichersLock sync.RWMutex
+
+	// Claims policy configured for tenants, indexed by tenant ID.
+	tenantClaimsPolicies = map[string]*tenantClaimsPolicy{}
+
+	claimsEnricherTimeout  = defaultClaimsEnricherTimeout
+	claimsEnricherCacheTtl = defaultClaimsEnricherCacheTtl
+)
+
+// RegisterClaimsEnricher - register a claims enricher, so it can be enabled for
+// tenants in the configuration file.
+func RegisterClaimsEnricher(enricher ClaimsEnricher) error {
+	claimsEnrichersLock.Lock()
+	defer claimsEnrichersLock.Unlock()
+
+	if _, ok := claimsEnrichers[enricher.Name()]; ok {
+		return fmt.Errorf("claims enricher %s is already registered",
+			enricher.Name())
+	}
+	claimsEnrichers[enricher.Name()] = enricher
+	return nil
+}
+
+// Initialize the claims policy for tenants listed in the configuration file.
+func initClaimsPipeline(cfgMgr *config.ConfigMgr) error {
+	tokenConfig := cfgMgr.GetTokenConfig()
+	if tokenConfig.ClaimsEnricherTimeout != 0 {
+		claimsEnricherTimeout = tokenConfig.ClaimsEnricherTimeout
+	}
+	if tokenConfig.ClaimsEnricherCacheTtl != 0 {
+		claimsEnricherCacheTtl = tokenConfig.ClaimsEnricherCacheTtl
+	}
+
+	claimsEnrichersLock.RLock()
+	defer claimsEnrichersLock.RUnlock()
+
+	for _, tenant := range cfgMgr.GetTenants() {
+		if (len(tenant.DeviceClaims) == 0) && (len(tenant.ClaimsEnrichers) == 0) &&
+			(len(tenant.PostureClaims) == 0) {
+			continue
+		}
+
+		policy := &tenantClaimsPolicy{}
+		for _, claim := range tenant.DeviceClaims {
+			if _, ok := deviceClaimValues[claim]; !ok {
+				return fmt.Errorf("unsupported device claim %s configured for tenant %s",
+					claim, tenant.Id)
+			}
+			policy.deviceClaims = append(policy.deviceClaims, claim)
+		}
+		for _, name := range tenant.ClaimsEnrichers {
+			enricher, ok := claimsEnrichers[name]
+			if !ok {
+				return fmt.Errorf("unknown claims enricher %s configured for tenant %s",
+					name, tenant.Id)
+			}
+			policy.enrichers = append(policy.enrichers, enricher)
+		}
+		for _, claim := range tenant.PostureClaims {
+			if _, ok := postureClaimValues[claim]; !ok {
+				return fmt.Errorf("unsupported posture claim %s configured for tenant %s",
+					claim, tenant.Id)
+			}
+			policy.postureClaims = append(policy.postureClaims, claim)
+		}
+		tenantClaimsPolicies[tenant.Id] = policy
+	}
+	return nil
+}
+
+// Return the additional claims to be asserted in the device access token
+// issued to the specified device, as configured for the device's tenant. The
+// posture claim takes precedence over claims supplied by claims enrichers.
+// Claims enrichers are invoked concurrently, and those that fail or do not
+// respond within the claims enricher timeout are skipped, so that a slow or
+// unavailable management service does not block device authentication.
+func getAdditionalDeviceClaims(requestID string,
+	device *db.Device) map[string]interface{} {
+	policy, ok := tenantClaimsPolicies[device.TenantId]
+	if !ok {
+		return nil
+	}
+
+	claims := map[string]interface{}{}
+	for _, claim := range policy.deviceClaims {
+		claims[claim] = deviceClaimValues[claim](device)
+	}
+
+	// Invoke the claims enrichers with a single deadline. Claims are merged
+	// in the configured order of the enrichers, so later enrichers take
+	// precedence over earlier ones.
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		claimsEnricherTimeout)
+	defer cancelFunc()
+
+	enricherResults := make([]enricherResult, len(policy.enrichers))
+	var wg sync.WaitGroup
+	for i, enricher := range policy.enrichers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			enricherResults[i] = getEnrichedClaims(ctx, requestID, enricher,
+				device)
+		}()
+	}
+	wg.Wait()
+
+	for i, result := range enricherResults {
+		if result.err != nil {
+			dstsLogger.Warn("Failed to obtain claims from claims enricher!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Enricher: ", policy.enrichers[i].Name()),
+				zap.String("Device ID: ", device.DeviceId),
+				zap.Error(result.err),
+			)
+			continue
+		}
+		for name, value := range result.claims {
+			if reservedClaims[name] {
+				continue
+			}
+			claims[name] = value
+		}
+	}
+
+	// Copy the posture last reported by the device. Devices that have not
+	// reported their posture do not get a posture claim.
+	if len(policy.postureClaims) != 0 {
+		postureClaim, err := getDevicePostureClaim(requestID, device,
+			policy.postureClaims)
+		if err == nil {
+			claims[PostureClaim] = postureClaim
+		} else if !errors.Is(err, db.ErrNotFound) {
+			dstsLogger.Warn("Failed to obtain the device posture claim!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Device ID: ", device.DeviceId),
+				zap.Error(err),
+			)
+		}
+	}
+	return claims
+}
+
+// Result of invoking a claims enricher.
+type enricherResult struct {
+	claims map[string]interface{}
+	err    error
+}
+
+// Return the claims supplied by the enricher for the specified device, or an
+// error if the enricher does not respond before the context is done. Claims
+// are served from the cache if available. Failures of the enricher are cached
+// briefly as an empty set of claims, and the enricher is not invoked again
+// until the cache entry expires.
+func getEnrichedClaims(ctx context.Context, requestID string,
+	enricher ClaimsEnricher, device *db.Device) enricherResult {
+	resultChan := make(chan enricherResult, 1)
+	go func() {
+		var claims map[string]interface{}
+		cacheEntry, err := cache.GetDeviceClaims(requestID, enricher.Name(),
+			device.TenantId, device.DeviceId)
+		if err == nil {
+			err = json.Unmarshal(cacheEntry, &claims)
+			if err == nil {
+				resultChan <- enricherResult{claims: claims}
+				return
+			}
+		}
+
+		// The result of the enricher is cached even if it responds after the
+		// deadline, so that it is available to subsequent requests.
+		claims, err = enricher.GetClaims(ctx, device)
+		if err != nil {
+			cache.AddDeviceClaims(requestID, enricher.Name(), device.TenantId,
+				device.DeviceId, nil, claimsEnricherFailureCacheTtl)
+		} else {
+			cache.AddDeviceClaims(requestID, enricher.Name(), device.TenantId,
+				device.DeviceId, claims, claimsEnricherCacheTtl)
+		}
+		resultChan <- enricherResult{claims: claims, err: err}
+	}()
+
+	select {
+	case result := <-resultChan:
+		if (result.err != nil) && (ctx.Err() != nil) {
+			return enricherResult{err: ErrClaimsEnricherTimeout}
+		}
+		return result
+
+	case <-ctx.Done():
+		return enricherResult{err: ErrClaimsEnricherTimeout}
+	}
+}
+
+// MarshalJSON - marshal the device token claims, including any additional
+// claims obtained from the claims pipeline.
+func (c DeviceTokenClaims) MarshalJSON() ([]byte, error) {
+	type deviceTokenClaims DeviceTokenClaims
+	encoded, err := json.Marshal(deviceTokenClaims(c))
+	if err != nil || len(c.AdditionalClaims) == 0 {
+		return encoded, err
+	}
+
+	var claims map[string]interface{}
+	err = json.Unmarshal(encoded, &claims)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range c.AdditionalClaims {
+		if _, found := claims[name]; found || reservedClaims[name] {
+			continue
+		}
+		claims[name] = value
+	}
+	return json.Marshal(claims)
+}
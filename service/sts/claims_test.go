@@ -0,0 +1,115 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Claims enricher returning the configured claims after a delay.
+type testClaimsEnricher struct {
+	name   string
+	delay  time.Duration
+	claims map[string]interface{}
+	err    error
+}
+
+func (e *testClaimsEnricher) Name() string {
+	return e.name
+}
+
+func (e *testClaimsEnricher) GetClaims(ctx context.Context,
+	device *db.Device) (map[string]interface{}, error) {
+	select {
+	case <-time.After(e.delay):
+		return e.claims, e.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Configure the claims enrichers for the test tenant.
+func initTestClaimsEnrichers(t *testing.T, timeout time.Duration,
+	enrichers ...ClaimsEnricher) *db.Device {
+	dstsLogger = zap.NewNop()
+	claimsEnricherTimeout = timeout
+	tenantClaimsPolicies = map[string]*tenantClaimsPolicy{
+		"tenant-1": {enrichers: enrichers},
+	}
+	t.Cleanup(func() {
+		claimsEnricherTimeout = defaultClaimsEnricherTimeout
+		tenantClaimsPolicies = map[string]*tenantClaimsPolicy{}
+	})
+	return &db.Device{DeviceId: "device-1", TenantId: "tenant-1"}
+}
+
+func TestGetAdditionalDeviceClaims_ConcurrentEnrichers(t *testing.T) {
+	// Each enricher responds within the timeout, but not if they are invoked
+	// one after another.
+	device := initTestClaimsEnrichers(t, time.Millisecond*300,
+		&testClaimsEnricher{name: "enricher-1", delay: time.Millisecond * 200,
+			claims: map[string]interface{}{"region": "us", "site": "a"}},
+		&testClaimsEnricher{name: "enricher-2", delay: time.Millisecond * 200,
+			claims: map[string]interface{}{"site": "b", "sub": "override"}},
+	)
+
+	claims := getAdditionalDeviceClaims("test", device)
+	if len(claims) != 2 {
+		t.Errorf("TestGetAdditionalDeviceClaims_ConcurrentEnrichers: unexpected claims %v",
+			claims)
+		return
+	}
+
+	// Later enrichers take precedence, and reserved claims are not asserted.
+	if (claims["region"] != "us") || (claims["site"] != "b") {
+		t.Errorf("TestGetAdditionalDeviceClaims_ConcurrentEnrichers: unexpected claims %v",
+			claims)
+	}
+}
+
+func TestGetAdditionalDeviceClaims_Deadline(t *testing.T) {
+	// Enrichers that fail or do not respond before the deadline are skipped,
+	// and do not delay the claims of other enrichers beyond the deadline.
+	device := initTestClaimsEnrichers(t, time.Millisecond*200,
+		&testClaimsEnricher{name: "enricher-1", delay: time.Millisecond * 150,
+			claims: map[string]interface{}{"region": "us"}},
+		&testClaimsEnricher{name: "enricher-2", delay: time.Millisecond * 150,
+			err: errors.New("management service unavailable")},
+		&testClaimsEnricher{name: "enricher-3", delay: time.Hour,
+			claims: map[string]interface{}{"site": "a"}},
+		&testClaimsEnricher{name: "enricher-4", delay: time.Millisecond * 150,
+			claims: map[string]interface{}{"site": "b"}},
+	)
+
+	start := time.Now()
+	claims := getAdditionalDeviceClaims("test", device)
+	if elapsed := time.Since(start); elapsed > time.Millisecond*400 {
+		t.Errorf("TestGetAdditionalDeviceClaims_Deadline: claims returned after %v",
+			elapsed)
+	}
+	if (len(claims) != 2) || (claims["region"] != "us") || (claims["site"] != "b") {
+		t.Errorf("TestGetAdditionalDeviceClaims_Deadline: unexpected claims %v", claims)
+	}
+}
+
+func TestGetEnrichedClaims_Timeout(t *testing.T) {
+	device := initTestClaimsEnrichers(t, time.Millisecond*100)
+	ctx, cancelFunc := context.WithTimeout(context.Background(),
+		time.Millisecond*100)
+	defer cancelFunc()
+
+	result := getEnrichedClaims(ctx, "test",
+		&testClaimsEnricher{name: "enricher-1", delay: time.Hour}, device)
+	if !errors.Is(result.err, ErrClaimsEnricherTimeout) {
+		t.Errorf("TestGetEnrichedClaims_Timeout: expected %v, got %v",
+			ErrClaimsEnricherTimeout, result.err)
+	}
+}
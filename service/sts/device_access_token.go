@@ -5,6 +5,7 @@
 package sts
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/HPInc/krypton-dsts/service/db"
@@ -14,11 +15,15 @@ import (
 )
 
 const (
-	// Token lifetime for device & app access tokens issued by the DSTS.
-	deviceAccessTokenLifetime = (time.Hour * 1)
-	appAccessTokenLifetime    = (time.Hour * 3)
+	// Default token lifetime for device & app access tokens issued by the DSTS.
+	defaultDeviceAccessTokenLifetime = (time.Hour * 1)
+	defaultAppAccessTokenLifetime    = (time.Hour * 3)
 
-	// Access token issuer name.
+	// Range of configurable access token lifetimes.
+	minAccessTokenLifetime = (time.Minute * 5)
+	maxAccessTokenLifetime = (time.Hour * 24)
+
+	// Default access token issuer name.
 	dstsIssuerName = "HP Device Token Service"
 
 	// Represents devices that are not currently being managed by any
@@ -28,6 +33,7 @@ const (
 	// Token types - asserted as values of the 'typ' claim.
 	TokenTypeDeviceAccessToken = "device"
 	TokenTypeAppAccessToken    = "app"
+	TokenTypeQuarantineToken   = "quarantine"
 )
 
 type DeviceTokenClaims struct {
@@ -39,6 +45,7 @@ type DeviceTokenClaims struct {
 	// Type of token. Possible values are:
 	//  - device: device access tokens
 	//  - app: app access token
+	//  - quarantine: quarantine tokens issued to lost devices
 	TokenType string `json:"typ"`
 
 	// The ID of the tenant to which the device belongs.
@@ -46,24 +53,59 @@ type DeviceTokenClaims struct {
 
 	// The device management service responsible for managing this device.
 	ManagementService string `json:"ms"`
+
+	// Space separated scopes to which the token is restricted. Only asserted
+	// in quarantine tokens issued to lost devices.
+	Scope string `json:"scope,omitempty"`
+
+	// Confirmation claim binding the access token to a proof-of-possession
+	// key or certificate held by the device. Not present for bearer tokens.
+	Confirmation *ConfirmationClaim `json:"cnf,omitempty"`
+
+	// Additional claims configured for the device's tenant, comprising device
+	// attributes and claims obtained from claims enrichers.
+	AdditionalClaims map[string]interface{} `json:"-"`
+}
+
+// ConfirmationClaim - the 'cnf' claim in sender constrained access tokens.
+type ConfirmationClaim struct {
+	// Base64url encoded SHA-256 thumbprint of the device certificate to which
+	// the access token is bound (RFC 8705).
+	X5tS256 string `json:"x5t#S256,omitempty"`
+
+	// Base64url encoded JWK thumbprint of the device key used to sign DPoP
+	// proofs, to which the access token is bound (RFC 9449).
+	Jkt string `json:"jkt,omitempty"`
 }
 
-// Create a new device access token and sign it using the token signing key.
-func NewDeviceAccessToken(requestID string, device *db.Device) (string,
-	time.Time, error) {
+// Create a new device access token and sign it using the token signing key
+// of the tenant to which the device belongs. Unless the certificate validity
+// check is relaxed for the tenant, the access token does not expire after the
+// device certificate presented by the device, which expires at certExpiresAt.
+// If a confirmation claim is specified, the access token is bound to the
+// proof-of-possession key or certificate it identifies.
+func NewDeviceAccessToken(requestID string, device *db.Device,
+	certExpiresAt time.Time, confirmation *ConfirmationClaim) (string, time.Time, error) {
+	// Tokens for tenants with a dedicated issuer or signing key are issued
+	// and signed accordingly.
+	signer := getTokenSigner(device.TenantId)
+
 	// Initialize the list of claims returned in the access token.
 	issuedTime := time.Now()
+	expiresAt := getDeviceAccessTokenExpiry(device, certExpiresAt, issuedTime)
 	claims := DeviceTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        uuid.NewString(),
-			Issuer:    dstsIssuerName,
+			Issuer:    signer.issuer,
 			IssuedAt:  jwt.NewNumericDate(issuedTime),
 			NotBefore: jwt.NewNumericDate(issuedTime),
-			ExpiresAt: jwt.NewNumericDate(issuedTime.Add(deviceAccessTokenLifetime)),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			Subject:   device.DeviceId,
 		},
-		TokenType: TokenTypeDeviceAccessToken,
-		TenantID:  device.TenantId,
+		TokenType:        TokenTypeDeviceAccessToken,
+		TenantID:         device.TenantId,
+		Confirmation:     confirmation,
+		AdditionalClaims: getAdditionalDeviceClaims(requestID, device),
 	}
 
 	// If the device is being managed, assert the name of the management service
@@ -74,10 +116,10 @@ func NewDeviceAccessToken(requestID string, device *db.Device) (string,
 
 	// Construct a new JWT with the claims within it.
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
-	token.Header["kid"] = tokenSigningKeyID
+	token.Header["kid"] = signer.keyID
 
 	// Sign the JWT using the token signing key.
-	tokenString, err := token.SignedString(tokenSigningKey)
+	tokenString, err := token.SignedString(signer.signingKey)
 	if err != nil {
 		dstsLogger.Error("Failed to sign new device access token!",
 			zap.String("Request ID: ", requestID),
@@ -89,3 +131,96 @@ func NewDeviceAccessToken(requestID string, device *db.Device) (string,
 
 	return tokenString, claims.RegisteredClaims.ExpiresAt.Time, nil
 }
+
+// VerifyDeviceAccessToken - parse and verify a device access token issued by
+// the DSTS. The signature on the token is verified using the signing key of
+// the tenant to which the device belongs. Quarantine tokens issued to lost
+// devices, and tokens issued before the device was transferred out of the
+// tenant, are rejected.
+func VerifyDeviceAccessToken(requestID string, accessToken string) (*DeviceTokenClaims,
+	error) {
+	claims, err := verifyDeviceToken(requestID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != TokenTypeDeviceAccessToken {
+		dstsLogger.Error("Presented access token is not a device access token!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Token type: ", claims.TokenType),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+	return claims, nil
+}
+
+// Parse a device token issued by the DSTS and verify its signature and issuer
+// using the token signer of the tenant to which the device belongs.
+func verifyDeviceToken(requestID string, accessToken string) (*DeviceTokenClaims,
+	error) {
+	parsedToken, err := jwt.ParseWithClaims(accessToken, &DeviceTokenClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			// Check if the signing method used to sign the token is
+			// acceptable.
+			if token.Method != jwt.SigningMethodRS512 {
+				return nil, fmt.Errorf("unexpected access token signing method: %v",
+					token.Header["alg"])
+			}
+
+			// Ensure the token was signed using the signing key of the
+			// device's tenant.
+			claims, ok := token.Claims.(*DeviceTokenClaims)
+			if !ok {
+				return nil, ErrInvalidAccessToken
+			}
+			signer := getTokenSigner(claims.TenantID)
+			if token.Header["kid"] != signer.keyID {
+				return nil, fmt.Errorf("unknown access token signing key: %v",
+					token.Header["kid"])
+			}
+			return &signer.signingKey.PublicKey, nil
+		})
+	if err != nil {
+		dstsLogger.Error("Failed to parse and validate the presented device access token",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	claims, ok := parsedToken.Claims.(*DeviceTokenClaims)
+	if !ok || !parsedToken.Valid {
+		dstsLogger.Error("Failed to validate the presented device access token",
+			zap.String("Request ID: ", requestID),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	if claims.Issuer != getTokenSigner(claims.TenantID).issuer {
+		dstsLogger.Error("Presented device access token was issued by another issuer!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Issuer: ", claims.Issuer),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+
+	// Tokens issued to the device before it was transferred out of the
+	// tenant are no longer valid.
+	if claims.IssuedAt == nil {
+		return nil, ErrInvalidAccessToken
+	}
+	transferred, err := db.IsDeviceTransferredSince(requestID, claims.Subject,
+		claims.TenantID, claims.IssuedAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	if transferred {
+		dstsLogger.Error("Presented device access token was issued before the device was transferred!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", claims.Subject),
+			zap.String("Tenant ID: ", claims.TenantID),
+		)
+		return nil, ErrAccessTokenRevoked
+	}
+	return claims, nil
+}
@@ -5,7 +5,9 @@
 package sts
 
 import (
+	"crypto/rsa"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/HPInc/krypton-dsts/service/common"
 	"github.com/HPInc/krypton-dsts/service/db"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -25,11 +28,24 @@ type AssertionClaims struct {
 	// endpoint. This nonce is included in the signed assertion to protect
 	// against assertion replay attacks.
 	Nonce string `json:"nonce"`
+
+	// Posture - optional device posture attributes reported by the device,
+	// such as OS version and disk encryption state.
+	Posture json.RawMessage `json:"posture,omitempty"`
 }
 
-func GetAccessTokenFromDeviceAssertion(requestID string,
-	assertion string) (string, time.Time, error) {
-	var foundDevice *db.Device
+// GetAccessTokenFromDeviceAssertion - validate the client assertion presented
+// by the device and issue a device access token. If a DPoP proof is presented,
+// the access token is bound to the device key used to sign the proof. If device
+// refresh tokens are enabled, a refresh token is also issued to the device.
+func GetAccessTokenFromDeviceAssertion(requestID string, assertion string,
+	dpop *DpopRequest) (string, time.Time, string, error) {
+	var (
+		foundDevice    *db.Device
+		deviceKey      *rsa.PublicKey
+		certThumbprint string
+		certExpiresAt  time.Time
+	)
 
 	// Parse the provided client assertion.
 	parsedAssertion, err := jwt.ParseWithClaims(assertion, &AssertionClaims{},
@@ -38,14 +54,6 @@ func GetAccessTokenFromDeviceAssertion(requestID string,
 				ok            bool
 				deviceCertStr string
 			)
-			// Check if the signing method used to sign the assertion is
-			// acceptable.
-			if token.Method != jwt.SigningMethodRS512 {
-				dstsLogger.Error("Unexpected token signing algorithm",
-					zap.String("Algorithm specified", token.Method.Alg()),
-				)
-				return nil, ErrUnsupportedSigningAlg
-			}
 
 			x5c, ok := token.Header["x5c"]
 			if !ok {
@@ -88,95 +96,39 @@ func GetAccessTokenFromDeviceAssertion(requestID string,
 				return nil, err
 			}
 
-			// Perform a few verification checks on the device certificate
-			// extracted from the assertion header.
-			err = common.VerifyCertificate(deviceCert)
+			// Validate the device certificate against the device registered
+			// in the database.
+			foundDevice, err = getDeviceForCertificate(requestID, deviceCert)
 			if err != nil {
-				dstsLogger.Error("Failed to verify device certificate from assertion",
-					zap.String("Request ID: ", requestID),
-					zap.Error(err),
-				)
 				return nil, err
 			}
 
-			// Extract the device ID and the tenant ID from the certificate.
-			deviceID := deviceCert.Subject.CommonName
-			tenantID := ""
-			for _, item := range deviceCert.Subject.Names {
-				if item.Type.Equal([]int{2, 5, 4, 10}) {
-					tenantID = item.Value.(string)
-					break
+			// Check if the signing method used to sign the assertion is
+			// acceptable, in the mode configured for the tenant. The
+			// signature is verified by jwt.ParseWithClaims using the public
+			// key of the device certificate, regardless of the mode.
+			if token.Method != jwt.SigningMethodRS512 {
+				err = applyVerificationMode(requestID, foundDevice.TenantId,
+					foundDevice.DeviceId,
+					VerificationCheckAssertionSigningAlgorithm,
+					ErrUnsupportedSigningAlg)
+				if err != nil {
+					dstsLogger.Error("Unexpected token signing algorithm",
+						zap.String("Request ID: ", requestID),
+						zap.String("Algorithm specified", token.Method.Alg()),
+					)
+					return nil, err
 				}
 			}
-			if deviceID == "" || tenantID == "" {
-				dstsLogger.Error("Invalid device ID or tenant ID in device certificate",
-					zap.String("Request ID: ", requestID),
-					zap.String("Device ID: ", deviceID),
-					zap.String("Tenant ID: ", tenantID),
-					zap.Error(err),
-				)
-				return nil, ErrInvalidDeviceOrTenantId
-			}
 
-			// Retrieve information about the device from the database.
-			foundDevice, err = db.GetDevice(requestID, tenantID, deviceID)
-			if err != nil {
-				dstsLogger.Error("Failed to retrieve information about the device",
-					zap.String("Request ID: ", requestID),
-					zap.String("Device ID: ", deviceID),
-					zap.String("Tenant ID: ", tenantID),
-					zap.Error(err),
-				)
-				return nil, err
-			}
-
-			// If the device is marked disabled or has been reported lost, block
-			// device authentication - no token will be issued.
-			if (!foundDevice.IsEnabled) || (foundDevice.IsLost) {
-				dstsLogger.Error("Device authentication is blocked for disabled or lost device!",
-					zap.String("Request ID: ", requestID),
-					zap.String("Device ID: ", deviceID),
-					zap.String("Tenant ID: ", tenantID),
-					zap.Bool("Is Enabled: ", foundDevice.IsEnabled),
-					zap.Bool("Is Lost: ", foundDevice.IsLost),
-				)
-				return nil, db.ErrAuthnBlocked
-			}
+			deviceKey, _ = deviceCert.PublicKey.(*rsa.PublicKey)
+			certThumbprint = common.GetCertificateThumbprint(deviceCert)
+			certExpiresAt = deviceCert.NotAfter
 
-			// Validate the certificate thumbprint of the presented device
-			// certificate against that stored in the database. In the event that
-			// a device's certificate was recently renewed, allow the device to
-			// authenticate with its previous certificate. When the previous
-			// certificate is no longer valid, device authentication will stop
-			// working for that certificate.
-			deviceCertThumbprint := common.GetCertificateThumbprint(deviceCert)
-			switch deviceCertThumbprint {
-			case foundDevice.CertificateThumbprint:
-				if foundDevice.PreviousCertificateThumbprint != "" {
-					// The device has successfully used the current device certificate
-					// for device authentication. This signifies a successful rotation of
-					// the device certificate. Delete the pevious certificate thumbprint
-					// so the previous device certificate can no longer be used for device
-					// authentication.
-					_ = db.DeletePreviousCertificateThumbprint(requestID, foundDevice.DeviceId,
-						foundDevice.TenantId)
-				}
-				return deviceCert.PublicKey, nil
-			case foundDevice.PreviousCertificateThumbprint:
-				// Return the public key of the device signing certificate to be
-				// used to verify the client assertion. This public key is used
-				// by jwt.ParseWithClaims to verify the signature on the assertion.
-				return deviceCert.PublicKey, nil
-			default:
-				dstsLogger.Error("Provided device certificate doesn't match that in database!",
-					zap.String("Request ID: ", requestID),
-					zap.String("Device ID: ", deviceID),
-					zap.String("Tenant ID: ", tenantID),
-					zap.String("Thumbprint in database: ", foundDevice.CertificateThumbprint),
-					zap.String("Thumbprint from assertion: ", deviceCertThumbprint),
-				)
-				return nil, ErrInvalidDeviceCertificate
-			}
+			// Return the public key of the device signing certificate to be
+			// used to verify the client assertion. This public key is used
+			// by jwt.ParseWithClaims to verify the signature on the assertion.
+			return deviceCert.PublicKey, nil
 		})
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -184,64 +136,130 @@ func GetAccessTokenFromDeviceAssertion(requestID string,
 				zap.String("Request ID: ", requestID),
 				zap.Error(err),
 			)
-			return "", time.Now(), ErrAssertionExpired
+			return "", time.Now(), "", ErrAssertionExpired
 		}
 		if errors.Is(err, jwt.ErrTokenNotValidYet) {
 			dstsLogger.Error("Presented client assertion is not yet valid",
 				zap.String("Request ID: ", requestID),
 				zap.Error(err),
 			)
-			return "", time.Now(), ErrAssertionNotValidYet
+			return "", time.Now(), "", ErrAssertionNotValidYet
 		}
 		dstsLogger.Error("Failed to parse and validate the presented client assertion",
 			zap.String("Request ID: ", requestID),
 			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", time.Now(), "", err
 	}
 
 	if !parsedAssertion.Valid {
 		dstsLogger.Error("Failed to validate the presented client assertion",
 			zap.String("Request ID: ", requestID),
 		)
-		return "", time.Now(), ErrInvalidAssertion
+		return "", time.Now(), "", ErrInvalidAssertion
 	}
 
 	// Extract claims from the parsed assertion.
 	claims, ok := parsedAssertion.Claims.(*AssertionClaims)
 	if !ok {
 		dstsLogger.Error("Failed to retrieve nonce claim from client assertion")
-		return "", time.Now(), ErrMissingNonce
+		return "", time.Now(), "", ErrMissingNonce
 	}
 
-	// Compare the nonce claim in the client assertion with the challenge that
-	// was issued to the device.
-	deviceChallenge, err := cache.GetDeviceAuthenticationChallenge(requestID,
-		foundDevice.DeviceId)
-	if err != nil {
-		dstsLogger.Error("Failed to retrieve the device authentication challenge from cache!",
-			zap.String("Request ID: ", requestID),
-			zap.Error(err),
-		)
-		return "", time.Now(), err
+	if (claims.Nonce == "") &&
+		isChallengelessAuthenticationEnabled(foundDevice.TenantId) {
+		// The device did not request a challenge. Verify the assertion is a
+		// short-lived, single use assertion.
+		err = verifyChallengelessAssertion(requestID, foundDevice, claims)
+		if err != nil {
+			return "", time.Now(), "", err
+		}
+	} else {
+		// Compare the nonce claim in the client assertion with the challenge
+		// that was issued to the device.
+		deviceChallenge, err := cache.GetDeviceAuthenticationChallenge(requestID,
+			foundDevice.DeviceId)
+		if err != nil {
+			dstsLogger.Error("Failed to retrieve the device authentication challenge from cache!",
+				zap.String("Request ID: ", requestID),
+				zap.Error(err),
+			)
+			return "", time.Now(), "", err
+		}
+		if deviceChallenge != claims.Nonce {
+			dstsLogger.Error("Invalid nonce value in presented client assertion!",
+				zap.String("Request ID: ", requestID),
+				zap.Error(err),
+			)
+			return "", time.Now(), "", ErrInvalidDeviceChallenge
+		}
 	}
-	if deviceChallenge != claims.Nonce {
-		dstsLogger.Error("Invalid nonce value in presented client assertion!",
-			zap.String("Request ID: ", requestID),
-			zap.Error(err),
-		)
-		return "", time.Now(), ErrInvalidDeviceChallenge
+
+	// Record the posture reported by the device, before issuing the access
+	// token so the token reflects the reported posture.
+	if len(claims.Posture) != 0 {
+		err = reportDevicePosture(requestID, foundDevice, claims.Posture)
+		if err != nil {
+			return "", time.Now(), "", err
+		}
+	}
+
+	// If a DPoP proof was presented, verify it was signed using the device key
+	// and bind the access token to the device key.
+	var (
+		confirmation *ConfirmationClaim
+		jkt          string
+	)
+	if dpop != nil {
+		jkt, err = verifyDpopProof(requestID, dpop, deviceKey)
+		if err != nil {
+			return "", time.Now(), "", err
+		}
+		confirmation = &ConfirmationClaim{Jkt: jkt}
+	}
+
+	// Lost devices are issued a quarantine token, so they can reach their
+	// management service. Refresh tokens are not issued to lost devices.
+	if foundDevice.IsLost {
+		accessToken, expiresAt, err := newDeviceQuarantineToken(requestID,
+			foundDevice, certExpiresAt, confirmation)
+		return accessToken, expiresAt, "", err
+	}
+
+	// Evaluate the token policies configured for the tenant.
+	err = enforceTokenPolicies(requestID, foundDevice, &tokenRequest{
+		grantType:      GrantTypeClientAssertion,
+		certThumbprint: certThumbprint,
+		dpopBound:      (dpop != nil),
+	})
+	if err != nil {
+		return "", time.Now(), "", err
 	}
 
 	// Generate a new device access token.
-	accessToken, expiresAt, err := NewDeviceAccessToken(requestID, foundDevice)
+	accessToken, expiresAt, err := NewDeviceAccessToken(requestID, foundDevice,
+		certExpiresAt, confirmation)
 	if err != nil {
 		dstsLogger.Error("Failed to generate a new device access token!",
 			zap.String("Request ID: ", requestID),
 			zap.Error(err),
 		)
-		return "", time.Now(), err
+		return "", time.Now(), "", err
+	}
+
+	// Issue a refresh token bound to the presented device certificate, and
+	// to the DPoP key if the access token is DPoP bound. Refresh tokens are
+	// revoked when the device certificate changes, so they are not issued to
+	// devices authenticating with their previous device certificate.
+	refreshToken := ""
+	if deviceRefreshTokensEnabled &&
+		(certThumbprint == foundDevice.CertificateThumbprint) {
+		refreshToken, err = newDeviceRefreshToken(requestID, foundDevice,
+			certThumbprint, jkt, uuid.NewString())
+		if err != nil {
+			return "", time.Now(), "", err
+		}
 	}
 
-	return accessToken, expiresAt, nil
+	return accessToken, expiresAt, refreshToken, nil
 }
@@ -0,0 +1,113 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/x509"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Validate the certificate presented by a device, either within the x5c header
+// of a client assertion or at the TLS layer, and return the device to which
+// the certificate was issued.
+func getDeviceForCertificate(requestID string,
+	deviceCert *x509.Certificate) (*db.Device, error) {
+	// Extract the device ID and the tenant ID from the certificate.
+	deviceID := deviceCert.Subject.CommonName
+	tenantID := common.GetTenantIDFromCertificate(deviceCert)
+	if deviceID == "" || tenantID == "" {
+		dstsLogger.Error("Invalid device ID or tenant ID in device certificate",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+		)
+		return nil, ErrInvalidDeviceOrTenantId
+	}
+
+	// Block device authentication if the tenant has been suspended or deleted.
+	err := checkTenantState(requestID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform a few verification checks on the device certificate, in the
+	// modes configured for the tenant.
+	err = common.VerifyCertificateWithHandler(deviceCert,
+		func(check string, err error) error {
+			return applyVerificationMode(requestID, tenantID, deviceID, check, err)
+		})
+	if err != nil {
+		dstsLogger.Error("Failed to verify the presented device certificate",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Retrieve information about the device from the database.
+	foundDevice, err := db.GetDevice(requestID, tenantID, deviceID)
+	if err != nil {
+		dstsLogger.Error("Failed to retrieve information about the device",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// If the device is marked disabled or has been reported lost, block
+	// device authentication - no token will be issued. Lost devices belonging
+	// to tenants with quarantine enabled are allowed to authenticate, and are
+	// issued a quarantine token.
+	if (!foundDevice.IsEnabled) ||
+		(foundDevice.IsLost && !isQuarantineAllowed(foundDevice)) {
+		dstsLogger.Error("Device authentication is blocked for disabled or lost device!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.Bool("Is Enabled: ", foundDevice.IsEnabled),
+			zap.Bool("Is Lost: ", foundDevice.IsLost),
+		)
+		return nil, db.ErrAuthnBlocked
+	}
+
+	// Validate the certificate thumbprint of the presented device
+	// certificate against that stored in the database. In the event that
+	// a device's certificate was recently renewed, allow the device to
+	// authenticate with its previous certificate. When the previous
+	// certificate is no longer valid, device authentication will stop
+	// working for that certificate.
+	deviceCertThumbprint := common.GetCertificateThumbprint(deviceCert)
+	switch deviceCertThumbprint {
+	case foundDevice.CertificateThumbprint:
+		if foundDevice.PreviousCertificateThumbprint != "" {
+			// The device has successfully used the current device certificate
+			// for device authentication. This signifies a successful rotation of
+			// the device certificate. Delete the pevious certificate thumbprint
+			// so the previous device certificate can no longer be used for device
+			// authentication.
+			_ = db.DeletePreviousCertificateThumbprint(requestID, foundDevice.DeviceId,
+				foundDevice.TenantId)
+		}
+		return foundDevice, nil
+	case foundDevice.PreviousCertificateThumbprint:
+		return foundDevice, nil
+	default:
+		dstsLogger.Error("Provided device certificate doesn't match that in database!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.String("Thumbprint in database: ", foundDevice.CertificateThumbprint),
+			zap.String("Thumbprint presented: ", deviceCertThumbprint),
+		)
+		return nil, ErrInvalidDeviceCertificate
+	}
+}
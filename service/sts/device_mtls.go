@@ -0,0 +1,76 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"go.uber.org/zap"
+)
+
+// GetAccessTokenFromDeviceCertificate - issue a certificate bound device access
+// token (RFC 8705) to a device that presented its registered device certificate
+// at the TLS layer. The access token carries a 'cnf' claim with the thumbprint
+// of the device certificate.
+func GetAccessTokenFromDeviceCertificate(requestID string,
+	deviceCert *x509.Certificate) (string, time.Time, error) {
+	// Validate the device certificate against the device registered in the
+	// database.
+	foundDevice, err := getDeviceForCertificate(requestID, deviceCert)
+	if err != nil {
+		return "", time.Now(), err
+	}
+
+	// Lost devices are issued a quarantine token bound to the device
+	// certificate, so they can reach their management service.
+	if foundDevice.IsLost {
+		return newDeviceQuarantineToken(requestID, foundDevice,
+			deviceCert.NotAfter, &ConfirmationClaim{
+				X5tS256: common.GetCertificateX5tS256(deviceCert),
+			})
+	}
+
+	// Evaluate the token policies configured for the tenant.
+	err = enforceTokenPolicies(requestID, foundDevice, &tokenRequest{
+		grantType:      GrantTypeClientCertificate,
+		certThumbprint: common.GetCertificateThumbprint(deviceCert),
+	})
+	if err != nil {
+		return "", time.Now(), err
+	}
+
+	// Generate a new device access token bound to the device certificate.
+	accessToken, expiresAt, err := NewDeviceAccessToken(requestID, foundDevice,
+		deviceCert.NotAfter, &ConfirmationClaim{
+			X5tS256: common.GetCertificateX5tS256(deviceCert),
+		})
+	if err != nil {
+		dstsLogger.Error("Failed to generate a new certificate bound device access token!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", foundDevice.DeviceId),
+			zap.Error(err),
+		)
+		return "", time.Now(), err
+	}
+
+	return accessToken, expiresAt, nil
+}
+
+// VerifyCertificateBinding - check whether the client certificate presented to
+// a resource server matches the certificate to which the device access token
+// is bound. Tokens that are not certificate bound fail the check only if
+// bindingRequired is set.
+func (c *DeviceTokenClaims) VerifyCertificateBinding(clientCert *x509.Certificate,
+	bindingRequired bool) error {
+	if (c.Confirmation == nil) || (c.Confirmation.X5tS256 == "") {
+		if bindingRequired {
+			return common.ErrCertificateBindingMismatch
+		}
+		return nil
+	}
+	return common.VerifyCertificateBinding(c.Confirmation.X5tS256, clientCert)
+}
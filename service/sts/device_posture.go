@@ -0,0 +1,135 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"bytes"
+	"encoding/json"
+	"unicode"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Device posture attributes that can be copied into the posture claim of
+// device access tokens. Timestamps are asserted as seconds since the epoch.
+const (
+	PostureClaim = "posture"
+
+	PostureClaimOsVersion      = "os_version"
+	PostureClaimPatchLevel     = "patch_level"
+	PostureClaimDiskEncryption = "disk_encryption"
+	PostureClaimAgentVersion   = "agent_version"
+	PostureClaimReportedAt     = "reported_at"
+
+	// Maximum length of posture attributes reported by devices.
+	maxPostureAttributeLength = 64
+)
+
+var postureClaimValues = map[string]func(*db.DevicePosture) interface{}{
+	PostureClaimOsVersion: func(p *db.DevicePosture) interface{} {
+		return p.OsVersion
+	},
+	PostureClaimPatchLevel: func(p *db.DevicePosture) interface{} {
+		return p.PatchLevel
+	},
+	PostureClaimDiskEncryption: func(p *db.DevicePosture) interface{} {
+		return p.DiskEncryption
+	},
+	PostureClaimAgentVersion: func(p *db.DevicePosture) interface{} {
+		return p.AgentVersion
+	},
+	PostureClaimReportedAt: func(p *db.DevicePosture) interface{} {
+		return p.ReportedAt.Unix()
+	},
+}
+
+var supportedDiskEncryptionStates = map[string]bool{
+	db.DiskEncryptionEncrypted:          true,
+	db.DiskEncryptionPartiallyEncrypted: true,
+	db.DiskEncryptionNotEncrypted:       true,
+	db.DiskEncryptionUnknown:            true,
+}
+
+// Validate the posture claim included by the device in its client assertion
+// and record it as the last reported posture of the device.
+func reportDevicePosture(requestID string, device *db.Device,
+	postureClaim json.RawMessage) error {
+	posture, err := parseDevicePosture(postureClaim)
+	if err != nil {
+		dstsLogger.Error("Invalid device posture claim in presented client assertion!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+			zap.String("Tenant ID: ", device.TenantId),
+			zap.Error(err),
+		)
+		return ErrInvalidDevicePosture
+	}
+
+	posture.DeviceId = device.DeviceId
+	posture.TenantId = device.TenantId
+	return posture.UpdateDevicePosture(requestID)
+}
+
+// Parse the posture claim and validate it against the device posture schema.
+// Unknown attributes are rejected.
+func parseDevicePosture(postureClaim json.RawMessage) (*db.DevicePosture, error) {
+	var posture db.DevicePosture
+
+	decoder := json.NewDecoder(bytes.NewReader(postureClaim))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&posture)
+	if err != nil {
+		return nil, err
+	}
+
+	if (posture.OsVersion == "") && (posture.PatchLevel == "") &&
+		(posture.DiskEncryption == "") && (posture.AgentVersion == "") {
+		return nil, ErrInvalidDevicePosture
+	}
+
+	for _, value := range []string{posture.OsVersion, posture.PatchLevel,
+		posture.AgentVersion} {
+		if !isValidPostureAttribute(value) {
+			return nil, ErrInvalidDevicePosture
+		}
+	}
+
+	if (posture.DiskEncryption != "") &&
+		!supportedDiskEncryptionStates[posture.DiskEncryption] {
+		return nil, ErrInvalidDevicePosture
+	}
+	return &posture, nil
+}
+
+func isValidPostureAttribute(value string) bool {
+	if len(value) > maxPostureAttributeLength {
+		return false
+	}
+	for _, c := range value {
+		if !unicode.IsPrint(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the posture claim to be asserted in the device access token issued
+// to the specified device, containing the specified attributes of the posture
+// last reported by the device.
+func getDevicePostureClaim(requestID string, device *db.Device,
+	attributes []string) (map[string]interface{}, error) {
+	posture, err := db.GetDevicePosture(requestID, device.TenantId,
+		device.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	claim := map[string]interface{}{}
+	for _, attribute := range attributes {
+		claim[attribute] = postureClaimValues[attribute](posture)
+	}
+	return claim, nil
+}
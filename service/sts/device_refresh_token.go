@@ -0,0 +1,270 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// Default token lifetime for device refresh tokens.
+	defaultDeviceRefreshTokenLifetime = (time.Hour * 24 * 30)
+
+	// Range of configurable device refresh token lifetimes.
+	minDeviceRefreshTokenLifetime = (time.Hour * 1)
+	maxDeviceRefreshTokenLifetime = (time.Hour * 24 * 365)
+
+	// Size of the random refresh tokens issued to devices, in bytes.
+	refreshTokenSize = 32
+)
+
+var (
+	// Whether refresh tokens are issued to devices, and their lifetime.
+	deviceRefreshTokensEnabled = false
+	deviceRefreshTokenLifetime = defaultDeviceRefreshTokenLifetime
+)
+
+// Initialize device refresh token settings from the configuration settings.
+func initDeviceRefreshTokens(cfgMgr *config.ConfigMgr) error {
+	tokenConfig := cfgMgr.GetTokenConfig()
+	deviceRefreshTokensEnabled = tokenConfig.DeviceRefreshTokensEnabled
+
+	if tokenConfig.DeviceRefreshTokenLifetime != 0 {
+		if (tokenConfig.DeviceRefreshTokenLifetime < minDeviceRefreshTokenLifetime) ||
+			(tokenConfig.DeviceRefreshTokenLifetime > maxDeviceRefreshTokenLifetime) {
+			dstsLogger.Error("Configured device refresh token lifetime is out of range!",
+				zap.Duration("Lifetime: ", tokenConfig.DeviceRefreshTokenLifetime),
+			)
+			return fmt.Errorf("%w: device refresh token", ErrInvalidAccessTokenLifetime)
+		}
+		deviceRefreshTokenLifetime = tokenConfig.DeviceRefreshTokenLifetime
+	}
+	return nil
+}
+
+// IsDeviceRefreshTokenEnabled - whether refresh tokens are issued to devices.
+func IsDeviceRefreshTokenEnabled() bool {
+	return deviceRefreshTokensEnabled
+}
+
+// Issue a new refresh token to the specified device and store its hash in the
+// database. The refresh token is bound to the device certificate presented by
+// the device and, if specified, to the device key used to sign DPoP proofs.
+func newDeviceRefreshToken(requestID string, device *db.Device,
+	certThumbprint string, jkt string, familyID string) (string, error) {
+	tokenBytes := make([]byte, refreshTokenSize)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		dstsLogger.Error("Failed to generate a device refresh token!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return "", err
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	entry := db.RefreshToken{
+		TokenHash:             db.GetRefreshTokenHash(refreshToken),
+		FamilyId:              familyID,
+		DeviceId:              device.DeviceId,
+		TenantId:              device.TenantId,
+		CertificateThumbprint: certThumbprint,
+		DpopJkt:               jkt,
+		ExpiresAt:             time.Now().Add(deviceRefreshTokenLifetime),
+	}
+	err = entry.AddRefreshToken(requestID)
+	if err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+// GetAccessTokenFromRefreshToken - exchange a refresh token previously issued
+// to a device for a new device access token and a new refresh token. The
+// presented refresh token is redeemed and cannot be used again - if it is
+// presented again, the refresh token and all refresh tokens obtained by
+// rotating it are revoked.
+func GetAccessTokenFromRefreshToken(requestID string, refreshToken string,
+	dpop *DpopRequest) (string, time.Time, string, error) {
+	if !deviceRefreshTokensEnabled {
+		return "", time.Now(), "", ErrInvalidRefreshToken
+	}
+
+	// The refresh token is only redeemed once the request has been validated,
+	// so that a failed request does not burn the refresh token.
+	var foundDevice *db.Device
+	var confirmation *ConfirmationClaim
+	token, err := db.RedeemRefreshToken(requestID,
+		db.GetRefreshTokenHash(refreshToken),
+		func(token *db.RefreshToken) error {
+			var err error
+			foundDevice, confirmation, err = validateRefreshTokenRequest(
+				requestID, token, dpop)
+			return err
+		})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return "", time.Now(), "", ErrInvalidRefreshToken
+		}
+		if errors.Is(err, db.ErrTokenReused) {
+			return "", time.Now(), "", ErrRefreshTokenReused
+		}
+		return "", time.Now(), "", err
+	}
+
+	// Refresh tokens are only issued to devices authenticating with their
+	// current device certificate.
+	accessToken, expiresAt, err := NewDeviceAccessToken(requestID, foundDevice,
+		foundDevice.CertificateExpiresAt, confirmation)
+	if err != nil {
+		dstsLogger.Error("Failed to generate a new device access token!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return "", time.Now(), "", err
+	}
+
+	// Rotate the refresh token.
+	newRefreshToken, err := newDeviceRefreshToken(requestID, foundDevice,
+		token.CertificateThumbprint, token.DpopJkt, token.FamilyId)
+	if err != nil {
+		return "", time.Now(), "", err
+	}
+
+	return accessToken, expiresAt, newRefreshToken, nil
+}
+
+// Validate a request to redeem the specified refresh token, and return the
+// device to which it was issued and the confirmation claim for the new access
+// token.
+func validateRefreshTokenRequest(requestID string, token *db.RefreshToken,
+	dpop *DpopRequest) (*db.Device, *ConfirmationClaim, error) {
+	if token.ExpiresAt.Before(time.Now()) {
+		dstsLogger.Error("Presented refresh token has expired!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", token.DeviceId),
+			zap.Time("Expires at: ", token.ExpiresAt),
+		)
+		return nil, nil, ErrInvalidRefreshToken
+	}
+
+	// Block token refresh if the tenant has been suspended or deleted.
+	err := checkTenantState(requestID, token.TenantId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Refresh tokens are revoked in the database when the device is disabled,
+	// lost, deleted or its certificate changes. Check the current state of the
+	// device anyway, since it may have been retrieved from the cache.
+	foundDevice, err := db.GetDevice(requestID, token.TenantId, token.DeviceId)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) || errors.Is(err, db.ErrTombstoned) {
+			return nil, nil, ErrInvalidRefreshToken
+		}
+		return nil, nil, err
+	}
+	if (!foundDevice.IsEnabled) || (foundDevice.IsLost) {
+		dstsLogger.Error("Token refresh is blocked for disabled or lost device!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", foundDevice.DeviceId),
+			zap.String("Tenant ID: ", foundDevice.TenantId),
+		)
+		return nil, nil, db.ErrAuthnBlocked
+	}
+	if token.CertificateThumbprint != foundDevice.CertificateThumbprint {
+		dstsLogger.Error("Refresh token was issued for a different device certificate!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", foundDevice.DeviceId),
+			zap.String("Tenant ID: ", foundDevice.TenantId),
+		)
+		return nil, nil, ErrInvalidRefreshToken
+	}
+
+	// The device certificate to which the refresh token is bound must still
+	// be valid.
+	now := time.Now()
+	if now.Before(foundDevice.CertificateIssuedAt) ||
+		now.After(foundDevice.CertificateExpiresAt) {
+		dstsLogger.Error("Refresh token is bound to a device certificate that is not valid!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", foundDevice.DeviceId),
+			zap.String("Tenant ID: ", foundDevice.TenantId),
+			zap.Time("Certificate expires at: ", foundDevice.CertificateExpiresAt),
+		)
+		return nil, nil, ErrInvalidRefreshToken
+	}
+
+	// Refresh tokens bound to a DPoP key can only be redeemed with a DPoP proof
+	// signed using the same key. The new access token is bound to that key.
+	var confirmation *ConfirmationClaim
+	if token.DpopJkt != "" {
+		if dpop == nil {
+			dstsLogger.Error("DPoP proof is required to redeem the refresh token!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Device ID: ", foundDevice.DeviceId),
+			)
+			return nil, nil, ErrInvalidDpopProof
+		}
+		_, err = verifyBoundDpopProof(requestID, dpop, token.DpopJkt)
+		if err != nil {
+			return nil, nil, err
+		}
+		confirmation = &ConfirmationClaim{Jkt: token.DpopJkt}
+	} else if dpop != nil {
+		dstsLogger.Error("DPoP proof presented with a refresh token not bound to a DPoP key!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", foundDevice.DeviceId),
+		)
+		return nil, nil, ErrInvalidDpopProof
+	}
+
+	// Evaluate the token policies configured for the tenant.
+	err = enforceTokenPolicies(requestID, foundDevice, &tokenRequest{
+		grantType:      GrantTypeRefreshToken,
+		certThumbprint: token.CertificateThumbprint,
+		dpopBound:      (confirmation != nil),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return foundDevice, confirmation, nil
+}
+
+// Verify a DPoP proof presented along with a refresh token bound to the
+// device key with the specified JWK thumbprint.
+func verifyBoundDpopProof(requestID string, dpop *DpopRequest,
+	jkt string) (string, error) {
+	// Retrieve the key from the jwk header of the proof. The proof signature
+	// is verified using this key once it is confirmed to be the bound key.
+	unverifiedProof, _, err := jwt.NewParser().ParseUnverified(dpop.Proof,
+		&DpopProofClaims{})
+	if err != nil {
+		return "", ErrInvalidDpopProof
+	}
+	proofKey, err := parseRsaJwk(unverifiedProof.Header["jwk"])
+	if err != nil {
+		return "", err
+	}
+	if common.GetRsaJwkThumbprint(proofKey) != jkt {
+		dstsLogger.Error("DPoP proof is not signed using the key bound to the refresh token!",
+			zap.String("Request ID: ", requestID),
+		)
+		return "", ErrInvalidDpopProof
+	}
+
+	return verifyDpopProof(requestID, dpop, proofKey)
+}
@@ -0,0 +1,220 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/cache"
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// Expected value of the 'typ' header of DPoP proofs.
+	dpopProofType = "dpop+jwt"
+
+	// DPoP proofs are accepted if they were issued within this window. The
+	// IDs of presented proofs are retained for the duration of the window to
+	// detect replayed proofs.
+	dpopProofMaxAge       = (time.Minute * 5)
+	dpopProofMaxClockSkew = (time.Minute * 1)
+	dpopProofReplayWindow = dpopProofMaxAge + dpopProofMaxClockSkew
+
+	// Length of DPoP nonces issued by the DSTS.
+	dpopNonceLength = 32
+
+	// Token types returned in the token_type field of token responses.
+	TokenTypeBearer = "Bearer"
+	TokenTypeDPoP   = "DPoP"
+)
+
+var (
+	// Specifies whether DPoP proofs must include a nonce issued by the DSTS.
+	dpopNonceRequired bool
+)
+
+// DpopRequest - a DPoP proof (RFC 9449) presented in the DPoP header of a
+// request to the token endpoint, along with the HTTP method and URL of the
+// request to which the proof must be bound.
+type DpopRequest struct {
+	Proof  string
+	Method string
+	Url    string
+}
+
+// DpopProofClaims - claims within a DPoP proof JWT.
+type DpopProofClaims struct {
+	HttpMethod string           `json:"htm"`
+	HttpUrl    string           `json:"htu"`
+	IssuedAt   *jwt.NumericDate `json:"iat"`
+	ID         string           `json:"jti"`
+	Nonce      string           `json:"nonce,omitempty"`
+}
+
+// Valid - the claims in the DPoP proof are validated by verifyDpopProof.
+func (c *DpopProofClaims) Valid() error {
+	return nil
+}
+
+// NewDpopNonce - issue a new DPoP nonce to be included by the device in
+// subsequent DPoP proofs.
+func NewDpopNonce(requestID string) (string, error) {
+	nonce := common.NewRandomString(dpopNonceLength)
+	err := cache.AddDpopNonce(requestID, nonce)
+	if err != nil {
+		return "", err
+	}
+	return nonce, nil
+}
+
+// IsDpopNonceRequired - whether DPoP proofs must include a DSTS issued nonce.
+func IsDpopNonceRequired() bool {
+	return dpopNonceRequired
+}
+
+// Verify the DPoP proof presented by the device. The proof must be signed
+// using the private key corresponding to the device certificate. Returns the
+// JWK thumbprint of the device key, to be asserted in the 'cnf' claim of the
+// access token.
+func verifyDpopProof(requestID string, dpop *DpopRequest,
+	deviceKey *rsa.PublicKey) (string, error) {
+	if deviceKey == nil {
+		return "", ErrInvalidDpopProof
+	}
+
+	parsedProof, err := jwt.ParseWithClaims(dpop.Proof, &DpopProofClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			// The device key is an RSA key.
+			switch token.Method.(type) {
+			case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			default:
+				return nil, ErrUnsupportedSigningAlg
+			}
+
+			if token.Header["typ"] != dpopProofType {
+				return nil, ErrInvalidDpopProof
+			}
+
+			// The public key in the jwk header must be the key in the
+			// registered device certificate.
+			proofKey, err := parseRsaJwk(token.Header["jwk"])
+			if err != nil {
+				return nil, err
+			}
+			if (proofKey.E != deviceKey.E) || (proofKey.N.Cmp(deviceKey.N) != 0) {
+				return nil, ErrInvalidDpopProof
+			}
+			return deviceKey, nil
+		})
+	if err != nil || !parsedProof.Valid {
+		dstsLogger.Error("Failed to parse and validate the presented DPoP proof!",
+			zap.String("Request ID: ", requestID),
+			zap.Error(err),
+		)
+		return "", ErrInvalidDpopProof
+	}
+
+	claims, ok := parsedProof.Claims.(*DpopProofClaims)
+	if !ok {
+		return "", ErrInvalidDpopProof
+	}
+
+	// The proof must be bound to the HTTP method and URL of this request.
+	if (claims.HttpMethod != dpop.Method) || (claims.HttpUrl != dpop.Url) ||
+		(claims.ID == "") || (claims.IssuedAt == nil) {
+		dstsLogger.Error("DPoP proof is not bound to the current request!",
+			zap.String("Request ID: ", requestID),
+			zap.String("htm: ", claims.HttpMethod),
+			zap.String("htu: ", claims.HttpUrl),
+			zap.String("Request URL: ", dpop.Url),
+		)
+		return "", ErrInvalidDpopProof
+	}
+
+	// The proof must have been issued recently.
+	issuedAt := claims.IssuedAt.Time
+	if issuedAt.After(time.Now().Add(dpopProofMaxClockSkew)) ||
+		issuedAt.Before(time.Now().Add(-dpopProofMaxAge)) {
+		dstsLogger.Error("DPoP proof was not issued within the acceptable window!",
+			zap.String("Request ID: ", requestID),
+			zap.Time("Issued at: ", issuedAt),
+		)
+		return "", ErrInvalidDpopProof
+	}
+
+	// If nonces are required, the proof must contain a valid DSTS issued nonce.
+	if dpopNonceRequired {
+		if claims.Nonce == "" {
+			return "", ErrDpopNonceRequired
+		}
+		valid, err := cache.IsValidDpopNonce(requestID, claims.Nonce)
+		if err != nil {
+			return "", err
+		}
+		if !valid {
+			dstsLogger.Error("DPoP proof contains an invalid or expired nonce!",
+				zap.String("Request ID: ", requestID),
+			)
+			return "", ErrDpopNonceRequired
+		}
+	}
+
+	// Protect against replay of the DPoP proof.
+	added, err := cache.AddDpopProofID(requestID, claims.ID, dpopProofReplayWindow)
+	if err != nil {
+		return "", err
+	}
+	if !added {
+		dstsLogger.Error("Replayed DPoP proof was presented!",
+			zap.String("Request ID: ", requestID),
+			zap.String("jti: ", claims.ID),
+		)
+		return "", ErrDpopProofReplayed
+	}
+
+	return common.GetRsaJwkThumbprint(deviceKey), nil
+}
+
+// Parse the RSA public key in the jwk header of a DPoP proof.
+func parseRsaJwk(header interface{}) (*rsa.PublicKey, error) {
+	jwk, ok := header.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidDpopProof
+	}
+
+	// The JWK must be an RSA public key and must not contain private key
+	// parameters.
+	if jwk["kty"] != "RSA" {
+		return nil, ErrInvalidDpopProof
+	}
+	if _, found := jwk["d"]; found {
+		return nil, ErrInvalidDpopProof
+	}
+
+	n, okN := jwk["n"].(string)
+	e, okE := jwk["e"].(string)
+	if !okN || !okE {
+		return nil, ErrInvalidDpopProof
+	}
+
+	nBytes, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, ErrInvalidDpopProof
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil || len(eBytes) == 0 || len(eBytes) > 4 {
+		return nil, ErrInvalidDpopProof
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: int(new(big.Int).SetBytes(eBytes).Int64()),
+	}, nil
+}
@@ -75,5 +75,11 @@ func ValidateEnrollmentToken(requestID string, enrollmentToken string) (string,
 		return "", ErrExpiredEnrollmentToken
 	}
 
+	// Devices cannot be enrolled into suspended or deleted tenants.
+	err = checkTenantState(requestID, token.TenantId)
+	if err != nil {
+		return "", err
+	}
+
 	return token.TenantId, nil
 }
@@ -15,8 +15,22 @@ var (
 	ErrInvalidAssertion               = errors.New("assertion is invalid")
 	ErrAssertionExpired               = errors.New("assertion has expired")
 	ErrAssertionNotValidYet           = errors.New("assertion is not yet valid")
+	ErrAssertionReplayed              = errors.New("assertion has already been presented")
 	ErrInvalidDeviceChallenge         = errors.New("invalid nonce value in presented client assertion")
 	ErrInvalidEnrollmentToken         = errors.New("invalid enrollment token provided")
 	ErrExpiredEnrollmentToken         = errors.New("enrollment token has expired")
 	ErrInvalidEnrollmentTokenLifetime = errors.New("enrollment token lifetime specified is invalid")
+	ErrInvalidScope                   = errors.New("requested scope is invalid or has not been granted")
+	ErrInvalidAccessToken             = errors.New("access token is invalid")
+	ErrAccessTokenRevoked             = errors.New("access token was issued before the device was transferred")
+	ErrInvalidDpopProof               = errors.New("invalid DPoP proof presented")
+	ErrDpopProofReplayed              = errors.New("DPoP proof has already been presented")
+	ErrDpopNonceRequired              = errors.New("DPoP proof must include a valid nonce")
+	ErrInvalidAccessTokenLifetime     = errors.New("configured access token lifetime is invalid")
+	ErrInvalidRefreshToken            = errors.New("refresh token is invalid or has expired")
+	ErrRefreshTokenReused             = errors.New("refresh token has already been redeemed")
+	ErrClaimsEnricherTimeout          = errors.New("claims enricher did not respond in time")
+	ErrInvalidDevicePosture           = errors.New("device posture claim is invalid")
+	ErrTokenPolicyDenied              = errors.New("token issuance was denied by a token policy")
+	ErrInvalidTokenPolicyExpression   = errors.New("token policy expression is invalid")
 )
@@ -5,22 +5,87 @@
 package sts
 
 import (
+	"github.com/HPInc/krypton-dsts/service/config"
 	"go.uber.org/zap"
 )
 
 var (
 	dstsLogger *zap.Logger
+
+	// Issuer asserted in the 'iss' claim of access tokens.
+	tokenIssuer = dstsIssuerName
 )
 
 const (
 	ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
 )
 
-func Init(logger *zap.Logger) error {
+func Init(logger *zap.Logger, cfgMgr *config.ConfigMgr) error {
 	dstsLogger = logger
+	dpopNonceRequired = cfgMgr.GetServerConfig().DpopNonceRequired
+	if cfgMgr.GetServerConfig().TokenIssuer != "" {
+		tokenIssuer = cfgMgr.GetServerConfig().TokenIssuer
+	}
+
+	// Initialize the access token lifetime policy.
+	err := initTokenLifetimes(cfgMgr)
+	if err != nil {
+		dstsLogger.Error("Failed to initialize token lifetimes!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize device refresh token settings.
+	err = initDeviceRefreshTokens(cfgMgr)
+	if err != nil {
+		dstsLogger.Error("Failed to initialize device refresh tokens!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize quarantine of lost devices.
+	err = initQuarantine(cfgMgr)
+	if err != nil {
+		dstsLogger.Error("Failed to initialize lost device quarantine!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize tenants allowed to use challenge-less device authentication.
+	initChallengelessTenants(cfgMgr.GetTenants())
+
+	// Initialize the verification modes configured for tenants.
+	err = initVerificationModes(cfgMgr.GetTenants())
+	if err != nil {
+		dstsLogger.Error("Failed to initialize verification modes!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize the environment in which token policies are compiled.
+	err = initTokenPolicyEnv()
+	if err != nil {
+		dstsLogger.Error("Failed to initialize the token policy environment!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize the claims pipeline for device access tokens.
+	err = initClaimsPipeline(cfgMgr)
+	if err != nil {
+		dstsLogger.Error("Failed to initialize the device claims pipeline!",
+			zap.Error(err),
+		)
+		return err
+	}
 
 	// Parse the token signing key.
-	err := initTokenSigningKey()
+	err = initTokenSigningKey()
 	if err != nil {
 		dstsLogger.Error("Failed to initialize token signing key!",
 			zap.Error(err),
@@ -30,5 +95,29 @@ func Init(logger *zap.Logger) error {
 
 	// Pre-create the token signing key response.
 	initTokenSigningKeyResponse()
+
+	// Pre-create the standard JWKS document.
+	err = initJwks()
+	if err != nil {
+		dstsLogger.Error("Failed to initialize the JWKS document!",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Initialize the issuers and signing keys configured for tenants.
+	err = initTenantSigners(cfgMgr.GetTenants())
+	if err != nil {
+		dstsLogger.Error("Failed to initialize tenant token signing keys!",
+			zap.Error(err),
+		)
+		return err
+	}
 	return nil
 }
+
+// GetTokenIssuer - returns the issuer asserted in access tokens issued by the
+// DSTS.
+func GetTokenIssuer() string {
+	return tokenIssuer
+}
@@ -0,0 +1,67 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// JSON Web Key Set (RFC 7517) containing the token verification keys.
+type JSONWebKeySet struct {
+	Keys []common.JSONWebKey `json:"keys"`
+}
+
+var (
+	// Serialized JWKS document and its entity tag.
+	jwksDocument []byte
+	jwksETag     string
+)
+
+// Initialize the standard JWKS document published by the DSTS.
+func initJwks() error {
+	var err error
+	jwksDocument, jwksETag, err = newJwks(tokenSigningKeyID, tokenSigningKey)
+	return err
+}
+
+// Build a serialized JWKS document containing the verification key for the
+// specified token signing key, along with its entity tag. The token signing
+// key is not issued a certificate, so the x5c and x5t parameters are omitted.
+func newJwks(keyID string, signingKey *rsa.PrivateKey) ([]byte, string, error) {
+	jwks := JSONWebKeySet{
+		Keys: []common.JSONWebKey{
+			{
+				KeyType: "RSA",
+				Use:     "sig",
+				Alg:     jwt.SigningMethodRS512.Alg(),
+				KeyID:   keyID,
+				N:       base64.RawURLEncoding.EncodeToString(signingKey.N.Bytes()),
+				E: base64.RawURLEncoding.EncodeToString(common.NewBufferFromInt(
+					// #nosec G115
+					uint64(signingKey.E)).Data),
+			},
+		},
+	}
+
+	document, err := json.Marshal(jwks)
+	if err != nil {
+		return nil, "", err
+	}
+	digest := sha256.Sum256(document)
+	return document, fmt.Sprintf("\"%s\"", hex.EncodeToString(digest[:16])), nil
+}
+
+// GetJwks - returns the serialized JWKS document and its entity tag.
+func GetJwks() ([]byte, string) {
+	return jwksDocument, jwksETag
+}
@@ -0,0 +1,147 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// Scope asserted in quarantine tokens issued to lost devices.
+	ScopeQuarantine = "quarantine"
+
+	// Default and maximum lifetime of quarantine tokens.
+	defaultQuarantineTokenLifetime = (time.Minute * 15)
+	maxQuarantineTokenLifetime     = (time.Hour * 1)
+)
+
+var (
+	// Tenants for which lost devices are issued quarantine tokens, instead of
+	// being blocked from authenticating.
+	quarantineTenants = map[string]bool{}
+
+	quarantineTokenLifetime = defaultQuarantineTokenLifetime
+)
+
+// Initialize the quarantine token settings and the list of tenants for which
+// lost devices are quarantined.
+func initQuarantine(cfgMgr *config.ConfigMgr) error {
+	lifetime := cfgMgr.GetTokenConfig().QuarantineTokenLifetime
+	if lifetime != 0 {
+		if (lifetime < time.Minute) || (lifetime > maxQuarantineTokenLifetime) {
+			dstsLogger.Error("Configured quarantine token lifetime is out of range!",
+				zap.Duration("Lifetime: ", lifetime),
+			)
+			return fmt.Errorf("%w: quarantine token", ErrInvalidAccessTokenLifetime)
+		}
+		quarantineTokenLifetime = lifetime
+	}
+
+	for _, tenant := range cfgMgr.GetTenants() {
+		if tenant.LostDeviceQuarantine {
+			quarantineTenants[tenant.Id] = true
+		}
+	}
+	return nil
+}
+
+// Check whether the lost device can be issued a quarantine token. Quarantine
+// must be enabled for the device's tenant, and the device must be managed by
+// a management service, which is the only audience of the token.
+func isQuarantineAllowed(device *db.Device) bool {
+	return quarantineTenants[device.TenantId] &&
+		(device.ServiceId != "") && (device.ServiceId != managementServiceNone)
+}
+
+// Create a quarantine token for a lost device. The token is short-lived,
+// asserts only the quarantine scope and is audience restricted to the
+// management service responsible for the device, so the device can receive
+// wipe or locate commands. The token is of a distinct type, so services
+// accepting device access tokens reject it.
+func newDeviceQuarantineToken(requestID string, device *db.Device,
+	certExpiresAt time.Time, confirmation *ConfirmationClaim) (string, time.Time, error) {
+	signer := getTokenSigner(device.TenantId)
+
+	// Quarantine tokens are not issued beyond the expiry of the device
+	// certificate presented by the device.
+	issuedTime := time.Now()
+	expiresAt := capDeviceTokenExpiry(device,
+		issuedTime.Add(quarantineTokenLifetime), certExpiresAt, issuedTime)
+	claims := DeviceTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    signer.issuer,
+			IssuedAt:  jwt.NewNumericDate(issuedTime),
+			NotBefore: jwt.NewNumericDate(issuedTime),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			Subject:   device.DeviceId,
+			Audience:  jwt.ClaimStrings{device.ServiceId},
+		},
+		TokenType:         TokenTypeQuarantineToken,
+		TenantID:          device.TenantId,
+		ManagementService: device.ServiceId,
+		Scope:             ScopeQuarantine,
+		Confirmation:      confirmation,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS512, claims)
+	token.Header["kid"] = signer.keyID
+
+	tokenString, err := token.SignedString(signer.signingKey)
+	if err != nil {
+		dstsLogger.Error("Failed to sign new device quarantine token!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+			zap.Error(err),
+		)
+		return "", time.Now(), err
+	}
+
+	dstsLogger.Warn("Issued quarantine token to lost device",
+		zap.String("Request ID: ", requestID),
+		zap.String("Device ID: ", device.DeviceId),
+		zap.String("Tenant ID: ", device.TenantId),
+		zap.String("Management service: ", device.ServiceId),
+		zap.String("Token ID: ", claims.ID),
+	)
+	metrics.MetricQuarantineTokensIssued.WithLabelValues(device.ServiceId).Inc()
+	return tokenString, claims.RegisteredClaims.ExpiresAt.Time, nil
+}
+
+// IsQuarantineToken - check whether the device token is a quarantine token
+// issued to a lost device. Services other than the management service to
+// which the token is issued must reject quarantine tokens.
+func (c *DeviceTokenClaims) IsQuarantineToken() bool {
+	return c.TokenType == TokenTypeQuarantineToken
+}
+
+// VerifyDeviceQuarantineToken - parse and verify a quarantine token issued to
+// a lost device. The token is only accepted by the management service to
+// which it was issued.
+func VerifyDeviceQuarantineToken(requestID string, quarantineToken string,
+	serviceID string) (*DeviceTokenClaims, error) {
+	claims, err := verifyDeviceToken(requestID, quarantineToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.IsQuarantineToken() || !claims.VerifyAudience(serviceID, true) {
+		dstsLogger.Error("Presented token is not a quarantine token for the management service!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Token type: ", claims.TokenType),
+			zap.String("Management service: ", serviceID),
+		)
+		return nil, ErrInvalidAccessToken
+	}
+	return claims, nil
+}
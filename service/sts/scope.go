@@ -0,0 +1,65 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"strings"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Parse a space delimited scope string (RFC 6749 section 3.3) into a list of
+// unique scopes.
+func parseScope(scope string) []string {
+	var scopes []string
+	seen := make(map[string]bool)
+	for _, item := range strings.Fields(scope) {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		scopes = append(scopes, item)
+	}
+	return scopes
+}
+
+// Format a list of scopes into a space delimited scope string.
+func formatScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// Determine the scopes to be issued to the app. If the app did not request a
+// specific scope, all scopes granted to the app are issued. Otherwise, only
+// the intersection of the requested and granted scopes is issued.
+func getGrantedScopes(requestID string, app *db.RegisteredApp,
+	requestedScope string) ([]string, error) {
+	requested := parseScope(requestedScope)
+	if len(requested) == 0 {
+		return app.Scopes, nil
+	}
+
+	granted := make(map[string]bool, len(app.Scopes))
+	for _, scope := range app.Scopes {
+		granted[scope] = true
+	}
+
+	var scopes []string
+	for _, scope := range requested {
+		if granted[scope] {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	if len(scopes) == 0 {
+		dstsLogger.Error("None of the requested scopes have been granted to the app!",
+			zap.String("Request ID: ", requestID),
+			zap.String("App ID: ", app.AppId),
+			zap.String("Requested scope: ", requestedScope),
+		)
+		return nil, ErrInvalidScope
+	}
+	return scopes, nil
+}
@@ -62,7 +62,7 @@ func initTokenSigningKey() error {
 	}
 
 	tokenVerificationKey = &tokenSigningKey.PublicKey
-	tokenSigningKeyID = getSigningKeyID()
+	tokenSigningKeyID = getSigningKeyID(tokenVerificationKey)
 	if tokenSigningKeyID == "" {
 		dstsLogger.Error("Failed to generate the key ID for the signing key!")
 		return errors.New("failed to generate token signing key ID")
@@ -90,8 +90,8 @@ func initTokenSigningKey() error {
 	return nil
 }
 
-func getSigningKeyID() string {
-	keyBytes := x509.MarshalPKCS1PublicKey(tokenVerificationKey)
+func getSigningKeyID(verificationKey *rsa.PublicKey) string {
+	keyBytes := x509.MarshalPKCS1PublicKey(verificationKey)
 	if keyBytes == nil {
 		dstsLogger.Error("Failed to marshal the token signing public key!")
 		return ""
@@ -101,16 +101,24 @@ func getSigningKeyID() string {
 }
 
 func initTokenSigningKeyResponse() {
-	signingKeyResponse = append(signingKeyResponse, &pb.JSONWebKey{
-		Kty: "RSA",
-		Alg: "RS512",
-		Use: "sig",
-		Kid: tokenSigningKeyID,
-		N:   b64.URLEncoding.EncodeToString(tokenVerificationKey.N.Bytes()),
-		E: b64.URLEncoding.EncodeToString(common.NewBufferFromInt(
-			// #nosec G115
-			uint64(tokenVerificationKey.E)).Data),
-	})
+	signingKeyResponse = newSigningKeyResponse(tokenSigningKeyID,
+		tokenVerificationKey)
+}
+
+func newSigningKeyResponse(keyID string,
+	verificationKey *rsa.PublicKey) []*pb.JSONWebKey {
+	return []*pb.JSONWebKey{
+		{
+			Kty: "RSA",
+			Alg: "RS512",
+			Use: "sig",
+			Kid: keyID,
+			N:   b64.URLEncoding.EncodeToString(verificationKey.N.Bytes()),
+			E: b64.URLEncoding.EncodeToString(common.NewBufferFromInt(
+				// #nosec G115
+				uint64(verificationKey.E)).Data),
+		},
+	}
 }
 
 func GetTokenSigningKey() []*pb.JSONWebKey {
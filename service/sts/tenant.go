@@ -0,0 +1,29 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// Check whether devices in the specified tenant can obtain tokens. Token
+// issuance is blocked for all devices in suspended and deleted tenants.
+func checkTenantState(requestID string, tenantID string) error {
+	tenant, err := db.GetTenant(requestID, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if !tenant.IsTokenIssuanceAllowed() {
+		dstsLogger.Error("Token issuance is blocked for devices in the tenant!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.String("Tenant state: ", tenant.State),
+		)
+		return db.ErrAuthnBlocked
+	}
+	return nil
+}
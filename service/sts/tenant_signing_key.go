@@ -0,0 +1,210 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	pb "github.com/HPInc/krypton-dsts/dstsprotos"
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+// tokenSigner - the issuer and signing key used to issue device access tokens
+// for a tenant, along with the pre-created key documents published for it.
+type tokenSigner struct {
+	issuer     string
+	keyID      string
+	signingKey *rsa.PrivateKey
+
+	jwksDocument       []byte
+	jwksETag           string
+	signingKeyResponse []*pb.JSONWebKey
+}
+
+var (
+	// Token signers for tenants that have a dedicated issuer or signing key
+	// configured, indexed by tenant ID. Initialized at startup and read-only
+	// thereafter.
+	tenantSigners = map[string]*tokenSigner{}
+)
+
+// Initialize token signers for tenants listed in the configuration file.
+// Tenants configured to use a dedicated signing key get one generated and
+// stored in the database, if it doesn't already exist.
+func initTenantSigners(tenants []config.TenantConfig) error {
+	var tenantKeys map[string]db.TenantSigningKey
+
+	for _, tenant := range tenants {
+		if tenant.Id == "" {
+			return errors.New("tenant ID not specified in tenant configuration")
+		}
+
+		signer := newServiceTokenSigner()
+		if tenant.Issuer != "" {
+			signer.issuer = tenant.Issuer
+		}
+
+		if tenant.DedicatedSigningKey {
+			// Lazily load the tenant signing keys stored in the database.
+			if tenantKeys == nil {
+				keys, err := listTenantSigningKeys()
+				if err != nil {
+					return err
+				}
+				tenantKeys = keys
+			}
+
+			key, ok := tenantKeys[tenant.Id]
+			if !ok {
+				newKey, err := newTenantSigningKey(tenant.Id)
+				if err != nil {
+					return err
+				}
+				key = *newKey
+			}
+
+			err := signer.initSigningKey(&key)
+			if err != nil {
+				dstsLogger.Error("Failed to initialize tenant token signing key!",
+					zap.String("Tenant ID: ", tenant.Id),
+					zap.Error(err),
+				)
+				return err
+			}
+		}
+
+		tenantSigners[tenant.Id] = signer
+		dstsLogger.Info("Initialized token signer for tenant",
+			zap.String("Tenant ID: ", tenant.Id),
+			zap.String("Issuer: ", signer.issuer),
+			zap.String("Key ID: ", signer.keyID),
+		)
+	}
+	return nil
+}
+
+// Initialize the token signer to use the specified tenant signing key and
+// pre-create the key documents published for the tenant.
+func (s *tokenSigner) initSigningKey(key *db.TenantSigningKey) error {
+	var err error
+
+	s.signingKey, err = key.GetPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	s.keyID = getSigningKeyID(&s.signingKey.PublicKey)
+	if s.keyID != key.KeyId {
+		return fmt.Errorf("tenant signing key ID mismatch: %s", key.KeyId)
+	}
+
+	s.signingKeyResponse = newSigningKeyResponse(s.keyID, &s.signingKey.PublicKey)
+	s.jwksDocument, s.jwksETag, err = newJwks(s.keyID, s.signingKey)
+	return err
+}
+
+// Retrieve the tenant signing keys stored in the database, indexed by tenant.
+func listTenantSigningKeys() (map[string]db.TenantSigningKey, error) {
+	keys, err := db.ListTenantSigningKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	tenantKeys := make(map[string]db.TenantSigningKey, len(keys))
+	for _, key := range keys {
+		tenantKeys[key.TenantId] = key
+	}
+	return tenantKeys, nil
+}
+
+// Generate a new signing key for the specified tenant and add it to the
+// database. If another DSTS instance created a signing key for the tenant
+// concurrently, that signing key is returned instead.
+func newTenantSigningKey(tenantID string) (*db.TenantSigningKey, error) {
+	privateKey, err := common.NewPrivateKey()
+	if err != nil {
+		dstsLogger.Error("Failed to generate tenant token signing key!",
+			zap.String("Tenant ID: ", tenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	keyID := getSigningKeyID(&privateKey.PublicKey)
+	if keyID == "" {
+		return nil, errors.New("failed to generate tenant signing key ID")
+	}
+
+	key, err := db.NewTenantSigningKey(tenantID, keyID, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = key.AddTenantSigningKey()
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, db.ErrDuplicateEntry) {
+		return nil, err
+	}
+
+	// Use the signing key created by the other DSTS instance.
+	tenantKeys, err := listTenantSigningKeys()
+	if err != nil {
+		return nil, err
+	}
+	existingKey, ok := tenantKeys[tenantID]
+	if !ok {
+		return nil, db.ErrNotFound
+	}
+	return &existingKey, nil
+}
+
+// Return the token signer for the specified tenant. Tenants without a
+// dedicated issuer or signing key use the service issuer and signing key.
+func getTokenSigner(tenantID string) *tokenSigner {
+	signer, ok := tenantSigners[tenantID]
+	if ok {
+		return signer
+	}
+
+	return newServiceTokenSigner()
+}
+
+// Return a token signer that uses the service issuer and signing key.
+func newServiceTokenSigner() *tokenSigner {
+	return &tokenSigner{
+		issuer:             tokenIssuer,
+		keyID:              tokenSigningKeyID,
+		signingKey:         tokenSigningKey,
+		jwksDocument:       jwksDocument,
+		jwksETag:           jwksETag,
+		signingKeyResponse: signingKeyResponse,
+	}
+}
+
+// GetTenantTokenIssuer - returns the issuer asserted in device access tokens
+// issued to devices belonging to the specified tenant.
+func GetTenantTokenIssuer(tenantID string) string {
+	return getTokenSigner(tenantID).issuer
+}
+
+// GetTenantTokenSigningKey - returns the JWK used to verify device access
+// tokens issued to devices belonging to the specified tenant.
+func GetTenantTokenSigningKey(tenantID string) []*pb.JSONWebKey {
+	return getTokenSigner(tenantID).signingKeyResponse
+}
+
+// GetTenantJwks - returns the serialized JWKS document and its entity tag
+// for the specified tenant.
+func GetTenantJwks(tenantID string) ([]byte, string) {
+	signer := getTokenSigner(tenantID)
+	return signer.jwksDocument, signer.jwksETag
+}
@@ -0,0 +1,131 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+var (
+	// Lifetimes of device & app access tokens issued by the DSTS.
+	deviceAccessTokenLifetime = defaultDeviceAccessTokenLifetime
+	appAccessTokenLifetime    = defaultAppAccessTokenLifetime
+
+	// Device access token lifetime overrides, indexed by management service
+	// ID and by tenant ID respectively.
+	serviceDeviceAccessTokenLifetimes = map[string]time.Duration{}
+	tenantDeviceAccessTokenLifetimes  = map[string]time.Duration{}
+)
+
+// Initialize the access token lifetime policy from the configuration settings.
+// Lifetimes that are not configured use the defaults.
+func initTokenLifetimes(cfgMgr *config.ConfigMgr) error {
+	tokenConfig := cfgMgr.GetTokenConfig()
+
+	if tokenConfig.DeviceAccessTokenLifetime != 0 {
+		err := validateTokenLifetime("device access token",
+			tokenConfig.DeviceAccessTokenLifetime)
+		if err != nil {
+			return err
+		}
+		deviceAccessTokenLifetime = tokenConfig.DeviceAccessTokenLifetime
+	}
+
+	if tokenConfig.AppAccessTokenLifetime != 0 {
+		err := validateTokenLifetime("app access token",
+			tokenConfig.AppAccessTokenLifetime)
+		if err != nil {
+			return err
+		}
+		appAccessTokenLifetime = tokenConfig.AppAccessTokenLifetime
+	}
+
+	for _, service := range tokenConfig.ManagementServices {
+		err := validateTokenLifetime("management service "+service.ServiceId,
+			service.DeviceAccessTokenLifetime)
+		if err != nil {
+			return err
+		}
+		serviceDeviceAccessTokenLifetimes[service.ServiceId] =
+			service.DeviceAccessTokenLifetime
+	}
+
+	for _, tenant := range cfgMgr.GetTenants() {
+		if tenant.DeviceAccessTokenLifetime == 0 {
+			continue
+		}
+		err := validateTokenLifetime("tenant "+tenant.Id,
+			tenant.DeviceAccessTokenLifetime)
+		if err != nil {
+			return err
+		}
+		tenantDeviceAccessTokenLifetimes[tenant.Id] =
+			tenant.DeviceAccessTokenLifetime
+	}
+	return nil
+}
+
+func validateTokenLifetime(name string, lifetime time.Duration) error {
+	if (lifetime < minAccessTokenLifetime) || (lifetime > maxAccessTokenLifetime) {
+		dstsLogger.Error("Configured token lifetime is out of range!",
+			zap.String("Token lifetime for: ", name),
+			zap.Duration("Lifetime: ", lifetime),
+		)
+		return fmt.Errorf("%w: %s", ErrInvalidAccessTokenLifetime, name)
+	}
+	return nil
+}
+
+// Return the expiry time of a device access token issued to the specified
+// device at the specified time. The lifetime configured for the device's
+// tenant takes precedence over the lifetime configured for its management
+// service. The expiry is capped at that of the device certificate presented
+// by the device, as described in capDeviceTokenExpiry.
+func getDeviceAccessTokenExpiry(device *db.Device, certExpiresAt time.Time,
+	issuedTime time.Time) time.Time {
+	lifetime := deviceAccessTokenLifetime
+	if serviceLifetime, ok := serviceDeviceAccessTokenLifetimes[device.ServiceId]; ok {
+		lifetime = serviceLifetime
+	}
+	if tenantLifetime, ok := tenantDeviceAccessTokenLifetimes[device.TenantId]; ok {
+		lifetime = tenantLifetime
+	}
+
+	return capDeviceTokenExpiry(device, issuedTime.Add(lifetime), certExpiresAt,
+		issuedTime)
+}
+
+// Cap the expiry of a device token issued at the specified time at the expiry
+// of the device certificate presented by the device. The cap is only applied
+// if the certificate validity check is enforced for the device's tenant. If
+// the check is relaxed, expired certificates are accepted and capping would
+// issue tokens that are already expired.
+func capDeviceTokenExpiry(device *db.Device, expiresAt time.Time,
+	certExpiresAt time.Time, issuedTime time.Time) time.Time {
+	if getVerificationMode(device.TenantId, common.CertificateCheckValidity) !=
+		VerificationModeEnforce {
+		return expiresAt
+	}
+
+	// Enforcing the validity check rejects expired certificates, but the
+	// certificate may have expired since it was checked.
+	if !certExpiresAt.After(issuedTime) {
+		dstsLogger.Warn("Device certificate expired before the device token was issued!",
+			zap.String("Device ID: ", device.DeviceId),
+			zap.Time("Certificate expires at: ", certExpiresAt),
+		)
+		return expiresAt
+	}
+	if certExpiresAt.Before(expiresAt) {
+		return certExpiresAt
+	}
+	return expiresAt
+}
@@ -0,0 +1,88 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/db"
+	"go.uber.org/zap"
+)
+
+func TestGetDeviceAccessTokenExpiry(t *testing.T) {
+	dstsLogger = zap.NewNop()
+	serviceDeviceAccessTokenLifetimes = map[string]time.Duration{
+		"hpcem": time.Hour * 8,
+	}
+	tenantDeviceAccessTokenLifetimes = map[string]time.Duration{
+		"tenant-2": time.Hour * 2,
+	}
+	tenantVerificationModes = map[string]map[string]string{
+		"tenant-3": {common.CertificateCheckValidity: VerificationModeReportOnly},
+		"tenant-4": {common.CertificateCheckValidity: VerificationModeOff},
+	}
+	t.Cleanup(func() {
+		serviceDeviceAccessTokenLifetimes = map[string]time.Duration{}
+		tenantDeviceAccessTokenLifetimes = map[string]time.Duration{}
+		tenantVerificationModes = map[string]map[string]string{}
+	})
+
+	issuedTime := time.Now()
+	farFuture := issuedTime.Add(time.Hour * 24 * 365)
+	tests := []struct {
+		name          string
+		device        *db.Device
+		certExpiresAt time.Time
+		expected      time.Time
+	}{
+		{"default lifetime", &db.Device{TenantId: "tenant-1"},
+			farFuture, issuedTime.Add(deviceAccessTokenLifetime)},
+		{"management service lifetime",
+			&db.Device{TenantId: "tenant-1", ServiceId: "hpcem"},
+			farFuture, issuedTime.Add(time.Hour * 8)},
+		{"tenant lifetime takes precedence",
+			&db.Device{TenantId: "tenant-2", ServiceId: "hpcem"},
+			farFuture, issuedTime.Add(time.Hour * 2)},
+
+		// The presented certificate caps the lifetime, even if the device
+		// has since been issued a certificate that expires later.
+		{"presented certificate expiry",
+			&db.Device{TenantId: "tenant-1", ServiceId: "hpcem",
+				CertificateExpiresAt: farFuture},
+			issuedTime.Add(time.Minute * 10), issuedTime.Add(time.Minute * 10)},
+
+		// Tokens are never issued already expired, if the presented
+		// certificate expired before the token was issued.
+		{"expired certificate", &db.Device{TenantId: "tenant-1"},
+			issuedTime.Add(-time.Hour), issuedTime.Add(deviceAccessTokenLifetime)},
+		{"expired certificate, validity check report only",
+			&db.Device{TenantId: "tenant-3"}, issuedTime.Add(-time.Hour),
+			issuedTime.Add(deviceAccessTokenLifetime)},
+		{"expired certificate, validity check off",
+			&db.Device{TenantId: "tenant-4"}, issuedTime.Add(-time.Hour),
+			issuedTime.Add(deviceAccessTokenLifetime)},
+
+		// The presented certificate only caps the lifetime if the validity
+		// check is enforced.
+		{"presented certificate expiry, validity check report only",
+			&db.Device{TenantId: "tenant-3"}, issuedTime.Add(time.Minute * 10),
+			issuedTime.Add(deviceAccessTokenLifetime)},
+	}
+
+	for _, test := range tests {
+		expiresAt := getDeviceAccessTokenExpiry(test.device, test.certExpiresAt,
+			issuedTime)
+		if !expiresAt.Equal(test.expected) {
+			t.Errorf("TestGetDeviceAccessTokenExpiry: %s: expected %v, got %v",
+				test.name, test.expected, expiresAt)
+		}
+		if !expiresAt.After(issuedTime) {
+			t.Errorf("TestGetDeviceAccessTokenExpiry: %s: token expires at %v, before it was issued",
+				test.name, expiresAt)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/google/cel-go/cel"
+	"go.uber.org/zap"
+)
+
+// Grant types reported to token policies in the request.grant_type attribute.
+const (
+	GrantTypeClientAssertion   = "client_assertion"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCertificate = "client_certificate"
+
+	// Maximum number of compiled token policies cached.
+	maxCompiledTokenPolicies = 1024
+
+	// Limits on the size of token policy expressions and on the cost of
+	// evaluating them, since they are evaluated each time a token is issued.
+	maxTokenPolicyExpressionLength = 2048
+	maxTokenPolicyEvaluationCost   = 10000
+)
+
+var (
+	// CEL environment declaring the variables that can be referenced by token
+	// policy expressions. The attributes of each variable are listed by
+	// newTokenPolicyVariables.
+	tokenPolicyEnv *cel.Env
+
+	// Compiled token policy programs, indexed by expression.
+	compiledTokenPolicies     = map[string]cel.Program{}
+	compiledTokenPoliciesLock sync.RWMutex
+)
+
+// tokenRequest - attributes of the device access token request evaluated by
+// token policies.
+type tokenRequest struct {
+	grantType      string
+	certThumbprint string
+	dpopBound      bool
+}
+
+// Initialize the CEL environment in which token policy expressions are
+// compiled.
+func initTokenPolicyEnv() error {
+	var err error
+	attributes := cel.MapType(cel.StringType, cel.DynType)
+	tokenPolicyEnv, err = cel.NewEnv(
+		cel.Variable("device", attributes),
+		cel.Variable("certificate", attributes),
+		cel.Variable("request", attributes),
+		cel.Variable("posture", attributes),
+		cel.Variable("now", cel.TimestampType),
+	)
+	return err
+}
+
+// ValidateTokenPolicyExpression - check that the token policy expression is a
+// well formed CEL expression, only references the declared variables and
+// evaluates to a bool for a sample device.
+func ValidateTokenPolicyExpression(expression string) error {
+	program, err := compileTokenPolicy(expression)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	sample := newTokenPolicyVariables(&db.Device{
+		CertificateIssuedAt:  now,
+		CertificateExpiresAt: now,
+		CreatedAt:            now,
+	}, &tokenRequest{grantType: GrantTypeClientAssertion},
+		&db.DevicePosture{ReportedAt: now})
+	_, err = evalTokenPolicyProgram(program, sample)
+	return err
+}
+
+// Compile the token policy expression in the token policy environment.
+// Expressions that cannot evaluate to a bool are rejected.
+func compileTokenPolicy(expression string) (cel.Program, error) {
+	if len(expression) > maxTokenPolicyExpressionLength {
+		return nil, fmt.Errorf("%w: expression exceeds the maximum length of %d",
+			ErrInvalidTokenPolicyExpression, maxTokenPolicyExpressionLength)
+	}
+
+	ast, issues := tokenPolicyEnv.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTokenPolicyExpression,
+			issues.Err())
+	}
+	if (ast.OutputType() != cel.BoolType) && (ast.OutputType() != cel.DynType) {
+		return nil, fmt.Errorf("%w: expression evaluates to %s, not bool",
+			ErrInvalidTokenPolicyExpression, ast.OutputType())
+	}
+
+	return tokenPolicyEnv.Program(ast,
+		cel.CostLimit(maxTokenPolicyEvaluationCost))
+}
+
+// Evaluate the compiled token policy using the specified attributes.
+func evalTokenPolicyProgram(program cel.Program,
+	variables map[string]interface{}) (bool, error) {
+	result, _, err := program.Eval(variables)
+	if err != nil {
+		return false, err
+	}
+	allowed, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: expression evaluated to %s, not bool",
+			ErrInvalidTokenPolicyExpression, result.Type())
+	}
+	return allowed, nil
+}
+
+// Evaluate the token policies configured for the device's tenant. A device
+// access token is issued only if all enabled policies evaluate to true.
+// Policies assigned to a device group are only evaluated for members of the
+// group. Policies that fail to evaluate deny token issuance.
+func enforceTokenPolicies(requestID string, device *db.Device,
+	request *tokenRequest) error {
+	policies, err := db.ListTokenPolicies(requestID, device.TenantId)
+	if err != nil {
+		dstsLogger.Error("Failed to retrieve token policies for the tenant!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Tenant ID: ", device.TenantId),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	var variables map[string]interface{}
+	var deviceGroups map[string]bool
+	for _, tokenPolicy := range policies {
+		if !tokenPolicy.IsEnabled {
+			continue
+		}
+
+		// Retrieve the groups to which the device belongs once, when the
+		// first policy assigned to a group is found.
+		if tokenPolicy.GroupId != "" {
+			if deviceGroups == nil {
+				deviceGroups, err = getTokenPolicyDeviceGroups(requestID, device)
+				if err != nil {
+					return err
+				}
+			}
+			if !deviceGroups[tokenPolicy.GroupId] {
+				continue
+			}
+		}
+
+		// Retrieve the attributes evaluated by the policies once, when the
+		// first enabled policy is found.
+		if variables == nil {
+			posture, err := getTokenPolicyPosture(requestID, device)
+			if err != nil {
+				return err
+			}
+			variables = newTokenPolicyVariables(device, request, posture)
+		}
+
+		allowed, err := evaluateTokenPolicy(&tokenPolicy, variables)
+		if err != nil {
+			dstsLogger.Error("Failed to evaluate token policy - token issuance denied!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Device ID: ", device.DeviceId),
+				zap.String("Tenant ID: ", device.TenantId),
+				zap.String("Policy ID: ", tokenPolicy.PolicyId),
+				zap.String("Policy name: ", tokenPolicy.Name),
+				zap.Error(err),
+			)
+			metrics.MetricTokenPolicyDenials.WithLabelValues(tokenPolicy.Name,
+				metrics.TokenPolicyDenialReasonEvaluationError).Inc()
+			return fmt.Errorf("%w: %s", ErrTokenPolicyDenied, tokenPolicy.Name)
+		}
+		if !allowed {
+			dstsLogger.Error("Token issuance denied by token policy!",
+				zap.String("Request ID: ", requestID),
+				zap.String("Device ID: ", device.DeviceId),
+				zap.String("Tenant ID: ", device.TenantId),
+				zap.String("Policy ID: ", tokenPolicy.PolicyId),
+				zap.String("Policy name: ", tokenPolicy.Name),
+				zap.String("Grant type: ", request.grantType),
+			)
+			metrics.MetricTokenPolicyDenials.WithLabelValues(tokenPolicy.Name,
+				metrics.TokenPolicyDenialReasonDenied).Inc()
+			return fmt.Errorf("%w: %s", ErrTokenPolicyDenied, tokenPolicy.Name)
+		}
+	}
+	return nil
+}
+
+// Evaluate the token policy using the specified attributes.
+func evaluateTokenPolicy(tokenPolicy *db.TokenPolicy,
+	variables map[string]interface{}) (bool, error) {
+	program, err := getCompiledTokenPolicy(tokenPolicy.Expression)
+	if err != nil {
+		return false, err
+	}
+	return evalTokenPolicyProgram(program, variables)
+}
+
+// Return the compiled program for the token policy expression. Programs are
+// cached, since policies are evaluated each time a token is requested.
+func getCompiledTokenPolicy(expression string) (cel.Program, error) {
+	compiledTokenPoliciesLock.RLock()
+	program, ok := compiledTokenPolicies[expression]
+	compiledTokenPoliciesLock.RUnlock()
+	if ok {
+		return program, nil
+	}
+
+	program, err := compileTokenPolicy(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledTokenPoliciesLock.Lock()
+	if len(compiledTokenPolicies) >= maxCompiledTokenPolicies {
+		compiledTokenPolicies = map[string]cel.Program{}
+	}
+	compiledTokenPolicies[expression] = program
+	compiledTokenPoliciesLock.Unlock()
+	return program, nil
+}
+
+// Return the posture last reported by the device. Devices that have not
+// reported their posture are evaluated with an empty posture.
+func getTokenPolicyPosture(requestID string,
+	device *db.Device) (*db.DevicePosture, error) {
+	posture, err := db.GetDevicePosture(requestID, device.TenantId,
+		device.DeviceId)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return posture, nil
+}
+
+// Return the set of device groups to which the device belongs.
+func getTokenPolicyDeviceGroups(requestID string,
+	device *db.Device) (map[string]bool, error) {
+	groupIDs, err := db.ListDeviceGroupsForDevice(requestID, device.TenantId,
+		device.DeviceId)
+	if err != nil {
+		dstsLogger.Error("Failed to retrieve the device groups for the device!",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", device.DeviceId),
+			zap.String("Tenant ID: ", device.TenantId),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	deviceGroups := make(map[string]bool, len(groupIDs))
+	for _, groupID := range groupIDs {
+		deviceGroups[groupID] = true
+	}
+	return deviceGroups, nil
+}
+
+// Return the attributes of the device, its certificate, the token request and
+// the device posture, as referenced by token policy expressions.
+func newTokenPolicyVariables(device *db.Device, request *tokenRequest,
+	posture *db.DevicePosture) map[string]interface{} {
+	certThumbprint := request.certThumbprint
+	if certThumbprint == "" {
+		certThumbprint = device.CertificateThumbprint
+	}
+
+	postureVariables := map[string]interface{}{
+		"reported":        false,
+		"os_version":      "",
+		"patch_level":     "",
+		"disk_encryption": "",
+		"agent_version":   "",
+		"reported_at":     time.Time{},
+	}
+	if posture != nil {
+		postureVariables["reported"] = true
+		postureVariables["os_version"] = posture.OsVersion
+		postureVariables["patch_level"] = posture.PatchLevel
+		postureVariables["disk_encryption"] = posture.DiskEncryption
+		postureVariables["agent_version"] = posture.AgentVersion
+		postureVariables["reported_at"] = posture.ReportedAt
+	}
+
+	return map[string]interface{}{
+		"device": map[string]interface{}{
+			"id":                 device.DeviceId,
+			"tenant_id":          device.TenantId,
+			"management_service": device.ServiceId,
+			"hardware_hash":      device.HardwareHash,
+			"enrolled_at":        device.CreatedAt,
+		},
+		"certificate": map[string]interface{}{
+			"thumbprint": certThumbprint,
+			"issued_at":  device.CertificateIssuedAt,
+			"expires_at": device.CertificateExpiresAt,
+		},
+		"request": map[string]interface{}{
+			"grant_type": request.grantType,
+			"dpop_bound": request.dpopBound,
+		},
+		"posture": postureVariables,
+		"now":     time.Now(),
+	}
+}
@@ -0,0 +1,133 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HPInc/krypton-dsts/service/db"
+)
+
+// Return the token policy attributes of a test device.
+func newTestTokenPolicyVariables(t *testing.T,
+	posture *db.DevicePosture) map[string]interface{} {
+	err := initTokenPolicyEnv()
+	if err != nil {
+		t.Fatalf("Failed to initialize the token policy environment: %v", err)
+	}
+
+	now := time.Now()
+	return newTokenPolicyVariables(&db.Device{
+		DeviceId:             "device-1",
+		TenantId:             "tenant-1",
+		ServiceId:            "hpcem",
+		CertificateIssuedAt:  now.Add(-time.Hour * 24),
+		CertificateExpiresAt: now.Add(time.Hour * 24 * 365),
+		CreatedAt:            now.Add(-time.Hour * 24),
+	}, &tokenRequest{
+		grantType:      GrantTypeClientAssertion,
+		certThumbprint: "thumbprint",
+		dpopBound:      true,
+	}, posture)
+}
+
+func TestValidateTokenPolicyExpression(t *testing.T) {
+	_ = newTestTokenPolicyVariables(t, nil)
+
+	for _, expression := range []string{
+		`posture.disk_encryption == "encrypted"`,
+		`device.management_service in ["hpcem", "none"]`,
+		`now - certificate.issued_at < duration("17520h")`,
+		`request.grant_type == "client_assertion" || request.dpop_bound`,
+		`posture.os_version.startsWith("10.") && size(device.hardware_hash) >= 0`,
+		`has(device.labels) ? device.labels.site == "a" : true`,
+	} {
+		err := ValidateTokenPolicyExpression(expression)
+		if err != nil {
+			t.Errorf("TestValidateTokenPolicyExpression: %s: rejected: %v",
+				expression, err)
+		}
+	}
+
+	for _, expression := range []string{
+		`device.id ==`,
+		`unknown.attribute == "x"`,
+		`device.id`,
+		`1 + 2`,
+		`now`,
+		strings.Repeat("true && ", maxTokenPolicyExpressionLength/8) + "true",
+	} {
+		err := ValidateTokenPolicyExpression(expression)
+		if !errors.Is(err, ErrInvalidTokenPolicyExpression) {
+			t.Errorf("TestValidateTokenPolicyExpression: %s: expected %v, got %v",
+				expression, ErrInvalidTokenPolicyExpression, err)
+		}
+	}
+}
+
+func TestEvaluateTokenPolicy(t *testing.T) {
+	reported := newTestTokenPolicyVariables(t, &db.DevicePosture{
+		OsVersion:      "10.0.22631",
+		DiskEncryption: "encrypted",
+		ReportedAt:     time.Now().Add(-time.Minute),
+	})
+	unreported := newTestTokenPolicyVariables(t, nil)
+
+	tests := []struct {
+		expression string
+		variables  map[string]interface{}
+		expected   bool
+	}{
+		{`posture.disk_encryption == "encrypted"`, reported, true},
+		{`posture.disk_encryption == "encrypted"`, unreported, false},
+		{`posture.reported && now - posture.reported_at < duration("1h")`,
+			reported, true},
+		{`posture.reported && now - posture.reported_at < duration("1h")`,
+			unreported, false},
+		{`device.management_service == "hpcem" && request.dpop_bound`,
+			reported, true},
+		{`certificate.expires_at - now > duration("720h")`, reported, true},
+		{`certificate.thumbprint == "thumbprint"`, reported, true},
+		{`request.grant_type == "refresh_token"`, reported, false},
+
+		// As in CEL, errors are absorbed by logical operators where the
+		// result is determined by the other operand.
+		{`false && device.missing == "x"`, reported, false},
+		{`true || device.missing == "x"`, reported, true},
+	}
+
+	for _, test := range tests {
+		allowed, err := evaluateTokenPolicy(&db.TokenPolicy{
+			Expression: test.expression,
+		}, test.variables)
+		if err != nil {
+			t.Errorf("TestEvaluateTokenPolicy: %s: failed to evaluate: %v",
+				test.expression, err)
+			continue
+		}
+		if allowed != test.expected {
+			t.Errorf("TestEvaluateTokenPolicy: %s: evaluated to %v, expected %v",
+				test.expression, allowed, test.expected)
+		}
+	}
+
+	// Policies that fail to evaluate, or do not evaluate to a bool, return an
+	// error so that token issuance is denied.
+	for _, expression := range []string{
+		`device.missing == "x"`,
+		`device.id`,
+		`int(device.id) > 0`,
+	} {
+		_, err := evaluateTokenPolicy(&db.TokenPolicy{Expression: expression},
+			reported)
+		if err == nil {
+			t.Errorf("TestEvaluateTokenPolicy: %s: evaluated without error",
+				expression)
+		}
+	}
+}
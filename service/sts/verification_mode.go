@@ -0,0 +1,129 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"fmt"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"go.uber.org/zap"
+)
+
+// Modes in which verification checks on the device authentication path can be
+// configured for a tenant.
+const (
+	// Failure of the check blocks device authentication.
+	VerificationModeEnforce = "enforce"
+
+	// Failure of the check is logged and counted, but device authentication
+	// is allowed to proceed. This is used to measure the impact of a check
+	// before it is enforced.
+	VerificationModeReportOnly = "report_only"
+
+	// The check is not performed.
+	VerificationModeOff = "off"
+)
+
+// Names of verification checks on the device authentication path, in addition
+// to the certificate checks performed by common.VerifyCertificate.
+const (
+	// The client assertion must be signed using RS512.
+	VerificationCheckAssertionSigningAlgorithm = "assertion_signing_algorithm"
+)
+
+var (
+	// Default mode for each verification check. Checks introduced to tighten
+	// device authentication can default to report only, until they are
+	// enforced.
+	defaultVerificationModes = map[string]string{
+		VerificationCheckAssertionSigningAlgorithm: VerificationModeEnforce,
+	}
+
+	// Verification modes configured for tenants, indexed by tenant ID and
+	// check name.
+	tenantVerificationModes = map[string]map[string]string{}
+)
+
+func init() {
+	for _, check := range common.CertificateCheckNames() {
+		defaultVerificationModes[check] = VerificationModeEnforce
+	}
+}
+
+// Initialize the verification modes configured for tenants listed in the
+// configuration file. Checks configured without a mode are enforced. An error
+// is returned for unsupported checks or modes, so that a misconfigured
+// check is never relaxed.
+func initVerificationModes(tenants []config.TenantConfig) error {
+	for _, tenant := range tenants {
+		if len(tenant.VerificationModes) == 0 {
+			continue
+		}
+
+		modes := map[string]string{}
+		for check, mode := range tenant.VerificationModes {
+			if _, ok := defaultVerificationModes[check]; !ok {
+				return fmt.Errorf("unsupported verification check %s configured for tenant %s",
+					check, tenant.Id)
+			}
+			switch mode {
+			case "":
+				modes[check] = VerificationModeEnforce
+			case VerificationModeEnforce, VerificationModeReportOnly,
+				VerificationModeOff:
+				modes[check] = mode
+			default:
+				return fmt.Errorf("unsupported verification mode %s configured for check %s of tenant %s",
+					mode, check, tenant.Id)
+			}
+		}
+		tenantVerificationModes[tenant.Id] = modes
+	}
+	return nil
+}
+
+// Return the mode of the verification check for the specified tenant.
+func getVerificationMode(tenantID string, check string) string {
+	if mode, ok := tenantVerificationModes[tenantID][check]; ok {
+		return mode
+	}
+	if mode, ok := defaultVerificationModes[check]; ok {
+		return mode
+	}
+	return VerificationModeEnforce
+}
+
+// Apply the verification mode configured for the tenant to the result of a
+// verification check. The error is returned if the check is enforced. If the
+// check is in report only mode, the failure is logged and counted and nil is
+// returned.
+func applyVerificationMode(requestID string, tenantID string, deviceID string,
+	check string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch getVerificationMode(tenantID, check) {
+	case VerificationModeOff:
+		return nil
+
+	case VerificationModeReportOnly:
+		dstsLogger.Warn("Verification check failed - allowed in report only mode",
+			zap.String("Request ID: ", requestID),
+			zap.String("Device ID: ", deviceID),
+			zap.String("Tenant ID: ", tenantID),
+			zap.String("Check: ", check),
+			zap.Error(err),
+		)
+		metrics.MetricVerificationReportOnlyFailures.WithLabelValues(tenantID,
+			check).Inc()
+		return nil
+
+	default:
+		return err
+	}
+}
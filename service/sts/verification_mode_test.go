@@ -0,0 +1,201 @@
+// package github.com/HPInc/krypton-dsts/service/sts
+// Author: Mahesh Unnikrishnan
+// Component: Krypton Device Security Token Service
+// (C) HP Development Company, LP
+package sts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/HPInc/krypton-dsts/service/common"
+	"github.com/HPInc/krypton-dsts/service/config"
+	"github.com/HPInc/krypton-dsts/service/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var errTestCheckFailed = errors.New("verification check failed")
+
+// Configure the verification modes for the test and return the observed
+// log entries.
+func initTestVerificationModes(t *testing.T,
+	tenants []config.TenantConfig) (*observer.ObservedLogs, error) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	dstsLogger = zap.New(core)
+	tenantVerificationModes = map[string]map[string]string{}
+	t.Cleanup(func() {
+		tenantVerificationModes = map[string]map[string]string{}
+	})
+	return logs, initVerificationModes(tenants)
+}
+
+func TestVerificationModes_Defaults(t *testing.T) {
+	_, err := initTestVerificationModes(t, nil)
+	if err != nil {
+		t.Errorf("TestVerificationModes_Defaults: failed to initialize: %v", err)
+		return
+	}
+
+	// All checks are enforced unless configured otherwise, including checks
+	// that are not known.
+	checks := append(common.CertificateCheckNames(),
+		VerificationCheckAssertionSigningAlgorithm, "unknown_check")
+	for _, check := range checks {
+		if mode := getVerificationMode("tenant-1", check); mode != VerificationModeEnforce {
+			t.Errorf("TestVerificationModes_Defaults: %s defaults to %s", check, mode)
+		}
+		err = applyVerificationMode("test", "tenant-1", "device-1", check,
+			errTestCheckFailed)
+		if !errors.Is(err, errTestCheckFailed) {
+			t.Errorf("TestVerificationModes_Defaults: failure of %s was not enforced", check)
+		}
+	}
+}
+
+func TestVerificationModes_Configured(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{VerificationModeEnforce, VerificationModeEnforce},
+		{VerificationModeReportOnly, VerificationModeReportOnly},
+		{VerificationModeOff, VerificationModeOff},
+
+		// Checks configured without a mode are enforced.
+		{"", VerificationModeEnforce},
+	}
+
+	for _, test := range tests {
+		_, err := initTestVerificationModes(t, []config.TenantConfig{{
+			Id: "tenant-1",
+			VerificationModes: map[string]string{
+				common.CertificateCheckExtKeyUsage: test.mode,
+			},
+		}})
+		if err != nil {
+			t.Errorf("TestVerificationModes_Configured: failed to initialize mode %q: %v",
+				test.mode, err)
+			continue
+		}
+		mode := getVerificationMode("tenant-1", common.CertificateCheckExtKeyUsage)
+		if mode != test.expected {
+			t.Errorf("TestVerificationModes_Configured: mode %q resolved to %s, expected %s",
+				test.mode, mode, test.expected)
+		}
+
+		// The configured mode only applies to the configured tenant and check.
+		if mode = getVerificationMode("tenant-2", common.CertificateCheckExtKeyUsage); mode != VerificationModeEnforce {
+			t.Errorf("TestVerificationModes_Configured: other tenant resolved to %s", mode)
+		}
+		if mode = getVerificationMode("tenant-1", common.CertificateCheckKeyUsage); mode != VerificationModeEnforce {
+			t.Errorf("TestVerificationModes_Configured: other check resolved to %s", mode)
+		}
+	}
+}
+
+func TestVerificationModes_InvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		modes map[string]string
+	}{
+		{"unsupported mode", map[string]string{
+			common.CertificateCheckValidity: "warn"}},
+		{"mode with different case", map[string]string{
+			common.CertificateCheckValidity: "Report_Only"}},
+		{"unsupported check", map[string]string{
+			"certificate_issuer": VerificationModeOff}},
+	}
+
+	for _, test := range tests {
+		_, err := initTestVerificationModes(t, []config.TenantConfig{{
+			Id:                "tenant-1",
+			VerificationModes: test.modes,
+		}})
+		if err == nil {
+			t.Errorf("TestVerificationModes_InvalidConfiguration: %s was accepted",
+				test.name)
+		}
+
+		// Checks of the misconfigured tenant remain enforced.
+		for check := range test.modes {
+			if mode := getVerificationMode("tenant-1", check); mode != VerificationModeEnforce {
+				t.Errorf("TestVerificationModes_InvalidConfiguration: %s: %s resolved to %s",
+					test.name, check, mode)
+			}
+		}
+	}
+}
+
+func TestApplyVerificationMode(t *testing.T) {
+	logs, err := initTestVerificationModes(t, []config.TenantConfig{
+		{
+			Id: "tenant-enforce",
+			VerificationModes: map[string]string{
+				common.CertificateCheckValidity: VerificationModeEnforce,
+			},
+		},
+		{
+			Id: "tenant-report-only",
+			VerificationModes: map[string]string{
+				common.CertificateCheckValidity: VerificationModeReportOnly,
+			},
+		},
+		{
+			Id: "tenant-off",
+			VerificationModes: map[string]string{
+				common.CertificateCheckValidity: VerificationModeOff,
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("TestApplyVerificationMode: failed to initialize: %v", err)
+		return
+	}
+	check := common.CertificateCheckValidity
+	reportOnlyFailures := metrics.MetricVerificationReportOnlyFailures.WithLabelValues(
+		"tenant-report-only", check)
+	failuresBefore := testutil.ToFloat64(reportOnlyFailures)
+
+	// Checks that pass are allowed in every mode.
+	for _, tenantID := range []string{"tenant-enforce", "tenant-report-only", "tenant-off"} {
+		err = applyVerificationMode("test", tenantID, "device-1", check, nil)
+		if err != nil {
+			t.Errorf("TestApplyVerificationMode: passed check failed for %s: %v", tenantID, err)
+		}
+	}
+
+	// Enforced checks fail.
+	err = applyVerificationMode("test", "tenant-enforce", "device-1", check,
+		errTestCheckFailed)
+	if !errors.Is(err, errTestCheckFailed) {
+		t.Errorf("TestApplyVerificationMode: enforced check returned %v", err)
+	}
+
+	// Failures of checks in report only mode are allowed, logged and counted.
+	err = applyVerificationMode("test", "tenant-report-only", "device-1", check,
+		errTestCheckFailed)
+	if err != nil {
+		t.Errorf("TestApplyVerificationMode: report only check returned %v", err)
+	}
+	reported := logs.FilterMessage("Verification check failed - allowed in report only mode")
+	if reported.Len() != 1 {
+		t.Errorf("TestApplyVerificationMode: report only failure was logged %d times",
+			reported.Len())
+	}
+	if testutil.ToFloat64(reportOnlyFailures) != failuresBefore+1 {
+		t.Errorf("TestApplyVerificationMode: report only failure was not counted")
+	}
+
+	// Checks that are off are not reported.
+	err = applyVerificationMode("test", "tenant-off", "device-1", check,
+		errTestCheckFailed)
+	if err != nil {
+		t.Errorf("TestApplyVerificationMode: disabled check returned %v", err)
+	}
+	if logs.FilterMessage("Verification check failed - allowed in report only mode").Len() != 1 {
+		t.Errorf("TestApplyVerificationMode: disabled check failure was reported")
+	}
+}